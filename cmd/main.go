@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"net/http"
 	"os"
 
@@ -37,7 +38,39 @@ func main() {
 
 	// =========================================================================
 	// Initialize Services
-	calculatorSvc, err := calculatorservice.NewService(logger)
+	serviceOpts := []calculatorservice.Option{
+		calculatorservice.WithCombinatoricsLimit(cfg.MaxCombinatoricsN),
+		calculatorservice.WithEvaluationBudget(cfg.MaxEvaluations),
+		calculatorservice.WithUnitsFile(cfg.UnitsFile),
+		calculatorservice.WithHolidayCalendars(cfg.HolidaysDir),
+	}
+	switch {
+	case cfg.RatesURL != "":
+		serviceOpts = append(serviceOpts, calculatorservice.WithRateProvider(
+			calculatorservice.NewHTTPRateProvider(cfg.RatesURL, nil, cfg.RatesRefresh),
+		))
+	case cfg.RatesFile != "":
+		rates, err := calculatorservice.NewFileRateProvider(cfg.RatesFile)
+		if err != nil {
+			level.Error(logger).Log("msg", "failed to load exchange rates", "err", err)
+			os.Exit(1)
+		}
+		serviceOpts = append(serviceOpts, calculatorservice.WithRateProvider(rates))
+	}
+	if cfg.RulesDir != "" {
+		rules, err := calculatorservice.NewRuleTables(cfg.RulesDir)
+		if err != nil {
+			level.Error(logger).Log("msg", "failed to load rule tables", "err", err)
+			os.Exit(1)
+		}
+		go rules.Watch(context.Background(), cfg.RulesReload, logger)
+		serviceOpts = append(serviceOpts, calculatorservice.WithRuleTables(rules))
+	}
+	calculatorSvc, err := calculatorservice.NewService(logger, serviceOpts...)
+	if err != nil {
+		level.Error(logger).Log("msg", "failed to initialize calculator service", "err", err)
+		os.Exit(1)
+	}
 
 	// =========================================================================
 
@@ -55,7 +88,14 @@ func main() {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_kit.UnaryServerInterceptor(logger, grpc_kit.WithLevels(grpc_kit.DefaultClientCodeToLevel)),
 		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_prometheus.StreamServerInterceptor,
+			grpc_kit.StreamServerInterceptor(logger, grpc_kit.WithLevels(grpc_kit.DefaultClientCodeToLevel)),
+		)),
 		grpc.StatsHandler(&ocgrpc.ServerHandler{IsPublicEndpoint: false}),
+		// signal processing requests carry up to a million samples
+		grpc.MaxRecvMsgSize(cfg.MaxMessageSize),
+		grpc.MaxSendMsgSize(cfg.MaxMessageSize),
 	}
 	grpcServer := grpc.NewServer(grpcOpts...)
 
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	arg "github.com/alexflint/go-arg"
 	"github.com/josephmbassey/calculator-service/internals/logger"
 	"github.com/pkg/errors"
@@ -9,11 +11,21 @@ import (
 // Config is the config struct
 type Config struct {
 	logger.LoglevelEnv
-	SERVICE_NAME       string `arg:"--service-name,env:SERVICE_NAME"`
-	Environment        string `arg:"--environment,env:ENVIRONMENT"`
-	ListenHTTP         string `arg:"--listen-http,env:LISTEN_HTTP"`
-	ListenGRPC         string `arg:"--listen-grpc,env:LISTEN_GRPC"`
-	ListenHTTPLiveness string `arg:"--listen-http-liveness,env:LISTEN_HTTP_LIVENESS"`
+	SERVICE_NAME       string        `arg:"--service-name,env:SERVICE_NAME"`
+	Environment        string        `arg:"--environment,env:ENVIRONMENT"`
+	ListenHTTP         string        `arg:"--listen-http,env:LISTEN_HTTP"`
+	ListenGRPC         string        `arg:"--listen-grpc,env:LISTEN_GRPC"`
+	ListenHTTPLiveness string        `arg:"--listen-http-liveness,env:LISTEN_HTTP_LIVENESS"`
+	MaxCombinatoricsN  uint64        `arg:"--max-combinatorics-n,env:MAX_COMBINATORICS_N"`
+	MaxEvaluations     uint64        `arg:"--max-evaluations,env:MAX_EVALUATIONS"`
+	MaxMessageSize     int           `arg:"--max-message-size,env:MAX_MESSAGE_SIZE"`
+	UnitsFile          string        `arg:"--units-file,env:UNITS_FILE"`
+	RatesFile          string        `arg:"--rates-file,env:RATES_FILE"`
+	RatesURL           string        `arg:"--rates-url,env:RATES_URL"`
+	RatesRefresh       time.Duration `arg:"--rates-refresh,env:RATES_REFRESH"`
+	HolidaysDir        string        `arg:"--holidays-dir,env:HOLIDAYS_DIR"`
+	RulesDir           string        `arg:"--rules-dir,env:RULES_DIR"`
+	RulesReload        time.Duration `arg:"--rules-reload,env:RULES_RELOAD"`
 }
 
 // New creates a new config struct with sane defaults
@@ -22,6 +34,11 @@ func New() (Config, error) {
 		ListenHTTP:         ":8080",
 		ListenGRPC:         ":8083",
 		ListenHTTPLiveness: ":8084",
+		MaxCombinatoricsN:  100000,
+		MaxEvaluations:     1000000,
+		MaxMessageSize:     64 << 20,
+		RatesRefresh:       time.Hour,
+		RulesReload:        30 * time.Second,
 	}
 
 	err := errors.Wrap(errors.WithStack(arg.Parse(&c)), "failed to parse config")
@@ -122,7 +122,6 @@ func (h *Server) Start() error {
 	case <-time.After(h.timeout):
 		return nil
 	}
-	return nil
 }
 
 func (h *Server) RunUntilInterrupt() error {
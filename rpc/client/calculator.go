@@ -45,3 +45,324 @@ func (c *CalculatorClient) Calculator(ctx context.Context, in *calculatorpb.Calc
 	}
 	return resp, nil
 }
+
+// StreamStatistics opens a stream of values to keep running statistics over
+func (c *CalculatorClient) StreamStatistics(ctx context.Context) (calculatorpb.CalculatorService_StreamStatisticsClient, error) {
+	return c.c.StreamStatistics(ctx)
+}
+
+// TTest runs a t-test on the supplied samples
+func (c *CalculatorClient) TTest(ctx context.Context, in *calculatorpb.TTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	resp, err := c.c.TTest(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ChiSquareTest runs a chi-square test on the supplied counts
+func (c *CalculatorClient) ChiSquareTest(ctx context.Context, in *calculatorpb.ChiSquareTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	resp, err := c.c.ChiSquareTest(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Correlation computes the Pearson or Spearman correlation of two samples
+func (c *CalculatorClient) Correlation(ctx context.Context, in *calculatorpb.CorrelationRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	resp, err := c.c.Correlation(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Distribution evaluates a probability distribution function at the supplied points
+func (c *CalculatorClient) Distribution(ctx context.Context, in *calculatorpb.DistributionRequest) (*calculatorpb.DistributionResponse, error) {
+	resp, err := c.c.Distribution(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Random draws reproducible random samples from a distribution
+func (c *CalculatorClient) Random(ctx context.Context, in *calculatorpb.RandomRequest) (*calculatorpb.RandomResponse, error) {
+	resp, err := c.c.Random(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RollDice rolls the dice of the supplied dice notation
+func (c *CalculatorClient) RollDice(ctx context.Context, in *calculatorpb.RollDiceRequest) (*calculatorpb.RollDiceResponse, error) {
+	resp, err := c.c.RollDice(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Combinatorics computes exact combinatorial numbers as decimal strings
+func (c *CalculatorClient) Combinatorics(ctx context.Context, in *calculatorpb.CombinatoricsRequest) (*calculatorpb.CombinatoricsResponse, error) {
+	resp, err := c.c.Combinatorics(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// NumberTheory runs number theory functions on integers passed as decimal strings
+func (c *CalculatorClient) NumberTheory(ctx context.Context, in *calculatorpb.NumberTheoryRequest) (*calculatorpb.NumberTheoryResponse, error) {
+	resp, err := c.c.NumberTheory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// IntegerCalculator computes on fixed-width integers in programmer mode
+func (c *CalculatorClient) IntegerCalculator(ctx context.Context, in *calculatorpb.IntegerCalculateRequest) (*calculatorpb.IntegerCalculateResponse, error) {
+	resp, err := c.c.IntegerCalculator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FloatBits breaks a float64 down into its IEEE-754 fields
+func (c *CalculatorClient) FloatBits(ctx context.Context, in *calculatorpb.FloatBitsRequest) (*calculatorpb.FloatBitsResponse, error) {
+	resp, err := c.c.FloatBits(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UnitCalculator computes with dimensioned quantities and converts between units
+func (c *CalculatorClient) UnitCalculator(ctx context.Context, in *calculatorpb.UnitCalculateRequest) (*calculatorpb.UnitCalculateResponse, error) {
+	resp, err := c.c.UnitCalculator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ConvertCurrency converts amounts in several currencies to one currency
+func (c *CalculatorClient) ConvertCurrency(ctx context.Context, in *calculatorpb.ConvertCurrencyRequest) (*calculatorpb.ConvertCurrencyResponse, error) {
+	resp, err := c.c.ConvertCurrency(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MoneyCalculator computes allocations, percentages and taxes on exact amounts of money
+func (c *CalculatorClient) MoneyCalculator(ctx context.Context, in *calculatorpb.MoneyCalculateRequest) (*calculatorpb.MoneyCalculateResponse, error) {
+	resp, err := c.c.MoneyCalculator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TimeValue computes a time value of money function
+func (c *CalculatorClient) TimeValue(ctx context.Context, in *calculatorpb.TimeValueRequest) (*calculatorpb.TimeValueResponse, error) {
+	resp, err := c.c.TimeValue(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CashFlow computes the NPV or IRR of a series of cash flows
+func (c *CalculatorClient) CashFlow(ctx context.Context, in *calculatorpb.CashFlowRequest) (*calculatorpb.CashFlowResponse, error) {
+	resp, err := c.c.CashFlow(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Amortization streams a loan amortization schedule row by row
+func (c *CalculatorClient) Amortization(ctx context.Context, in *calculatorpb.AmortizationRequest) (calculatorpb.CalculatorService_AmortizationClient, error) {
+	return c.c.Amortization(ctx, in)
+}
+
+// Depreciation computes a depreciation schedule
+func (c *CalculatorClient) Depreciation(ctx context.Context, in *calculatorpb.DepreciationRequest) (*calculatorpb.DepreciationResponse, error) {
+	resp, err := c.c.Depreciation(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DateCalculator does date and time arithmetic, with business days and time zones
+func (c *CalculatorClient) DateCalculator(ctx context.Context, in *calculatorpb.DateCalculateRequest) (*calculatorpb.DateCalculateResponse, error) {
+	resp, err := c.c.DateCalculator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EvaluateRuleTable evaluates an amount against a named bracket table, such as a tax or shipping table, bracket by bracket
+func (c *CalculatorClient) EvaluateRuleTable(ctx context.Context, in *calculatorpb.RuleTableRequest) (*calculatorpb.RuleTableResponse, error) {
+	resp, err := c.c.EvaluateRuleTable(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Symbolic differentiates, simplifies or substitutes values into an expression symbolically
+func (c *CalculatorClient) Symbolic(ctx context.Context, in *calculatorpb.SymbolicRequest) (*calculatorpb.SymbolicResponse, error) {
+	resp, err := c.c.Symbolic(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EvaluateExpression evaluates an expression at values of its variables, and its gradient when asked to
+func (c *CalculatorClient) EvaluateExpression(ctx context.Context, in *calculatorpb.EvaluateExpressionRequest) (*calculatorpb.EvaluateExpressionResponse, error) {
+	resp, err := c.c.EvaluateExpression(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Solve Solve finds the roots of an equation
+func (c *CalculatorClient) Solve(ctx context.Context, in *calculatorpb.SolveRequest) (*calculatorpb.SolveResponse, error) {
+	resp, err := c.c.Solve(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Integrate Integrate computes a definite integral
+func (c *CalculatorClient) Integrate(ctx context.Context, in *calculatorpb.IntegrateRequest) (*calculatorpb.IntegrateResponse, error) {
+	resp, err := c.c.Integrate(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SumSeries SumSeries sums a finite or infinite series
+func (c *CalculatorClient) SumSeries(ctx context.Context, in *calculatorpb.SumSeriesRequest) (*calculatorpb.SumSeriesResponse, error) {
+	resp, err := c.c.SumSeries(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SolveODE streams the trajectory of an initial value problem
+func (c *CalculatorClient) SolveODE(ctx context.Context, in *calculatorpb.SolveODERequest) (calculatorpb.CalculatorService_SolveODEClient, error) {
+	return c.c.SolveODE(ctx, in)
+}
+
+// Plot samples expressions for a chart, optionally rendered as SVG
+func (c *CalculatorClient) Plot(ctx context.Context, in *calculatorpb.PlotRequest) (*calculatorpb.PlotResponse, error) {
+	resp, err := c.c.Plot(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Interpolate interpolates tabulated points
+func (c *CalculatorClient) Interpolate(ctx context.Context, in *calculatorpb.InterpolateRequest) (*calculatorpb.InterpolateResponse, error) {
+	resp, err := c.c.Interpolate(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Fit fits a model to points by least squares
+func (c *CalculatorClient) Fit(ctx context.Context, in *calculatorpb.FitRequest) (*calculatorpb.FitResponse, error) {
+	resp, err := c.c.Fit(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LinearProgram solves a linear program by the simplex method
+func (c *CalculatorClient) LinearProgram(ctx context.Context, in *calculatorpb.LinearProgramRequest) (*calculatorpb.LinearProgramResponse, error) {
+	resp, err := c.c.LinearProgram(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FourierTransform computes the discrete Fourier transform of samples
+func (c *CalculatorClient) FourierTransform(ctx context.Context, in *calculatorpb.FourierTransformRequest) (*calculatorpb.FourierTransformResponse, error) {
+	resp, err := c.c.FourierTransform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PowerSpectrum computes the power spectral density of samples
+func (c *CalculatorClient) PowerSpectrum(ctx context.Context, in *calculatorpb.PowerSpectrumRequest) (*calculatorpb.PowerSpectrumResponse, error) {
+	resp, err := c.c.PowerSpectrum(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Window applies a window to samples
+func (c *CalculatorClient) Window(ctx context.Context, in *calculatorpb.WindowRequest) (*calculatorpb.WindowResponse, error) {
+	resp, err := c.c.Window(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FIRFilter filters samples with a finite impulse response filter
+func (c *CalculatorClient) FIRFilter(ctx context.Context, in *calculatorpb.FIRFilterRequest) (*calculatorpb.FIRFilterResponse, error) {
+	resp, err := c.c.FIRFilter(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Geometry computes shapes, triangles, distances, polygons and geodesics
+func (c *CalculatorClient) Geometry(ctx context.Context, in *calculatorpb.GeometryRequest) (*calculatorpb.GeometryResponse, error) {
+	resp, err := c.c.Geometry(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Logic evaluates, tabulates and minimizes boolean expressions
+func (c *CalculatorClient) Logic(ctx context.Context, in *calculatorpb.LogicRequest) (*calculatorpb.LogicResponse, error) {
+	resp, err := c.c.Logic(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// IntervalCalculator computes an enclosure of an operation or an expression of intervals
+func (c *CalculatorClient) IntervalCalculator(ctx context.Context, in *calculatorpb.IntervalCalculateRequest) (*calculatorpb.IntervalCalculateResponse, error) {
+	resp, err := c.c.IntervalCalculator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -9,6 +9,7 @@ package calculatorpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -75,254 +76,12385 @@ func (OPERATOR) EnumDescriptor() ([]byte, []int) {
 	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{0}
 }
 
-type CalculateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type TTEST int32
 
-	Operator OPERATOR  `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.OPERATOR" json:"operator,omitempty"`
-	Operands *OPERANDS `protobuf:"bytes,2,opt,name=operands,proto3" json:"operands,omitempty"`
-}
+const (
+	TTEST_DEFAULT_TTEST    TTEST = 0
+	TTEST_TTEST_ONE_SAMPLE TTEST = 1
+	// TTEST_TWO_SAMPLE assumes equal variances and pools them.
+	TTEST_TTEST_TWO_SAMPLE TTEST = 2
+	TTEST_TTEST_WELCH      TTEST = 3
+)
 
-func (x *CalculateRequest) Reset() {
-	*x = CalculateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TTEST.
+var (
+	TTEST_name = map[int32]string{
+		0: "DEFAULT_TTEST",
+		1: "TTEST_ONE_SAMPLE",
+		2: "TTEST_TWO_SAMPLE",
+		3: "TTEST_WELCH",
 	}
+	TTEST_value = map[string]int32{
+		"DEFAULT_TTEST":    0,
+		"TTEST_ONE_SAMPLE": 1,
+		"TTEST_TWO_SAMPLE": 2,
+		"TTEST_WELCH":      3,
+	}
+)
+
+func (x TTEST) Enum() *TTEST {
+	p := new(TTEST)
+	*p = x
+	return p
 }
 
-func (x *CalculateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TTEST) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CalculateRequest) ProtoMessage() {}
+func (TTEST) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[1].Descriptor()
+}
 
-func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TTEST) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[1]
 }
 
-// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
-func (*CalculateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{0}
+func (x TTEST) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CalculateRequest) GetOperator() OPERATOR {
-	if x != nil {
-		return x.Operator
-	}
-	return OPERATOR_DEFAULT_OPERATOR
+// Deprecated: Use TTEST.Descriptor instead.
+func (TTEST) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CalculateRequest) GetOperands() *OPERANDS {
-	if x != nil {
-		return x.Operands
+type CHI_SQUARE_TEST int32
+
+const (
+	CHI_SQUARE_TEST_DEFAULT_CHI_SQUARE_TEST         CHI_SQUARE_TEST = 0
+	CHI_SQUARE_TEST_CHI_SQUARE_TEST_GOODNESS_OF_FIT CHI_SQUARE_TEST = 1
+	CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE    CHI_SQUARE_TEST = 2
+)
+
+// Enum value maps for CHI_SQUARE_TEST.
+var (
+	CHI_SQUARE_TEST_name = map[int32]string{
+		0: "DEFAULT_CHI_SQUARE_TEST",
+		1: "CHI_SQUARE_TEST_GOODNESS_OF_FIT",
+		2: "CHI_SQUARE_TEST_INDEPENDENCE",
 	}
-	return nil
+	CHI_SQUARE_TEST_value = map[string]int32{
+		"DEFAULT_CHI_SQUARE_TEST":         0,
+		"CHI_SQUARE_TEST_GOODNESS_OF_FIT": 1,
+		"CHI_SQUARE_TEST_INDEPENDENCE":    2,
+	}
+)
+
+func (x CHI_SQUARE_TEST) Enum() *CHI_SQUARE_TEST {
+	p := new(CHI_SQUARE_TEST)
+	*p = x
+	return p
 }
 
-type OPERANDS struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x CHI_SQUARE_TEST) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Number_1 float64 `protobuf:"fixed64,1,opt,name=number_1,json=number1,proto3" json:"number_1,omitempty"`
-	Number_2 float64 `protobuf:"fixed64,2,opt,name=number_2,json=number2,proto3" json:"number_2,omitempty"`
+func (CHI_SQUARE_TEST) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[2].Descriptor()
 }
 
-func (x *OPERANDS) Reset() {
-	*x = OPERANDS{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (CHI_SQUARE_TEST) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[2]
 }
 
-func (x *OPERANDS) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CHI_SQUARE_TEST) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*OPERANDS) ProtoMessage() {}
+// Deprecated: Use CHI_SQUARE_TEST.Descriptor instead.
+func (CHI_SQUARE_TEST) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{2}
+}
 
-func (x *OPERANDS) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type CORRELATION int32
+
+const (
+	CORRELATION_DEFAULT_CORRELATION  CORRELATION = 0
+	CORRELATION_CORRELATION_PEARSON  CORRELATION = 1
+	CORRELATION_CORRELATION_SPEARMAN CORRELATION = 2
+)
+
+// Enum value maps for CORRELATION.
+var (
+	CORRELATION_name = map[int32]string{
+		0: "DEFAULT_CORRELATION",
+		1: "CORRELATION_PEARSON",
+		2: "CORRELATION_SPEARMAN",
 	}
-	return mi.MessageOf(x)
+	CORRELATION_value = map[string]int32{
+		"DEFAULT_CORRELATION":  0,
+		"CORRELATION_PEARSON":  1,
+		"CORRELATION_SPEARMAN": 2,
+	}
+)
+
+func (x CORRELATION) Enum() *CORRELATION {
+	p := new(CORRELATION)
+	*p = x
+	return p
 }
 
-// Deprecated: Use OPERANDS.ProtoReflect.Descriptor instead.
-func (*OPERANDS) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{1}
+func (x CORRELATION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *OPERANDS) GetNumber_1() float64 {
-	if x != nil {
-		return x.Number_1
-	}
-	return 0
+func (CORRELATION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[3].Descriptor()
 }
 
-func (x *OPERANDS) GetNumber_2() float64 {
-	if x != nil {
-		return x.Number_2
-	}
-	return 0
+func (CORRELATION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[3]
 }
 
-type CalculateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x CORRELATION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+// Deprecated: Use CORRELATION.Descriptor instead.
+func (CORRELATION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CalculateResponse) Reset() {
-	*x = CalculateResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// ALTERNATIVE is the alternative hypothesis, tests are two-sided by default.
+type ALTERNATIVE int32
+
+const (
+	ALTERNATIVE_ALTERNATIVE_TWO_SIDED ALTERNATIVE = 0
+	ALTERNATIVE_ALTERNATIVE_LESS      ALTERNATIVE = 1
+	ALTERNATIVE_ALTERNATIVE_GREATER   ALTERNATIVE = 2
+)
+
+// Enum value maps for ALTERNATIVE.
+var (
+	ALTERNATIVE_name = map[int32]string{
+		0: "ALTERNATIVE_TWO_SIDED",
+		1: "ALTERNATIVE_LESS",
+		2: "ALTERNATIVE_GREATER",
 	}
+	ALTERNATIVE_value = map[string]int32{
+		"ALTERNATIVE_TWO_SIDED": 0,
+		"ALTERNATIVE_LESS":      1,
+		"ALTERNATIVE_GREATER":   2,
+	}
+)
+
+func (x ALTERNATIVE) Enum() *ALTERNATIVE {
+	p := new(ALTERNATIVE)
+	*p = x
+	return p
 }
 
-func (x *CalculateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ALTERNATIVE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CalculateResponse) ProtoMessage() {}
+func (ALTERNATIVE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[4].Descriptor()
+}
 
-func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (ALTERNATIVE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[4]
+}
+
+func (x ALTERNATIVE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ALTERNATIVE.Descriptor instead.
+func (ALTERNATIVE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{4}
+}
+
+// DISTRIBUTION names a probability distribution and the parameters it takes,
+// parameters with a default may be left out.
+type DISTRIBUTION int32
+
+const (
+	DISTRIBUTION_DEFAULT_DISTRIBUTION DISTRIBUTION = 0
+	// mu (default 0) and sigma (default 1).
+	DISTRIBUTION_DISTRIBUTION_NORMAL DISTRIBUTION = 1
+	// df.
+	DISTRIBUTION_DISTRIBUTION_STUDENT_T DISTRIBUTION = 2
+	// df.
+	DISTRIBUTION_DISTRIBUTION_CHI_SQUARE DISTRIBUTION = 3
+	// n and p.
+	DISTRIBUTION_DISTRIBUTION_BINOMIAL DISTRIBUTION = 4
+	// lambda.
+	DISTRIBUTION_DISTRIBUTION_POISSON DISTRIBUTION = 5
+	// rate (default 1).
+	DISTRIBUTION_DISTRIBUTION_EXPONENTIAL DISTRIBUTION = 6
+	// a (default 0) and b (default 1).
+	DISTRIBUTION_DISTRIBUTION_UNIFORM DISTRIBUTION = 7
+	// shape and scale (default 1).
+	DISTRIBUTION_DISTRIBUTION_GAMMA DISTRIBUTION = 8
+	// alpha and beta.
+	DISTRIBUTION_DISTRIBUTION_BETA DISTRIBUTION = 9
+)
+
+// Enum value maps for DISTRIBUTION.
+var (
+	DISTRIBUTION_name = map[int32]string{
+		0: "DEFAULT_DISTRIBUTION",
+		1: "DISTRIBUTION_NORMAL",
+		2: "DISTRIBUTION_STUDENT_T",
+		3: "DISTRIBUTION_CHI_SQUARE",
+		4: "DISTRIBUTION_BINOMIAL",
+		5: "DISTRIBUTION_POISSON",
+		6: "DISTRIBUTION_EXPONENTIAL",
+		7: "DISTRIBUTION_UNIFORM",
+		8: "DISTRIBUTION_GAMMA",
+		9: "DISTRIBUTION_BETA",
 	}
-	return mi.MessageOf(x)
+	DISTRIBUTION_value = map[string]int32{
+		"DEFAULT_DISTRIBUTION":     0,
+		"DISTRIBUTION_NORMAL":      1,
+		"DISTRIBUTION_STUDENT_T":   2,
+		"DISTRIBUTION_CHI_SQUARE":  3,
+		"DISTRIBUTION_BINOMIAL":    4,
+		"DISTRIBUTION_POISSON":     5,
+		"DISTRIBUTION_EXPONENTIAL": 6,
+		"DISTRIBUTION_UNIFORM":     7,
+		"DISTRIBUTION_GAMMA":       8,
+		"DISTRIBUTION_BETA":        9,
+	}
+)
+
+func (x DISTRIBUTION) Enum() *DISTRIBUTION {
+	p := new(DISTRIBUTION)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CalculateResponse.ProtoReflect.Descriptor instead.
-func (*CalculateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{2}
+func (x DISTRIBUTION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CalculateResponse) GetResult() float64 {
-	if x != nil {
-		return x.Result
+func (DISTRIBUTION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[5].Descriptor()
+}
+
+func (DISTRIBUTION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[5]
+}
+
+func (x DISTRIBUTION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DISTRIBUTION.Descriptor instead.
+func (DISTRIBUTION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{5}
+}
+
+type DISTRIBUTION_FUNCTION int32
+
+const (
+	DISTRIBUTION_FUNCTION_DEFAULT_DISTRIBUTION_FUNCTION DISTRIBUTION_FUNCTION = 0
+	// DISTRIBUTION_FUNCTION_PDF is the probability mass function for the
+	// binomial and Poisson distributions.
+	DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_PDF DISTRIBUTION_FUNCTION = 1
+	DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_CDF DISTRIBUTION_FUNCTION = 2
+	// DISTRIBUTION_FUNCTION_QUANTILE is the inverse CDF, points are probabilities.
+	DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_QUANTILE DISTRIBUTION_FUNCTION = 3
+)
+
+// Enum value maps for DISTRIBUTION_FUNCTION.
+var (
+	DISTRIBUTION_FUNCTION_name = map[int32]string{
+		0: "DEFAULT_DISTRIBUTION_FUNCTION",
+		1: "DISTRIBUTION_FUNCTION_PDF",
+		2: "DISTRIBUTION_FUNCTION_CDF",
+		3: "DISTRIBUTION_FUNCTION_QUANTILE",
 	}
-	return 0
+	DISTRIBUTION_FUNCTION_value = map[string]int32{
+		"DEFAULT_DISTRIBUTION_FUNCTION":  0,
+		"DISTRIBUTION_FUNCTION_PDF":      1,
+		"DISTRIBUTION_FUNCTION_CDF":      2,
+		"DISTRIBUTION_FUNCTION_QUANTILE": 3,
+	}
+)
+
+func (x DISTRIBUTION_FUNCTION) Enum() *DISTRIBUTION_FUNCTION {
+	p := new(DISTRIBUTION_FUNCTION)
+	*p = x
+	return p
 }
 
-var File_rpc_proto_calculatorpb_calculator_proto protoreflect.FileDescriptor
+func (x DISTRIBUTION_FUNCTION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-var file_rpc_proto_calculatorpb_calculator_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x22, 0x7a, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x32, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
-	0x2e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x4e, 0x44, 0x53, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x6e, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x4e, 0x44, 0x53, 0x12,
-	0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x32, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x2a, 0x75, 0x0a, 0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x12, 0x14,
-	0x0a, 0x10, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
-	0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x13, 0x0a,
-	0x0f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45,
-	0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53,
-	0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x04, 0x32, 0x64, 0x0a, 0x11, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f,
-	0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x63,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x10, 0x5a, 0x0e, 0x2e, 0x3b, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (DISTRIBUTION_FUNCTION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[6].Descriptor()
+}
+
+func (DISTRIBUTION_FUNCTION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[6]
+}
+
+func (x DISTRIBUTION_FUNCTION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
+// Deprecated: Use DISTRIBUTION_FUNCTION.Descriptor instead.
+func (DISTRIBUTION_FUNCTION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{6}
+}
+
+type COMBINATORICS int32
+
+const (
+	COMBINATORICS_DEFAULT_COMBINATORICS COMBINATORICS = 0
+	// n!
+	COMBINATORICS_COMBINATORICS_FACTORIAL COMBINATORICS = 1
+	// nPr, the ordered selections of k out of n.
+	COMBINATORICS_COMBINATORICS_PERMUTATIONS COMBINATORICS = 2
+	// nCr, the unordered selections of k out of n.
+	COMBINATORICS_COMBINATORICS_COMBINATIONS COMBINATORICS = 3
+	// the multinomial coefficient of the group sizes.
+	COMBINATORICS_COMBINATORICS_MULTINOMIAL COMBINATORICS = 4
+	// the nth Catalan number.
+	COMBINATORICS_COMBINATORICS_CATALAN COMBINATORICS = 5
+	// the nth Fibonacci number, F(0) = 0 and F(1) = 1.
+	COMBINATORICS_COMBINATORICS_FIBONACCI COMBINATORICS = 6
+	// the probability of exactly k successes in n trials.
+	COMBINATORICS_COMBINATORICS_BINOMIAL_PROBABILITY COMBINATORICS = 7
+)
+
+// Enum value maps for COMBINATORICS.
 var (
-	file_rpc_proto_calculatorpb_calculator_proto_rawDescOnce sync.Once
-	file_rpc_proto_calculatorpb_calculator_proto_rawDescData = file_rpc_proto_calculatorpb_calculator_proto_rawDesc
+	COMBINATORICS_name = map[int32]string{
+		0: "DEFAULT_COMBINATORICS",
+		1: "COMBINATORICS_FACTORIAL",
+		2: "COMBINATORICS_PERMUTATIONS",
+		3: "COMBINATORICS_COMBINATIONS",
+		4: "COMBINATORICS_MULTINOMIAL",
+		5: "COMBINATORICS_CATALAN",
+		6: "COMBINATORICS_FIBONACCI",
+		7: "COMBINATORICS_BINOMIAL_PROBABILITY",
+	}
+	COMBINATORICS_value = map[string]int32{
+		"DEFAULT_COMBINATORICS":              0,
+		"COMBINATORICS_FACTORIAL":            1,
+		"COMBINATORICS_PERMUTATIONS":         2,
+		"COMBINATORICS_COMBINATIONS":         3,
+		"COMBINATORICS_MULTINOMIAL":          4,
+		"COMBINATORICS_CATALAN":              5,
+		"COMBINATORICS_FIBONACCI":            6,
+		"COMBINATORICS_BINOMIAL_PROBABILITY": 7,
+	}
 )
 
-func file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP() []byte {
-	file_rpc_proto_calculatorpb_calculator_proto_rawDescOnce.Do(func() {
-		file_rpc_proto_calculatorpb_calculator_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_proto_calculatorpb_calculator_proto_rawDescData)
-	})
-	return file_rpc_proto_calculatorpb_calculator_proto_rawDescData
+func (x COMBINATORICS) Enum() *COMBINATORICS {
+	p := new(COMBINATORICS)
+	*p = x
+	return p
 }
 
-var file_rpc_proto_calculatorpb_calculator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_proto_calculatorpb_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_rpc_proto_calculatorpb_calculator_proto_goTypes = []interface{}{
-	(OPERATOR)(0),             // 0: calculatorpb.OPERATOR
-	(*CalculateRequest)(nil),  // 1: calculatorpb.CalculateRequest
-	(*OPERANDS)(nil),          // 2: calculatorpb.OPERANDS
-	(*CalculateResponse)(nil), // 3: calculatorpb.CalculateResponse
+func (x COMBINATORICS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-var file_rpc_proto_calculatorpb_calculator_proto_depIdxs = []int32{
-	0, // 0: calculatorpb.CalculateRequest.operator:type_name -> calculatorpb.OPERATOR
-	2, // 1: calculatorpb.CalculateRequest.operands:type_name -> calculatorpb.OPERANDS
-	1, // 2: calculatorpb.CalculatorService.Calculator:input_type -> calculatorpb.CalculateRequest
-	3, // 3: calculatorpb.CalculatorService.Calculator:output_type -> calculatorpb.CalculateResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (COMBINATORICS) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[7].Descriptor()
 }
 
-func init() { file_rpc_proto_calculatorpb_calculator_proto_init() }
-func file_rpc_proto_calculatorpb_calculator_proto_init() {
-	if File_rpc_proto_calculatorpb_calculator_proto != nil {
-		return
+func (COMBINATORICS) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[7]
+}
+
+func (x COMBINATORICS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use COMBINATORICS.Descriptor instead.
+func (COMBINATORICS) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{7}
+}
+
+type NUMBER_THEORY int32
+
+const (
+	NUMBER_THEORY_DEFAULT_NUMBER_THEORY NUMBER_THEORY = 0
+	// is_prime of the first operand, deterministic below 2^64.
+	NUMBER_THEORY_NUMBER_THEORY_IS_PRIME NUMBER_THEORY = 1
+	// factors of the first operand.
+	NUMBER_THEORY_NUMBER_THEORY_FACTORIZE NUMBER_THEORY = 2
+	// result is the greatest common divisor of all operands.
+	NUMBER_THEORY_NUMBER_THEORY_GCD NUMBER_THEORY = 3
+	// result is the least common multiple of all operands.
+	NUMBER_THEORY_NUMBER_THEORY_LCM NUMBER_THEORY = 4
+	// result is gcd(a, b) of the two operands, with bezout holding x and y
+	// such that ax + by = gcd(a, b).
+	NUMBER_THEORY_NUMBER_THEORY_EXTENDED_GCD NUMBER_THEORY = 5
+	// result is the inverse of the first operand modulo the modulus.
+	NUMBER_THEORY_NUMBER_THEORY_MOD_INVERSE NUMBER_THEORY = 6
+	// result is the first operand raised to the second, modulo the modulus.
+	NUMBER_THEORY_NUMBER_THEORY_MOD_POW NUMBER_THEORY = 7
+	// result is Euler's totient of the first operand.
+	NUMBER_THEORY_NUMBER_THEORY_TOTIENT NUMBER_THEORY = 8
+)
+
+// Enum value maps for NUMBER_THEORY.
+var (
+	NUMBER_THEORY_name = map[int32]string{
+		0: "DEFAULT_NUMBER_THEORY",
+		1: "NUMBER_THEORY_IS_PRIME",
+		2: "NUMBER_THEORY_FACTORIZE",
+		3: "NUMBER_THEORY_GCD",
+		4: "NUMBER_THEORY_LCM",
+		5: "NUMBER_THEORY_EXTENDED_GCD",
+		6: "NUMBER_THEORY_MOD_INVERSE",
+		7: "NUMBER_THEORY_MOD_POW",
+		8: "NUMBER_THEORY_TOTIENT",
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalculateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
+	NUMBER_THEORY_value = map[string]int32{
+		"DEFAULT_NUMBER_THEORY":      0,
+		"NUMBER_THEORY_IS_PRIME":     1,
+		"NUMBER_THEORY_FACTORIZE":    2,
+		"NUMBER_THEORY_GCD":          3,
+		"NUMBER_THEORY_LCM":          4,
+		"NUMBER_THEORY_EXTENDED_GCD": 5,
+		"NUMBER_THEORY_MOD_INVERSE":  6,
+		"NUMBER_THEORY_MOD_POW":      7,
+		"NUMBER_THEORY_TOTIENT":      8,
+	}
+)
+
+func (x NUMBER_THEORY) Enum() *NUMBER_THEORY {
+	p := new(NUMBER_THEORY)
+	*p = x
+	return p
+}
+
+func (x NUMBER_THEORY) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NUMBER_THEORY) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[8].Descriptor()
+}
+
+func (NUMBER_THEORY) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[8]
+}
+
+func (x NUMBER_THEORY) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NUMBER_THEORY.Descriptor instead.
+func (NUMBER_THEORY) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{8}
+}
+
+type INTEGER_TYPE int32
+
+const (
+	INTEGER_TYPE_DEFAULT_INTEGER_TYPE INTEGER_TYPE = 0
+	INTEGER_TYPE_INTEGER_TYPE_INT8    INTEGER_TYPE = 1
+	INTEGER_TYPE_INTEGER_TYPE_INT16   INTEGER_TYPE = 2
+	INTEGER_TYPE_INTEGER_TYPE_INT32   INTEGER_TYPE = 3
+	INTEGER_TYPE_INTEGER_TYPE_INT64   INTEGER_TYPE = 4
+	INTEGER_TYPE_INTEGER_TYPE_UINT8   INTEGER_TYPE = 5
+	INTEGER_TYPE_INTEGER_TYPE_UINT16  INTEGER_TYPE = 6
+	INTEGER_TYPE_INTEGER_TYPE_UINT32  INTEGER_TYPE = 7
+	INTEGER_TYPE_INTEGER_TYPE_UINT64  INTEGER_TYPE = 8
+)
+
+// Enum value maps for INTEGER_TYPE.
+var (
+	INTEGER_TYPE_name = map[int32]string{
+		0: "DEFAULT_INTEGER_TYPE",
+		1: "INTEGER_TYPE_INT8",
+		2: "INTEGER_TYPE_INT16",
+		3: "INTEGER_TYPE_INT32",
+		4: "INTEGER_TYPE_INT64",
+		5: "INTEGER_TYPE_UINT8",
+		6: "INTEGER_TYPE_UINT16",
+		7: "INTEGER_TYPE_UINT32",
+		8: "INTEGER_TYPE_UINT64",
+	}
+	INTEGER_TYPE_value = map[string]int32{
+		"DEFAULT_INTEGER_TYPE": 0,
+		"INTEGER_TYPE_INT8":    1,
+		"INTEGER_TYPE_INT16":   2,
+		"INTEGER_TYPE_INT32":   3,
+		"INTEGER_TYPE_INT64":   4,
+		"INTEGER_TYPE_UINT8":   5,
+		"INTEGER_TYPE_UINT16":  6,
+		"INTEGER_TYPE_UINT32":  7,
+		"INTEGER_TYPE_UINT64":  8,
+	}
+)
+
+func (x INTEGER_TYPE) Enum() *INTEGER_TYPE {
+	p := new(INTEGER_TYPE)
+	*p = x
+	return p
+}
+
+func (x INTEGER_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (INTEGER_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[9].Descriptor()
+}
+
+func (INTEGER_TYPE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[9]
+}
+
+func (x INTEGER_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use INTEGER_TYPE.Descriptor instead.
+func (INTEGER_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{9}
+}
+
+// OVERFLOW is what happens when a result doesn't fit its type, it wraps around
+// by default.
+type OVERFLOW int32
+
+const (
+	OVERFLOW_OVERFLOW_WRAP     OVERFLOW = 0
+	OVERFLOW_OVERFLOW_SATURATE OVERFLOW = 1
+	// OVERFLOW_CHECKED fails the request instead.
+	OVERFLOW_OVERFLOW_CHECKED OVERFLOW = 2
+)
+
+// Enum value maps for OVERFLOW.
+var (
+	OVERFLOW_name = map[int32]string{
+		0: "OVERFLOW_WRAP",
+		1: "OVERFLOW_SATURATE",
+		2: "OVERFLOW_CHECKED",
+	}
+	OVERFLOW_value = map[string]int32{
+		"OVERFLOW_WRAP":     0,
+		"OVERFLOW_SATURATE": 1,
+		"OVERFLOW_CHECKED":  2,
+	}
+)
+
+func (x OVERFLOW) Enum() *OVERFLOW {
+	p := new(OVERFLOW)
+	*p = x
+	return p
+}
+
+func (x OVERFLOW) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OVERFLOW) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[10].Descriptor()
+}
+
+func (OVERFLOW) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[10]
+}
+
+func (x OVERFLOW) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OVERFLOW.Descriptor instead.
+func (OVERFLOW) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{10}
+}
+
+type INTEGER_OPERATOR int32
+
+const (
+	INTEGER_OPERATOR_DEFAULT_INTEGER_OPERATOR  INTEGER_OPERATOR = 0
+	INTEGER_OPERATOR_INTEGER_OPERATOR_ADD      INTEGER_OPERATOR = 1
+	INTEGER_OPERATOR_INTEGER_OPERATOR_SUBTRACT INTEGER_OPERATOR = 2
+	INTEGER_OPERATOR_INTEGER_OPERATOR_MULTIPLY INTEGER_OPERATOR = 3
+	// INTEGER_OPERATOR_DIVIDE truncates towards zero.
+	INTEGER_OPERATOR_INTEGER_OPERATOR_DIVIDE INTEGER_OPERATOR = 4
+	// INTEGER_OPERATOR_REMAINDER has the sign of the dividend.
+	INTEGER_OPERATOR_INTEGER_OPERATOR_REMAINDER  INTEGER_OPERATOR = 5
+	INTEGER_OPERATOR_INTEGER_OPERATOR_NEGATE     INTEGER_OPERATOR = 6
+	INTEGER_OPERATOR_INTEGER_OPERATOR_AND        INTEGER_OPERATOR = 7
+	INTEGER_OPERATOR_INTEGER_OPERATOR_OR         INTEGER_OPERATOR = 8
+	INTEGER_OPERATOR_INTEGER_OPERATOR_XOR        INTEGER_OPERATOR = 9
+	INTEGER_OPERATOR_INTEGER_OPERATOR_NOT        INTEGER_OPERATOR = 10
+	INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_LEFT INTEGER_OPERATOR = 11
+	// INTEGER_OPERATOR_SHIFT_RIGHT is arithmetic for signed types and logical
+	// for unsigned ones.
+	INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_RIGHT  INTEGER_OPERATOR = 12
+	INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_LEFT  INTEGER_OPERATOR = 13
+	INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_RIGHT INTEGER_OPERATOR = 14
+)
+
+// Enum value maps for INTEGER_OPERATOR.
+var (
+	INTEGER_OPERATOR_name = map[int32]string{
+		0:  "DEFAULT_INTEGER_OPERATOR",
+		1:  "INTEGER_OPERATOR_ADD",
+		2:  "INTEGER_OPERATOR_SUBTRACT",
+		3:  "INTEGER_OPERATOR_MULTIPLY",
+		4:  "INTEGER_OPERATOR_DIVIDE",
+		5:  "INTEGER_OPERATOR_REMAINDER",
+		6:  "INTEGER_OPERATOR_NEGATE",
+		7:  "INTEGER_OPERATOR_AND",
+		8:  "INTEGER_OPERATOR_OR",
+		9:  "INTEGER_OPERATOR_XOR",
+		10: "INTEGER_OPERATOR_NOT",
+		11: "INTEGER_OPERATOR_SHIFT_LEFT",
+		12: "INTEGER_OPERATOR_SHIFT_RIGHT",
+		13: "INTEGER_OPERATOR_ROTATE_LEFT",
+		14: "INTEGER_OPERATOR_ROTATE_RIGHT",
+	}
+	INTEGER_OPERATOR_value = map[string]int32{
+		"DEFAULT_INTEGER_OPERATOR":      0,
+		"INTEGER_OPERATOR_ADD":          1,
+		"INTEGER_OPERATOR_SUBTRACT":     2,
+		"INTEGER_OPERATOR_MULTIPLY":     3,
+		"INTEGER_OPERATOR_DIVIDE":       4,
+		"INTEGER_OPERATOR_REMAINDER":    5,
+		"INTEGER_OPERATOR_NEGATE":       6,
+		"INTEGER_OPERATOR_AND":          7,
+		"INTEGER_OPERATOR_OR":           8,
+		"INTEGER_OPERATOR_XOR":          9,
+		"INTEGER_OPERATOR_NOT":          10,
+		"INTEGER_OPERATOR_SHIFT_LEFT":   11,
+		"INTEGER_OPERATOR_SHIFT_RIGHT":  12,
+		"INTEGER_OPERATOR_ROTATE_LEFT":  13,
+		"INTEGER_OPERATOR_ROTATE_RIGHT": 14,
+	}
+)
+
+func (x INTEGER_OPERATOR) Enum() *INTEGER_OPERATOR {
+	p := new(INTEGER_OPERATOR)
+	*p = x
+	return p
+}
+
+func (x INTEGER_OPERATOR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (INTEGER_OPERATOR) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[11].Descriptor()
+}
+
+func (INTEGER_OPERATOR) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[11]
+}
+
+func (x INTEGER_OPERATOR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use INTEGER_OPERATOR.Descriptor instead.
+func (INTEGER_OPERATOR) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{11}
+}
+
+type FLOAT_CLASS int32
+
+const (
+	FLOAT_CLASS_FLOAT_CLASS_ZERO      FLOAT_CLASS = 0
+	FLOAT_CLASS_FLOAT_CLASS_SUBNORMAL FLOAT_CLASS = 1
+	FLOAT_CLASS_FLOAT_CLASS_NORMAL    FLOAT_CLASS = 2
+	FLOAT_CLASS_FLOAT_CLASS_INFINITE  FLOAT_CLASS = 3
+	FLOAT_CLASS_FLOAT_CLASS_NAN       FLOAT_CLASS = 4
+)
+
+// Enum value maps for FLOAT_CLASS.
+var (
+	FLOAT_CLASS_name = map[int32]string{
+		0: "FLOAT_CLASS_ZERO",
+		1: "FLOAT_CLASS_SUBNORMAL",
+		2: "FLOAT_CLASS_NORMAL",
+		3: "FLOAT_CLASS_INFINITE",
+		4: "FLOAT_CLASS_NAN",
+	}
+	FLOAT_CLASS_value = map[string]int32{
+		"FLOAT_CLASS_ZERO":      0,
+		"FLOAT_CLASS_SUBNORMAL": 1,
+		"FLOAT_CLASS_NORMAL":    2,
+		"FLOAT_CLASS_INFINITE":  3,
+		"FLOAT_CLASS_NAN":       4,
+	}
+)
+
+func (x FLOAT_CLASS) Enum() *FLOAT_CLASS {
+	p := new(FLOAT_CLASS)
+	*p = x
+	return p
+}
+
+func (x FLOAT_CLASS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FLOAT_CLASS) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[12].Descriptor()
+}
+
+func (FLOAT_CLASS) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[12]
+}
+
+func (x FLOAT_CLASS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FLOAT_CLASS.Descriptor instead.
+func (FLOAT_CLASS) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{12}
+}
+
+type UNIT_OPERATOR int32
+
+const (
+	UNIT_OPERATOR_DEFAULT_UNIT_OPERATOR  UNIT_OPERATOR = 0
+	UNIT_OPERATOR_UNIT_OPERATOR_ADD      UNIT_OPERATOR = 1
+	UNIT_OPERATOR_UNIT_OPERATOR_SUBTRACT UNIT_OPERATOR = 2
+	UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY UNIT_OPERATOR = 3
+	UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE   UNIT_OPERATOR = 4
+	UNIT_OPERATOR_UNIT_OPERATOR_CONVERT  UNIT_OPERATOR = 5
+)
+
+// Enum value maps for UNIT_OPERATOR.
+var (
+	UNIT_OPERATOR_name = map[int32]string{
+		0: "DEFAULT_UNIT_OPERATOR",
+		1: "UNIT_OPERATOR_ADD",
+		2: "UNIT_OPERATOR_SUBTRACT",
+		3: "UNIT_OPERATOR_MULTIPLY",
+		4: "UNIT_OPERATOR_DIVIDE",
+		5: "UNIT_OPERATOR_CONVERT",
+	}
+	UNIT_OPERATOR_value = map[string]int32{
+		"DEFAULT_UNIT_OPERATOR":  0,
+		"UNIT_OPERATOR_ADD":      1,
+		"UNIT_OPERATOR_SUBTRACT": 2,
+		"UNIT_OPERATOR_MULTIPLY": 3,
+		"UNIT_OPERATOR_DIVIDE":   4,
+		"UNIT_OPERATOR_CONVERT":  5,
+	}
+)
+
+func (x UNIT_OPERATOR) Enum() *UNIT_OPERATOR {
+	p := new(UNIT_OPERATOR)
+	*p = x
+	return p
+}
+
+func (x UNIT_OPERATOR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UNIT_OPERATOR) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[13].Descriptor()
+}
+
+func (UNIT_OPERATOR) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[13]
+}
+
+func (x UNIT_OPERATOR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UNIT_OPERATOR.Descriptor instead.
+func (UNIT_OPERATOR) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{13}
+}
+
+// ROUNDING is applied at the minor unit of a currency, e.g. cents.
+type ROUNDING int32
+
+const (
+	// ROUNDING_HALF_EVEN rounds ties to the even neighbour, as bankers do.
+	ROUNDING_ROUNDING_HALF_EVEN ROUNDING = 0
+	// ROUNDING_HALF_UP rounds ties away from zero.
+	ROUNDING_ROUNDING_HALF_UP ROUNDING = 1
+	// ROUNDING_HALF_DOWN rounds ties towards zero.
+	ROUNDING_ROUNDING_HALF_DOWN ROUNDING = 2
+	// ROUNDING_DOWN truncates towards zero.
+	ROUNDING_ROUNDING_DOWN ROUNDING = 3
+	// ROUNDING_UP rounds away from zero.
+	ROUNDING_ROUNDING_UP      ROUNDING = 4
+	ROUNDING_ROUNDING_CEILING ROUNDING = 5
+	ROUNDING_ROUNDING_FLOOR   ROUNDING = 6
+)
+
+// Enum value maps for ROUNDING.
+var (
+	ROUNDING_name = map[int32]string{
+		0: "ROUNDING_HALF_EVEN",
+		1: "ROUNDING_HALF_UP",
+		2: "ROUNDING_HALF_DOWN",
+		3: "ROUNDING_DOWN",
+		4: "ROUNDING_UP",
+		5: "ROUNDING_CEILING",
+		6: "ROUNDING_FLOOR",
+	}
+	ROUNDING_value = map[string]int32{
+		"ROUNDING_HALF_EVEN": 0,
+		"ROUNDING_HALF_UP":   1,
+		"ROUNDING_HALF_DOWN": 2,
+		"ROUNDING_DOWN":      3,
+		"ROUNDING_UP":        4,
+		"ROUNDING_CEILING":   5,
+		"ROUNDING_FLOOR":     6,
+	}
+)
+
+func (x ROUNDING) Enum() *ROUNDING {
+	p := new(ROUNDING)
+	*p = x
+	return p
+}
+
+func (x ROUNDING) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ROUNDING) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[14].Descriptor()
+}
+
+func (ROUNDING) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[14]
+}
+
+func (x ROUNDING) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ROUNDING.Descriptor instead.
+func (ROUNDING) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{14}
+}
+
+type MONEY_OPERATOR int32
+
+const (
+	MONEY_OPERATOR_DEFAULT_MONEY_OPERATOR MONEY_OPERATOR = 0
+	// MONEY_OPERATOR_ALLOCATE splits amount by ratios into parts that sum to it.
+	MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE MONEY_OPERATOR = 1
+	// MONEY_OPERATOR_PERCENT_OF is percent of amount.
+	MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_OF MONEY_OPERATOR = 2
+	// MONEY_OPERATOR_PERCENT_CHANGE is the change from amount to other in percent.
+	MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE MONEY_OPERATOR = 3
+	// MONEY_OPERATOR_MARKUP is the price of a cost of amount with a markup of percent.
+	MONEY_OPERATOR_MONEY_OPERATOR_MARKUP MONEY_OPERATOR = 4
+	// MONEY_OPERATOR_MARGIN is the price of a cost of amount with a margin of percent.
+	MONEY_OPERATOR_MONEY_OPERATOR_MARGIN MONEY_OPERATOR = 5
+	// MONEY_OPERATOR_ADD_TAX adds a tax of percent to the net amount.
+	MONEY_OPERATOR_MONEY_OPERATOR_ADD_TAX MONEY_OPERATOR = 6
+	// MONEY_OPERATOR_REMOVE_TAX takes a tax of percent out of the gross amount.
+	MONEY_OPERATOR_MONEY_OPERATOR_REMOVE_TAX MONEY_OPERATOR = 7
+)
+
+// Enum value maps for MONEY_OPERATOR.
+var (
+	MONEY_OPERATOR_name = map[int32]string{
+		0: "DEFAULT_MONEY_OPERATOR",
+		1: "MONEY_OPERATOR_ALLOCATE",
+		2: "MONEY_OPERATOR_PERCENT_OF",
+		3: "MONEY_OPERATOR_PERCENT_CHANGE",
+		4: "MONEY_OPERATOR_MARKUP",
+		5: "MONEY_OPERATOR_MARGIN",
+		6: "MONEY_OPERATOR_ADD_TAX",
+		7: "MONEY_OPERATOR_REMOVE_TAX",
+	}
+	MONEY_OPERATOR_value = map[string]int32{
+		"DEFAULT_MONEY_OPERATOR":        0,
+		"MONEY_OPERATOR_ALLOCATE":       1,
+		"MONEY_OPERATOR_PERCENT_OF":     2,
+		"MONEY_OPERATOR_PERCENT_CHANGE": 3,
+		"MONEY_OPERATOR_MARKUP":         4,
+		"MONEY_OPERATOR_MARGIN":         5,
+		"MONEY_OPERATOR_ADD_TAX":        6,
+		"MONEY_OPERATOR_REMOVE_TAX":     7,
+	}
+)
+
+func (x MONEY_OPERATOR) Enum() *MONEY_OPERATOR {
+	p := new(MONEY_OPERATOR)
+	*p = x
+	return p
+}
+
+func (x MONEY_OPERATOR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MONEY_OPERATOR) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[15].Descriptor()
+}
+
+func (MONEY_OPERATOR) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[15]
+}
+
+func (x MONEY_OPERATOR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MONEY_OPERATOR.Descriptor instead.
+func (MONEY_OPERATOR) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{15}
+}
+
+// TIME_VALUE functions follow the spreadsheet functions of the same name and
+// their sign convention: money paid out is negative and money received is
+// positive.
+type TIME_VALUE int32
+
+const (
+	TIME_VALUE_DEFAULT_TIME_VALUE TIME_VALUE = 0
+	TIME_VALUE_TIME_VALUE_PMT     TIME_VALUE = 1
+	TIME_VALUE_TIME_VALUE_PV      TIME_VALUE = 2
+	TIME_VALUE_TIME_VALUE_FV      TIME_VALUE = 3
+	TIME_VALUE_TIME_VALUE_NPER    TIME_VALUE = 4
+	TIME_VALUE_TIME_VALUE_RATE    TIME_VALUE = 5
+)
+
+// Enum value maps for TIME_VALUE.
+var (
+	TIME_VALUE_name = map[int32]string{
+		0: "DEFAULT_TIME_VALUE",
+		1: "TIME_VALUE_PMT",
+		2: "TIME_VALUE_PV",
+		3: "TIME_VALUE_FV",
+		4: "TIME_VALUE_NPER",
+		5: "TIME_VALUE_RATE",
+	}
+	TIME_VALUE_value = map[string]int32{
+		"DEFAULT_TIME_VALUE": 0,
+		"TIME_VALUE_PMT":     1,
+		"TIME_VALUE_PV":      2,
+		"TIME_VALUE_FV":      3,
+		"TIME_VALUE_NPER":    4,
+		"TIME_VALUE_RATE":    5,
+	}
+)
+
+func (x TIME_VALUE) Enum() *TIME_VALUE {
+	p := new(TIME_VALUE)
+	*p = x
+	return p
+}
+
+func (x TIME_VALUE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TIME_VALUE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[16].Descriptor()
+}
+
+func (TIME_VALUE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[16]
+}
+
+func (x TIME_VALUE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TIME_VALUE.Descriptor instead.
+func (TIME_VALUE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{16}
+}
+
+type PAYMENT_TIMING int32
+
+const (
+	PAYMENT_TIMING_PAYMENT_TIMING_END   PAYMENT_TIMING = 0
+	PAYMENT_TIMING_PAYMENT_TIMING_BEGIN PAYMENT_TIMING = 1
+)
+
+// Enum value maps for PAYMENT_TIMING.
+var (
+	PAYMENT_TIMING_name = map[int32]string{
+		0: "PAYMENT_TIMING_END",
+		1: "PAYMENT_TIMING_BEGIN",
+	}
+	PAYMENT_TIMING_value = map[string]int32{
+		"PAYMENT_TIMING_END":   0,
+		"PAYMENT_TIMING_BEGIN": 1,
+	}
+)
+
+func (x PAYMENT_TIMING) Enum() *PAYMENT_TIMING {
+	p := new(PAYMENT_TIMING)
+	*p = x
+	return p
+}
+
+func (x PAYMENT_TIMING) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PAYMENT_TIMING) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[17].Descriptor()
+}
+
+func (PAYMENT_TIMING) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[17]
+}
+
+func (x PAYMENT_TIMING) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PAYMENT_TIMING.Descriptor instead.
+func (PAYMENT_TIMING) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{17}
+}
+
+type CASH_FLOW int32
+
+const (
+	CASH_FLOW_DEFAULT_CASH_FLOW CASH_FLOW = 0
+	// CASH_FLOW_NPV discounts the first value by one period, like the spreadsheet NPV.
+	CASH_FLOW_CASH_FLOW_NPV  CASH_FLOW = 1
+	CASH_FLOW_CASH_FLOW_IRR  CASH_FLOW = 2
+	CASH_FLOW_CASH_FLOW_XNPV CASH_FLOW = 3
+	CASH_FLOW_CASH_FLOW_XIRR CASH_FLOW = 4
+)
+
+// Enum value maps for CASH_FLOW.
+var (
+	CASH_FLOW_name = map[int32]string{
+		0: "DEFAULT_CASH_FLOW",
+		1: "CASH_FLOW_NPV",
+		2: "CASH_FLOW_IRR",
+		3: "CASH_FLOW_XNPV",
+		4: "CASH_FLOW_XIRR",
+	}
+	CASH_FLOW_value = map[string]int32{
+		"DEFAULT_CASH_FLOW": 0,
+		"CASH_FLOW_NPV":     1,
+		"CASH_FLOW_IRR":     2,
+		"CASH_FLOW_XNPV":    3,
+		"CASH_FLOW_XIRR":    4,
+	}
+)
+
+func (x CASH_FLOW) Enum() *CASH_FLOW {
+	p := new(CASH_FLOW)
+	*p = x
+	return p
+}
+
+func (x CASH_FLOW) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CASH_FLOW) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[18].Descriptor()
+}
+
+func (CASH_FLOW) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[18]
+}
+
+func (x CASH_FLOW) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CASH_FLOW.Descriptor instead.
+func (CASH_FLOW) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{18}
+}
+
+type DEPRECIATION int32
+
+const (
+	DEPRECIATION_DEFAULT_DEPRECIATION DEPRECIATION = 0
+	// DEPRECIATION_STRAIGHT_LINE is the spreadsheet SLN.
+	DEPRECIATION_DEPRECIATION_STRAIGHT_LINE DEPRECIATION = 1
+	// DEPRECIATION_DECLINING_BALANCE is the fixed-declining balance of the
+	// spreadsheet DB, with the rate rounded to three decimals.
+	DEPRECIATION_DEPRECIATION_DECLINING_BALANCE DEPRECIATION = 2
+	// DEPRECIATION_DOUBLE_DECLINING_BALANCE is the spreadsheet DDB.
+	DEPRECIATION_DEPRECIATION_DOUBLE_DECLINING_BALANCE DEPRECIATION = 3
+)
+
+// Enum value maps for DEPRECIATION.
+var (
+	DEPRECIATION_name = map[int32]string{
+		0: "DEFAULT_DEPRECIATION",
+		1: "DEPRECIATION_STRAIGHT_LINE",
+		2: "DEPRECIATION_DECLINING_BALANCE",
+		3: "DEPRECIATION_DOUBLE_DECLINING_BALANCE",
+	}
+	DEPRECIATION_value = map[string]int32{
+		"DEFAULT_DEPRECIATION":                  0,
+		"DEPRECIATION_STRAIGHT_LINE":            1,
+		"DEPRECIATION_DECLINING_BALANCE":        2,
+		"DEPRECIATION_DOUBLE_DECLINING_BALANCE": 3,
+	}
+)
+
+func (x DEPRECIATION) Enum() *DEPRECIATION {
+	p := new(DEPRECIATION)
+	*p = x
+	return p
+}
+
+func (x DEPRECIATION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DEPRECIATION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[19].Descriptor()
+}
+
+func (DEPRECIATION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[19]
+}
+
+func (x DEPRECIATION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DEPRECIATION.Descriptor instead.
+func (DEPRECIATION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{19}
+}
+
+type DATE_OPERATOR int32
+
+const (
+	DATE_OPERATOR_DEFAULT_DATE_OPERATOR DATE_OPERATOR = 0
+	// DATE_OPERATOR_ADD adds duration to time. Years and months move the
+	// calendar date and are clamped to the end of the month, so 2024-01-31 plus
+	// P1M is 2024-02-29. Days keep the wall clock across daylight saving time
+	// changes and the time part is added as elapsed time.
+	DATE_OPERATOR_DATE_OPERATOR_ADD DATE_OPERATOR = 1
+	// DATE_OPERATOR_SUBTRACT subtracts duration from time, the same way.
+	DATE_OPERATOR_DATE_OPERATOR_SUBTRACT DATE_OPERATOR = 2
+	// DATE_OPERATOR_DIFFERENCE is the time from time to other.
+	DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE DATE_OPERATOR = 3
+	// DATE_OPERATOR_ADD_BUSINESS_DAYS moves time by days business days of the
+	// calendar, backwards when days is negative.
+	DATE_OPERATOR_DATE_OPERATOR_ADD_BUSINESS_DAYS DATE_OPERATOR = 4
+	// DATE_OPERATOR_BUSINESS_DAYS counts the business days after time up to and
+	// including other.
+	DATE_OPERATOR_DATE_OPERATOR_BUSINESS_DAYS DATE_OPERATOR = 5
+	// DATE_OPERATOR_IS_BUSINESS_DAY tells whether time falls on a business day.
+	DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY DATE_OPERATOR = 6
+	// DATE_OPERATOR_CONVERT_TIME_ZONE is time in target_time_zone.
+	DATE_OPERATOR_DATE_OPERATOR_CONVERT_TIME_ZONE DATE_OPERATOR = 7
+)
+
+// Enum value maps for DATE_OPERATOR.
+var (
+	DATE_OPERATOR_name = map[int32]string{
+		0: "DEFAULT_DATE_OPERATOR",
+		1: "DATE_OPERATOR_ADD",
+		2: "DATE_OPERATOR_SUBTRACT",
+		3: "DATE_OPERATOR_DIFFERENCE",
+		4: "DATE_OPERATOR_ADD_BUSINESS_DAYS",
+		5: "DATE_OPERATOR_BUSINESS_DAYS",
+		6: "DATE_OPERATOR_IS_BUSINESS_DAY",
+		7: "DATE_OPERATOR_CONVERT_TIME_ZONE",
+	}
+	DATE_OPERATOR_value = map[string]int32{
+		"DEFAULT_DATE_OPERATOR":           0,
+		"DATE_OPERATOR_ADD":               1,
+		"DATE_OPERATOR_SUBTRACT":          2,
+		"DATE_OPERATOR_DIFFERENCE":        3,
+		"DATE_OPERATOR_ADD_BUSINESS_DAYS": 4,
+		"DATE_OPERATOR_BUSINESS_DAYS":     5,
+		"DATE_OPERATOR_IS_BUSINESS_DAY":   6,
+		"DATE_OPERATOR_CONVERT_TIME_ZONE": 7,
+	}
+)
+
+func (x DATE_OPERATOR) Enum() *DATE_OPERATOR {
+	p := new(DATE_OPERATOR)
+	*p = x
+	return p
+}
+
+func (x DATE_OPERATOR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DATE_OPERATOR) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[20].Descriptor()
+}
+
+func (DATE_OPERATOR) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[20]
+}
+
+func (x DATE_OPERATOR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DATE_OPERATOR.Descriptor instead.
+func (DATE_OPERATOR) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{20}
+}
+
+type SYMBOLIC int32
+
+const (
+	SYMBOLIC_DEFAULT_SYMBOLIC SYMBOLIC = 0
+	// SYMBOLIC_DIFFERENTIATE is the derivative of order with respect to variable.
+	SYMBOLIC_SYMBOLIC_DIFFERENTIATE SYMBOLIC = 1
+	// SYMBOLIC_SIMPLIFY folds constants, drops identities such as x*1 and x+0
+	// and collects like terms and powers.
+	SYMBOLIC_SYMBOLIC_SIMPLIFY SYMBOLIC = 2
+	// SYMBOLIC_SUBSTITUTE replaces variables with values.
+	SYMBOLIC_SYMBOLIC_SUBSTITUTE SYMBOLIC = 3
+)
+
+// Enum value maps for SYMBOLIC.
+var (
+	SYMBOLIC_name = map[int32]string{
+		0: "DEFAULT_SYMBOLIC",
+		1: "SYMBOLIC_DIFFERENTIATE",
+		2: "SYMBOLIC_SIMPLIFY",
+		3: "SYMBOLIC_SUBSTITUTE",
+	}
+	SYMBOLIC_value = map[string]int32{
+		"DEFAULT_SYMBOLIC":       0,
+		"SYMBOLIC_DIFFERENTIATE": 1,
+		"SYMBOLIC_SIMPLIFY":      2,
+		"SYMBOLIC_SUBSTITUTE":    3,
+	}
+)
+
+func (x SYMBOLIC) Enum() *SYMBOLIC {
+	p := new(SYMBOLIC)
+	*p = x
+	return p
+}
+
+func (x SYMBOLIC) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SYMBOLIC) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[21].Descriptor()
+}
+
+func (SYMBOLIC) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[21]
+}
+
+func (x SYMBOLIC) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SYMBOLIC.Descriptor instead.
+func (SYMBOLIC) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{21}
+}
+
+type SOLVER int32
+
+const (
+	SOLVER_DEFAULT_SOLVER SOLVER = 0
+	// SOLVER_POLYNOMIAL finds all the roots, complex ones included, of a
+	// polynomial of degree 1 to 4 in closed form.
+	SOLVER_SOLVER_POLYNOMIAL SOLVER = 1
+	// SOLVER_BISECTION halves the bracket lower to upper.
+	SOLVER_SOLVER_BISECTION SOLVER = 2
+	// SOLVER_BRENT combines bisection with the secant method and inverse
+	// quadratic interpolation over the bracket lower to upper.
+	SOLVER_SOLVER_BRENT SOLVER = 3
+	// SOLVER_NEWTON follows the derivative from initial.
+	SOLVER_SOLVER_NEWTON SOLVER = 4
+)
+
+// Enum value maps for SOLVER.
+var (
+	SOLVER_name = map[int32]string{
+		0: "DEFAULT_SOLVER",
+		1: "SOLVER_POLYNOMIAL",
+		2: "SOLVER_BISECTION",
+		3: "SOLVER_BRENT",
+		4: "SOLVER_NEWTON",
+	}
+	SOLVER_value = map[string]int32{
+		"DEFAULT_SOLVER":    0,
+		"SOLVER_POLYNOMIAL": 1,
+		"SOLVER_BISECTION":  2,
+		"SOLVER_BRENT":      3,
+		"SOLVER_NEWTON":     4,
+	}
+)
+
+func (x SOLVER) Enum() *SOLVER {
+	p := new(SOLVER)
+	*p = x
+	return p
+}
+
+func (x SOLVER) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SOLVER) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[22].Descriptor()
+}
+
+func (SOLVER) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[22]
+}
+
+func (x SOLVER) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SOLVER.Descriptor instead.
+func (SOLVER) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{22}
+}
+
+type QUADRATURE int32
+
+const (
+	QUADRATURE_DEFAULT_QUADRATURE QUADRATURE = 0
+	// QUADRATURE_SIMPSON is adaptive Simpson's rule, the integrand must be
+	// finite at the bounds.
+	QUADRATURE_QUADRATURE_SIMPSON QUADRATURE = 1
+	// QUADRATURE_GAUSS_KRONROD is globally adaptive 7-point Gauss and 15-point
+	// Kronrod quadrature, which handles integrable singularities at the bounds.
+	QUADRATURE_QUADRATURE_GAUSS_KRONROD QUADRATURE = 2
+)
+
+// Enum value maps for QUADRATURE.
+var (
+	QUADRATURE_name = map[int32]string{
+		0: "DEFAULT_QUADRATURE",
+		1: "QUADRATURE_SIMPSON",
+		2: "QUADRATURE_GAUSS_KRONROD",
+	}
+	QUADRATURE_value = map[string]int32{
+		"DEFAULT_QUADRATURE":       0,
+		"QUADRATURE_SIMPSON":       1,
+		"QUADRATURE_GAUSS_KRONROD": 2,
+	}
+)
+
+func (x QUADRATURE) Enum() *QUADRATURE {
+	p := new(QUADRATURE)
+	*p = x
+	return p
+}
+
+func (x QUADRATURE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QUADRATURE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[23].Descriptor()
+}
+
+func (QUADRATURE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[23]
+}
+
+func (x QUADRATURE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QUADRATURE.Descriptor instead.
+func (QUADRATURE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{23}
+}
+
+type ODE_SOLVER int32
+
+const (
+	ODE_SOLVER_DEFAULT_ODE_SOLVER ODE_SOLVER = 0
+	// ODE_SOLVER_RK4 is the classic fourth order Runge-Kutta method with a
+	// fixed step.
+	ODE_SOLVER_ODE_SOLVER_RK4 ODE_SOLVER = 1
+	// ODE_SOLVER_DORMAND_PRINCE is the adaptive Runge-Kutta method of order 5
+	// with an embedded order 4 error estimate.
+	ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE ODE_SOLVER = 2
+)
+
+// Enum value maps for ODE_SOLVER.
+var (
+	ODE_SOLVER_name = map[int32]string{
+		0: "DEFAULT_ODE_SOLVER",
+		1: "ODE_SOLVER_RK4",
+		2: "ODE_SOLVER_DORMAND_PRINCE",
+	}
+	ODE_SOLVER_value = map[string]int32{
+		"DEFAULT_ODE_SOLVER":        0,
+		"ODE_SOLVER_RK4":            1,
+		"ODE_SOLVER_DORMAND_PRINCE": 2,
+	}
+)
+
+func (x ODE_SOLVER) Enum() *ODE_SOLVER {
+	p := new(ODE_SOLVER)
+	*p = x
+	return p
+}
+
+func (x ODE_SOLVER) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ODE_SOLVER) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[24].Descriptor()
+}
+
+func (ODE_SOLVER) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[24]
+}
+
+func (x ODE_SOLVER) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ODE_SOLVER.Descriptor instead.
+func (ODE_SOLVER) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{24}
+}
+
+type INTERPOLATION int32
+
+const (
+	INTERPOLATION_DEFAULT_INTERPOLATION INTERPOLATION = 0
+	// INTERPOLATION_LINEAR joins neighbouring points with lines.
+	INTERPOLATION_INTERPOLATION_LINEAR INTERPOLATION = 1
+	// INTERPOLATION_POLYNOMIAL is the polynomial through all the points, of at
+	// most 100 points.
+	INTERPOLATION_INTERPOLATION_POLYNOMIAL INTERPOLATION = 2
+	// INTERPOLATION_SPLINE is the natural cubic spline, whose second
+	// derivative is 0 at the ends.
+	INTERPOLATION_INTERPOLATION_SPLINE INTERPOLATION = 3
+	// INTERPOLATION_PCHIP is the piecewise cubic Hermite interpolation of
+	// Fritsch and Carlson, which is monotone where the points are.
+	INTERPOLATION_INTERPOLATION_PCHIP INTERPOLATION = 4
+)
+
+// Enum value maps for INTERPOLATION.
+var (
+	INTERPOLATION_name = map[int32]string{
+		0: "DEFAULT_INTERPOLATION",
+		1: "INTERPOLATION_LINEAR",
+		2: "INTERPOLATION_POLYNOMIAL",
+		3: "INTERPOLATION_SPLINE",
+		4: "INTERPOLATION_PCHIP",
+	}
+	INTERPOLATION_value = map[string]int32{
+		"DEFAULT_INTERPOLATION":    0,
+		"INTERPOLATION_LINEAR":     1,
+		"INTERPOLATION_POLYNOMIAL": 2,
+		"INTERPOLATION_SPLINE":     3,
+		"INTERPOLATION_PCHIP":      4,
+	}
+)
+
+func (x INTERPOLATION) Enum() *INTERPOLATION {
+	p := new(INTERPOLATION)
+	*p = x
+	return p
+}
+
+func (x INTERPOLATION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (INTERPOLATION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[25].Descriptor()
+}
+
+func (INTERPOLATION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[25]
+}
+
+func (x INTERPOLATION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use INTERPOLATION.Descriptor instead.
+func (INTERPOLATION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{25}
+}
+
+type FIT_MODEL int32
+
+const (
+	FIT_MODEL_DEFAULT_FIT_MODEL FIT_MODEL = 0
+	// FIT_MODEL_LINEAR is y = c0 + c1 x.
+	FIT_MODEL_FIT_MODEL_LINEAR FIT_MODEL = 1
+	// FIT_MODEL_POLYNOMIAL is y = c0 + c1 x + ... + cn x^n.
+	FIT_MODEL_FIT_MODEL_POLYNOMIAL FIT_MODEL = 2
+	// FIT_MODEL_EXPONENTIAL is y = c0 exp(c1 x), of positive y.
+	FIT_MODEL_FIT_MODEL_EXPONENTIAL FIT_MODEL = 3
+	// FIT_MODEL_POWER is y = c0 x^c1, of positive x and y.
+	FIT_MODEL_FIT_MODEL_POWER FIT_MODEL = 4
+)
+
+// Enum value maps for FIT_MODEL.
+var (
+	FIT_MODEL_name = map[int32]string{
+		0: "DEFAULT_FIT_MODEL",
+		1: "FIT_MODEL_LINEAR",
+		2: "FIT_MODEL_POLYNOMIAL",
+		3: "FIT_MODEL_EXPONENTIAL",
+		4: "FIT_MODEL_POWER",
+	}
+	FIT_MODEL_value = map[string]int32{
+		"DEFAULT_FIT_MODEL":     0,
+		"FIT_MODEL_LINEAR":      1,
+		"FIT_MODEL_POLYNOMIAL":  2,
+		"FIT_MODEL_EXPONENTIAL": 3,
+		"FIT_MODEL_POWER":       4,
+	}
+)
+
+func (x FIT_MODEL) Enum() *FIT_MODEL {
+	p := new(FIT_MODEL)
+	*p = x
+	return p
+}
+
+func (x FIT_MODEL) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FIT_MODEL) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[26].Descriptor()
+}
+
+func (FIT_MODEL) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[26]
+}
+
+func (x FIT_MODEL) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FIT_MODEL.Descriptor instead.
+func (FIT_MODEL) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{26}
+}
+
+type LP_SENSE int32
+
+const (
+	LP_SENSE_DEFAULT_LP_SENSE  LP_SENSE = 0
+	LP_SENSE_LP_SENSE_MINIMIZE LP_SENSE = 1
+	LP_SENSE_LP_SENSE_MAXIMIZE LP_SENSE = 2
+)
+
+// Enum value maps for LP_SENSE.
+var (
+	LP_SENSE_name = map[int32]string{
+		0: "DEFAULT_LP_SENSE",
+		1: "LP_SENSE_MINIMIZE",
+		2: "LP_SENSE_MAXIMIZE",
+	}
+	LP_SENSE_value = map[string]int32{
+		"DEFAULT_LP_SENSE":  0,
+		"LP_SENSE_MINIMIZE": 1,
+		"LP_SENSE_MAXIMIZE": 2,
+	}
+)
+
+func (x LP_SENSE) Enum() *LP_SENSE {
+	p := new(LP_SENSE)
+	*p = x
+	return p
+}
+
+func (x LP_SENSE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LP_SENSE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[27].Descriptor()
+}
+
+func (LP_SENSE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[27]
+}
+
+func (x LP_SENSE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LP_SENSE.Descriptor instead.
+func (LP_SENSE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{27}
+}
+
+type CONSTRAINT_RELATION int32
+
+const (
+	CONSTRAINT_RELATION_DEFAULT_CONSTRAINT_RELATION       CONSTRAINT_RELATION = 0
+	CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL    CONSTRAINT_RELATION = 1
+	CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL CONSTRAINT_RELATION = 2
+	CONSTRAINT_RELATION_CONSTRAINT_RELATION_EQUAL         CONSTRAINT_RELATION = 3
+)
+
+// Enum value maps for CONSTRAINT_RELATION.
+var (
+	CONSTRAINT_RELATION_name = map[int32]string{
+		0: "DEFAULT_CONSTRAINT_RELATION",
+		1: "CONSTRAINT_RELATION_LESS_EQUAL",
+		2: "CONSTRAINT_RELATION_GREATER_EQUAL",
+		3: "CONSTRAINT_RELATION_EQUAL",
+	}
+	CONSTRAINT_RELATION_value = map[string]int32{
+		"DEFAULT_CONSTRAINT_RELATION":       0,
+		"CONSTRAINT_RELATION_LESS_EQUAL":    1,
+		"CONSTRAINT_RELATION_GREATER_EQUAL": 2,
+		"CONSTRAINT_RELATION_EQUAL":         3,
+	}
+)
+
+func (x CONSTRAINT_RELATION) Enum() *CONSTRAINT_RELATION {
+	p := new(CONSTRAINT_RELATION)
+	*p = x
+	return p
+}
+
+func (x CONSTRAINT_RELATION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CONSTRAINT_RELATION) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[28].Descriptor()
+}
+
+func (CONSTRAINT_RELATION) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[28]
+}
+
+func (x CONSTRAINT_RELATION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CONSTRAINT_RELATION.Descriptor instead.
+func (CONSTRAINT_RELATION) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{28}
+}
+
+type LP_STATUS int32
+
+const (
+	LP_STATUS_DEFAULT_LP_STATUS LP_STATUS = 0
+	LP_STATUS_LP_STATUS_OPTIMAL LP_STATUS = 1
+	// LP_STATUS_INFEASIBLE is when no x meets the constraints and bounds.
+	LP_STATUS_LP_STATUS_INFEASIBLE LP_STATUS = 2
+	// LP_STATUS_UNBOUNDED is when the objective improves without bound.
+	LP_STATUS_LP_STATUS_UNBOUNDED LP_STATUS = 3
+)
+
+// Enum value maps for LP_STATUS.
+var (
+	LP_STATUS_name = map[int32]string{
+		0: "DEFAULT_LP_STATUS",
+		1: "LP_STATUS_OPTIMAL",
+		2: "LP_STATUS_INFEASIBLE",
+		3: "LP_STATUS_UNBOUNDED",
+	}
+	LP_STATUS_value = map[string]int32{
+		"DEFAULT_LP_STATUS":    0,
+		"LP_STATUS_OPTIMAL":    1,
+		"LP_STATUS_INFEASIBLE": 2,
+		"LP_STATUS_UNBOUNDED":  3,
+	}
+)
+
+func (x LP_STATUS) Enum() *LP_STATUS {
+	p := new(LP_STATUS)
+	*p = x
+	return p
+}
+
+func (x LP_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LP_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[29].Descriptor()
+}
+
+func (LP_STATUS) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[29]
+}
+
+func (x LP_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LP_STATUS.Descriptor instead.
+func (LP_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{29}
+}
+
+// WINDOW is a window tapering samples to 0 at the ends, which lessens the
+// leakage of a spectrum between frequencies. The windows are symmetric.
+type WINDOW int32
+
+const (
+	// WINDOW_RECTANGULAR leaves the samples as they are.
+	WINDOW_WINDOW_RECTANGULAR WINDOW = 0
+	WINDOW_WINDOW_HANN        WINDOW = 1
+	WINDOW_WINDOW_HAMMING     WINDOW = 2
+	WINDOW_WINDOW_BLACKMAN    WINDOW = 3
+)
+
+// Enum value maps for WINDOW.
+var (
+	WINDOW_name = map[int32]string{
+		0: "WINDOW_RECTANGULAR",
+		1: "WINDOW_HANN",
+		2: "WINDOW_HAMMING",
+		3: "WINDOW_BLACKMAN",
+	}
+	WINDOW_value = map[string]int32{
+		"WINDOW_RECTANGULAR": 0,
+		"WINDOW_HANN":        1,
+		"WINDOW_HAMMING":     2,
+		"WINDOW_BLACKMAN":    3,
+	}
+)
+
+func (x WINDOW) Enum() *WINDOW {
+	p := new(WINDOW)
+	*p = x
+	return p
+}
+
+func (x WINDOW) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WINDOW) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[30].Descriptor()
+}
+
+func (WINDOW) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[30]
+}
+
+func (x WINDOW) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WINDOW.Descriptor instead.
+func (WINDOW) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{30}
+}
+
+type FIR_FILTER int32
+
+const (
+	// FIR_FILTER_TAPS is the filter of the taps of the request.
+	FIR_FILTER_FIR_FILTER_TAPS FIR_FILTER = 0
+	// FIR_FILTER_LOW_PASS passes the frequencies below cutoff.
+	FIR_FILTER_FIR_FILTER_LOW_PASS FIR_FILTER = 1
+	// FIR_FILTER_HIGH_PASS passes the frequencies above cutoff.
+	FIR_FILTER_FIR_FILTER_HIGH_PASS FIR_FILTER = 2
+	// FIR_FILTER_BAND_PASS passes the frequencies from cutoff to cutoff_high.
+	FIR_FILTER_FIR_FILTER_BAND_PASS FIR_FILTER = 3
+	// FIR_FILTER_BAND_STOP stops the frequencies from cutoff to cutoff_high.
+	FIR_FILTER_FIR_FILTER_BAND_STOP FIR_FILTER = 4
+)
+
+// Enum value maps for FIR_FILTER.
+var (
+	FIR_FILTER_name = map[int32]string{
+		0: "FIR_FILTER_TAPS",
+		1: "FIR_FILTER_LOW_PASS",
+		2: "FIR_FILTER_HIGH_PASS",
+		3: "FIR_FILTER_BAND_PASS",
+		4: "FIR_FILTER_BAND_STOP",
+	}
+	FIR_FILTER_value = map[string]int32{
+		"FIR_FILTER_TAPS":      0,
+		"FIR_FILTER_LOW_PASS":  1,
+		"FIR_FILTER_HIGH_PASS": 2,
+		"FIR_FILTER_BAND_PASS": 3,
+		"FIR_FILTER_BAND_STOP": 4,
+	}
+)
+
+func (x FIR_FILTER) Enum() *FIR_FILTER {
+	p := new(FIR_FILTER)
+	*p = x
+	return p
+}
+
+func (x FIR_FILTER) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FIR_FILTER) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[31].Descriptor()
+}
+
+func (FIR_FILTER) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[31]
+}
+
+func (x FIR_FILTER) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FIR_FILTER.Descriptor instead.
+func (FIR_FILTER) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{31}
+}
+
+type GEOMETRY int32
+
+const (
+	GEOMETRY_DEFAULT_GEOMETRY GEOMETRY = 0
+	// GEOMETRY_SHAPE is the area and perimeter of a shape.
+	GEOMETRY_GEOMETRY_SHAPE GEOMETRY = 1
+	// GEOMETRY_TRIANGLE solves a triangle from three sides (SSS), two sides
+	// and the angle between them (SAS) or two angles and a side (ASA).
+	GEOMETRY_GEOMETRY_TRIANGLE GEOMETRY = 2
+	// GEOMETRY_DISTANCE is the Euclidean distance between two points.
+	GEOMETRY_GEOMETRY_DISTANCE GEOMETRY = 3
+	// GEOMETRY_POLYGON is the area by the shoelace formula, the perimeter and
+	// the centroid of a simple polygon.
+	GEOMETRY_GEOMETRY_POLYGON GEOMETRY = 4
+	// GEOMETRY_HAVERSINE is the great-circle distance and bearings between
+	// two points on a sphere.
+	GEOMETRY_GEOMETRY_HAVERSINE GEOMETRY = 5
+	// GEOMETRY_VINCENTY is the geodesic distance and bearings between two
+	// points on the WGS84 ellipsoid by Vincenty's inverse formula, which
+	// doesn't converge for nearly antipodal points and fails with
+	// FAILED_PRECONDITION for them.
+	GEOMETRY_GEOMETRY_VINCENTY GEOMETRY = 6
+)
+
+// Enum value maps for GEOMETRY.
+var (
+	GEOMETRY_name = map[int32]string{
+		0: "DEFAULT_GEOMETRY",
+		1: "GEOMETRY_SHAPE",
+		2: "GEOMETRY_TRIANGLE",
+		3: "GEOMETRY_DISTANCE",
+		4: "GEOMETRY_POLYGON",
+		5: "GEOMETRY_HAVERSINE",
+		6: "GEOMETRY_VINCENTY",
+	}
+	GEOMETRY_value = map[string]int32{
+		"DEFAULT_GEOMETRY":   0,
+		"GEOMETRY_SHAPE":     1,
+		"GEOMETRY_TRIANGLE":  2,
+		"GEOMETRY_DISTANCE":  3,
+		"GEOMETRY_POLYGON":   4,
+		"GEOMETRY_HAVERSINE": 5,
+		"GEOMETRY_VINCENTY":  6,
+	}
+)
+
+func (x GEOMETRY) Enum() *GEOMETRY {
+	p := new(GEOMETRY)
+	*p = x
+	return p
+}
+
+func (x GEOMETRY) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GEOMETRY) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[32].Descriptor()
+}
+
+func (GEOMETRY) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[32]
+}
+
+func (x GEOMETRY) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GEOMETRY.Descriptor instead.
+func (GEOMETRY) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{32}
+}
+
+type SHAPE int32
+
+const (
+	SHAPE_DEFAULT_SHAPE SHAPE = 0
+	// SHAPE_CIRCLE of dimensions radius.
+	SHAPE_SHAPE_CIRCLE SHAPE = 1
+	// SHAPE_SQUARE of dimensions side.
+	SHAPE_SHAPE_SQUARE SHAPE = 2
+	// SHAPE_RECTANGLE of dimensions width and height.
+	SHAPE_SHAPE_RECTANGLE SHAPE = 3
+	// SHAPE_ELLIPSE of dimensions the semi-axes, whose perimeter is
+	// Ramanujan's approximation.
+	SHAPE_SHAPE_ELLIPSE SHAPE = 4
+	// SHAPE_REGULAR_POLYGON of dimensions the number of sides and the side.
+	SHAPE_SHAPE_REGULAR_POLYGON SHAPE = 5
+	// SHAPE_TRAPEZOID of dimensions the parallel sides a and b and the legs c
+	// and d.
+	SHAPE_SHAPE_TRAPEZOID SHAPE = 6
+)
+
+// Enum value maps for SHAPE.
+var (
+	SHAPE_name = map[int32]string{
+		0: "DEFAULT_SHAPE",
+		1: "SHAPE_CIRCLE",
+		2: "SHAPE_SQUARE",
+		3: "SHAPE_RECTANGLE",
+		4: "SHAPE_ELLIPSE",
+		5: "SHAPE_REGULAR_POLYGON",
+		6: "SHAPE_TRAPEZOID",
+	}
+	SHAPE_value = map[string]int32{
+		"DEFAULT_SHAPE":         0,
+		"SHAPE_CIRCLE":          1,
+		"SHAPE_SQUARE":          2,
+		"SHAPE_RECTANGLE":       3,
+		"SHAPE_ELLIPSE":         4,
+		"SHAPE_REGULAR_POLYGON": 5,
+		"SHAPE_TRAPEZOID":       6,
+	}
+)
+
+func (x SHAPE) Enum() *SHAPE {
+	p := new(SHAPE)
+	*p = x
+	return p
+}
+
+func (x SHAPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SHAPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[33].Descriptor()
+}
+
+func (SHAPE) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[33]
+}
+
+func (x SHAPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SHAPE.Descriptor instead.
+func (SHAPE) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{33}
+}
+
+type LOGIC int32
+
+const (
+	LOGIC_DEFAULT_LOGIC LOGIC = 0
+	// LOGIC_EVALUATE is the value of expression at values.
+	LOGIC_LOGIC_EVALUATE LOGIC = 1
+	// LOGIC_TRUTH_TABLE is the value of expression for every assignment of
+	// its variables, of at most 16 variables.
+	LOGIC_LOGIC_TRUTH_TABLE LOGIC = 2
+	// LOGIC_MINIMIZE is a minimal sum of products of expression, or of
+	// minterms and dont_cares of variables, by the Quine-McCluskey method.
+	LOGIC_LOGIC_MINIMIZE LOGIC = 3
+)
+
+// Enum value maps for LOGIC.
+var (
+	LOGIC_name = map[int32]string{
+		0: "DEFAULT_LOGIC",
+		1: "LOGIC_EVALUATE",
+		2: "LOGIC_TRUTH_TABLE",
+		3: "LOGIC_MINIMIZE",
+	}
+	LOGIC_value = map[string]int32{
+		"DEFAULT_LOGIC":     0,
+		"LOGIC_EVALUATE":    1,
+		"LOGIC_TRUTH_TABLE": 2,
+		"LOGIC_MINIMIZE":    3,
+	}
+)
+
+func (x LOGIC) Enum() *LOGIC {
+	p := new(LOGIC)
+	*p = x
+	return p
+}
+
+func (x LOGIC) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LOGIC) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_calculatorpb_calculator_proto_enumTypes[34].Descriptor()
+}
+
+func (LOGIC) Type() protoreflect.EnumType {
+	return &file_rpc_proto_calculatorpb_calculator_proto_enumTypes[34]
+}
+
+func (x LOGIC) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LOGIC.Descriptor instead.
+func (LOGIC) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{34}
+}
+
+type CalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator OPERATOR  `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.OPERATOR" json:"operator,omitempty"`
+	Operands *OPERANDS `protobuf:"bytes,2,opt,name=operands,proto3" json:"operands,omitempty"`
+}
+
+func (x *CalculateRequest) Reset() {
+	*x = CalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRequest) ProtoMessage() {}
+
+func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
+func (*CalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CalculateRequest) GetOperator() OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return OPERATOR_DEFAULT_OPERATOR
+}
+
+func (x *CalculateRequest) GetOperands() *OPERANDS {
+	if x != nil {
+		return x.Operands
+	}
+	return nil
+}
+
+type OPERANDS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number_1 float64 `protobuf:"fixed64,1,opt,name=number_1,json=number1,proto3" json:"number_1,omitempty"`
+	Number_2 float64 `protobuf:"fixed64,2,opt,name=number_2,json=number2,proto3" json:"number_2,omitempty"`
+}
+
+func (x *OPERANDS) Reset() {
+	*x = OPERANDS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OPERANDS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OPERANDS) ProtoMessage() {}
+
+func (x *OPERANDS) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OPERANDS.ProtoReflect.Descriptor instead.
+func (*OPERANDS) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OPERANDS) GetNumber_1() float64 {
+	if x != nil {
+		return x.Number_1
+	}
+	return 0
+}
+
+func (x *OPERANDS) GetNumber_2() float64 {
+	if x != nil {
+		return x.Number_2
+	}
+	return 0
+}
+
+type CalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CalculateResponse) Reset() {
+	*x = CalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateResponse) ProtoMessage() {}
+
+func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateResponse.ProtoReflect.Descriptor instead.
+func (*CalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CalculateResponse) GetResult() float64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+// StatisticsStreamRequest carries a batch of values for the running statistics.
+// options are only read from the first message of the stream, and a snapshot
+// is sent back whenever snapshot is set as well as when the stream is closed.
+type StatisticsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options  *StatisticsOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Values   []float64          `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Snapshot bool               `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *StatisticsStreamRequest) Reset() {
+	*x = StatisticsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatisticsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsStreamRequest) ProtoMessage() {}
+
+func (x *StatisticsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsStreamRequest.ProtoReflect.Descriptor instead.
+func (*StatisticsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatisticsStreamRequest) GetOptions() *StatisticsOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *StatisticsStreamRequest) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *StatisticsStreamRequest) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+type StatisticsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the number of values in the simple moving average, default 10.
+	Window uint32 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// alpha is the smoothing factor of the exponential moving average,
+	// default 2/(window+1).
+	Alpha float64 `protobuf:"fixed64,2,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// compression bounds the size of the quantile sketch, default 100.
+	Compression float64 `protobuf:"fixed64,3,opt,name=compression,proto3" json:"compression,omitempty"`
+	// quantiles to report, default 0.5, 0.9 and 0.99.
+	Quantiles []float64 `protobuf:"fixed64,4,rep,packed,name=quantiles,proto3" json:"quantiles,omitempty"`
+}
+
+func (x *StatisticsOptions) Reset() {
+	*x = StatisticsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatisticsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsOptions) ProtoMessage() {}
+
+func (x *StatisticsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsOptions.ProtoReflect.Descriptor instead.
+func (*StatisticsOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StatisticsOptions) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *StatisticsOptions) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *StatisticsOptions) GetCompression() float64 {
+	if x != nil {
+		return x.Compression
+	}
+	return 0
+}
+
+func (x *StatisticsOptions) GetQuantiles() []float64 {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+type StatisticsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean  float64 `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	// variance is the sample variance, zero until two values have been seen.
+	Variance  float64          `protobuf:"fixed64,3,opt,name=variance,proto3" json:"variance,omitempty"`
+	Stddev    float64          `protobuf:"fixed64,4,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	Min       float64          `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max       float64          `protobuf:"fixed64,6,opt,name=max,proto3" json:"max,omitempty"`
+	Ema       float64          `protobuf:"fixed64,7,opt,name=ema,proto3" json:"ema,omitempty"`
+	Sma       float64          `protobuf:"fixed64,8,opt,name=sma,proto3" json:"sma,omitempty"`
+	Quantiles []*QuantileValue `protobuf:"bytes,9,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+}
+
+func (x *StatisticsSnapshot) Reset() {
+	*x = StatisticsSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatisticsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsSnapshot) ProtoMessage() {}
+
+func (x *StatisticsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsSnapshot.ProtoReflect.Descriptor instead.
+func (*StatisticsSnapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StatisticsSnapshot) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetEma() float64 {
+	if x != nil {
+		return x.Ema
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetSma() float64 {
+	if x != nil {
+		return x.Sma
+	}
+	return 0
+}
+
+func (x *StatisticsSnapshot) GetQuantiles() []*QuantileValue {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+type QuantileValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *QuantileValue) Reset() {
+	*x = QuantileValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuantileValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantileValue) ProtoMessage() {}
+
+func (x *QuantileValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantileValue.ProtoReflect.Descriptor instead.
+func (*QuantileValue) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuantileValue) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *QuantileValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type TTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test     TTEST     `protobuf:"varint,1,opt,name=test,proto3,enum=calculatorpb.TTEST" json:"test,omitempty"`
+	Sample_1 []float64 `protobuf:"fixed64,2,rep,packed,name=sample_1,json=sample1,proto3" json:"sample_1,omitempty"`
+	// sample_2 is only used by the two-sample tests.
+	Sample_2 []float64 `protobuf:"fixed64,3,rep,packed,name=sample_2,json=sample2,proto3" json:"sample_2,omitempty"`
+	// mu is the hypothesised mean, or difference of means for two samples.
+	Mu          float64     `protobuf:"fixed64,4,opt,name=mu,proto3" json:"mu,omitempty"`
+	Alternative ALTERNATIVE `protobuf:"varint,5,opt,name=alternative,proto3,enum=calculatorpb.ALTERNATIVE" json:"alternative,omitempty"`
+	// confidence is the confidence level of the interval, default 0.95.
+	Confidence float64 `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *TTestRequest) Reset() {
+	*x = TTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTestRequest) ProtoMessage() {}
+
+func (x *TTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTestRequest.ProtoReflect.Descriptor instead.
+func (*TTestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TTestRequest) GetTest() TTEST {
+	if x != nil {
+		return x.Test
+	}
+	return TTEST_DEFAULT_TTEST
+}
+
+func (x *TTestRequest) GetSample_1() []float64 {
+	if x != nil {
+		return x.Sample_1
+	}
+	return nil
+}
+
+func (x *TTestRequest) GetSample_2() []float64 {
+	if x != nil {
+		return x.Sample_2
+	}
+	return nil
+}
+
+func (x *TTestRequest) GetMu() float64 {
+	if x != nil {
+		return x.Mu
+	}
+	return 0
+}
+
+func (x *TTestRequest) GetAlternative() ALTERNATIVE {
+	if x != nil {
+		return x.Alternative
+	}
+	return ALTERNATIVE_ALTERNATIVE_TWO_SIDED
+}
+
+func (x *TTestRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type ChiSquareTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test CHI_SQUARE_TEST `protobuf:"varint,1,opt,name=test,proto3,enum=calculatorpb.CHI_SQUARE_TEST" json:"test,omitempty"`
+	// observed counts for the goodness-of-fit test.
+	Observed []float64 `protobuf:"fixed64,2,rep,packed,name=observed,proto3" json:"observed,omitempty"`
+	// expected counts or proportions for the goodness-of-fit test, they are
+	// scaled to the observed total and default to a uniform distribution.
+	Expected []float64 `protobuf:"fixed64,3,rep,packed,name=expected,proto3" json:"expected,omitempty"`
+	// table is the contingency table for the independence test.
+	Table []*DoubleRow `protobuf:"bytes,4,rep,name=table,proto3" json:"table,omitempty"`
+	// confidence is the confidence level of the interval, default 0.95.
+	Confidence float64 `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *ChiSquareTestRequest) Reset() {
+	*x = ChiSquareTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChiSquareTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChiSquareTestRequest) ProtoMessage() {}
+
+func (x *ChiSquareTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChiSquareTestRequest.ProtoReflect.Descriptor instead.
+func (*ChiSquareTestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChiSquareTestRequest) GetTest() CHI_SQUARE_TEST {
+	if x != nil {
+		return x.Test
+	}
+	return CHI_SQUARE_TEST_DEFAULT_CHI_SQUARE_TEST
+}
+
+func (x *ChiSquareTestRequest) GetObserved() []float64 {
+	if x != nil {
+		return x.Observed
+	}
+	return nil
+}
+
+func (x *ChiSquareTestRequest) GetExpected() []float64 {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *ChiSquareTestRequest) GetTable() []*DoubleRow {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *ChiSquareTestRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type DoubleRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *DoubleRow) Reset() {
+	*x = DoubleRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRow) ProtoMessage() {}
+
+func (x *DoubleRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRow.ProtoReflect.Descriptor instead.
+func (*DoubleRow) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DoubleRow) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CorrelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method      CORRELATION `protobuf:"varint,1,opt,name=method,proto3,enum=calculatorpb.CORRELATION" json:"method,omitempty"`
+	X           []float64   `protobuf:"fixed64,2,rep,packed,name=x,proto3" json:"x,omitempty"`
+	Y           []float64   `protobuf:"fixed64,3,rep,packed,name=y,proto3" json:"y,omitempty"`
+	Alternative ALTERNATIVE `protobuf:"varint,4,opt,name=alternative,proto3,enum=calculatorpb.ALTERNATIVE" json:"alternative,omitempty"`
+	// confidence is the confidence level of the interval, default 0.95.
+	Confidence float64 `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *CorrelationRequest) Reset() {
+	*x = CorrelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationRequest) ProtoMessage() {}
+
+func (x *CorrelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationRequest.ProtoReflect.Descriptor instead.
+func (*CorrelationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CorrelationRequest) GetMethod() CORRELATION {
+	if x != nil {
+		return x.Method
+	}
+	return CORRELATION_DEFAULT_CORRELATION
+}
+
+func (x *CorrelationRequest) GetX() []float64 {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *CorrelationRequest) GetY() []float64 {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+func (x *CorrelationRequest) GetAlternative() ALTERNATIVE {
+	if x != nil {
+		return x.Alternative
+	}
+	return ALTERNATIVE_ALTERNATIVE_TWO_SIDED
+}
+
+func (x *CorrelationRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type HypothesisTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statistic        float64 `protobuf:"fixed64,1,opt,name=statistic,proto3" json:"statistic,omitempty"`
+	DegreesOfFreedom float64 `protobuf:"fixed64,2,opt,name=degrees_of_freedom,json=degreesOfFreedom,proto3" json:"degrees_of_freedom,omitempty"`
+	PValue           float64 `protobuf:"fixed64,3,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	// estimate is the mean, the difference of means or the correlation
+	// coefficient. For chi-square tests it is the effect size, Cohen's w for
+	// goodness of fit or Cramér's V for independence, of the noncentrality
+	// max(0, statistic - degrees_of_freedom).
+	Estimate float64 `protobuf:"fixed64,4,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	// confidence_interval of the estimate, for chi-square tests from the
+	// noncentral chi-square distribution.
+	ConfidenceInterval *ConfidenceInterval `protobuf:"bytes,5,opt,name=confidence_interval,json=confidenceInterval,proto3" json:"confidence_interval,omitempty"`
+}
+
+func (x *HypothesisTestResponse) Reset() {
+	*x = HypothesisTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HypothesisTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypothesisTestResponse) ProtoMessage() {}
+
+func (x *HypothesisTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HypothesisTestResponse.ProtoReflect.Descriptor instead.
+func (*HypothesisTestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HypothesisTestResponse) GetStatistic() float64 {
+	if x != nil {
+		return x.Statistic
+	}
+	return 0
+}
+
+func (x *HypothesisTestResponse) GetDegreesOfFreedom() float64 {
+	if x != nil {
+		return x.DegreesOfFreedom
+	}
+	return 0
+}
+
+func (x *HypothesisTestResponse) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *HypothesisTestResponse) GetEstimate() float64 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
+}
+
+func (x *HypothesisTestResponse) GetConfidenceInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ConfidenceInterval
+	}
+	return nil
+}
+
+type ConfidenceInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower      float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper      float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Confidence float64 `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *ConfidenceInterval) Reset() {
+	*x = ConfidenceInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfidenceInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidenceInterval) ProtoMessage() {}
+
+func (x *ConfidenceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidenceInterval.ProtoReflect.Descriptor instead.
+func (*ConfidenceInterval) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfidenceInterval) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type DistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distribution DISTRIBUTION          `protobuf:"varint,1,opt,name=distribution,proto3,enum=calculatorpb.DISTRIBUTION" json:"distribution,omitempty"`
+	Function     DISTRIBUTION_FUNCTION `protobuf:"varint,2,opt,name=function,proto3,enum=calculatorpb.DISTRIBUTION_FUNCTION" json:"function,omitempty"`
+	Parameters   map[string]float64    `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Points       []float64             `protobuf:"fixed64,4,rep,packed,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *DistributionRequest) Reset() {
+	*x = DistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionRequest) ProtoMessage() {}
+
+func (x *DistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionRequest.ProtoReflect.Descriptor instead.
+func (*DistributionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DistributionRequest) GetDistribution() DISTRIBUTION {
+	if x != nil {
+		return x.Distribution
+	}
+	return DISTRIBUTION_DEFAULT_DISTRIBUTION
+}
+
+func (x *DistributionRequest) GetFunction() DISTRIBUTION_FUNCTION {
+	if x != nil {
+		return x.Function
+	}
+	return DISTRIBUTION_FUNCTION_DEFAULT_DISTRIBUTION_FUNCTION
+}
+
+func (x *DistributionRequest) GetParameters() map[string]float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *DistributionRequest) GetPoints() []float64 {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type DistributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// values holds the function evaluated at each of the requested points.
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *DistributionResponse) Reset() {
+	*x = DistributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionResponse) ProtoMessage() {}
+
+func (x *DistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionResponse.ProtoReflect.Descriptor instead.
+func (*DistributionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DistributionResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RandomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seed makes the sequence reproducible, a random seed is picked when it is 0.
+	Seed int64 `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// distribution and parameters are the same as for DistributionRequest.
+	Distribution DISTRIBUTION       `protobuf:"varint,2,opt,name=distribution,proto3,enum=calculatorpb.DISTRIBUTION" json:"distribution,omitempty"`
+	Parameters   map[string]float64 `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Count        uint32             `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RandomRequest) Reset() {
+	*x = RandomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRequest) ProtoMessage() {}
+
+func (x *RandomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomRequest.ProtoReflect.Descriptor instead.
+func (*RandomRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RandomRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RandomRequest) GetDistribution() DISTRIBUTION {
+	if x != nil {
+		return x.Distribution
+	}
+	return DISTRIBUTION_DEFAULT_DISTRIBUTION
+}
+
+func (x *RandomRequest) GetParameters() map[string]float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *RandomRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RandomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seed that was used, to replay the same sequence.
+	Seed   int64     `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Values []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *RandomResponse) Reset() {
+	*x = RandomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomResponse) ProtoMessage() {}
+
+func (x *RandomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomResponse.ProtoReflect.Descriptor instead.
+func (*RandomResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RandomResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RandomResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RollDiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// notation such as "4d6kh3+2", dice may keep (kh, kl) or drop (dh, dl)
+	// their highest or lowest rolls.
+	Notation string `protobuf:"bytes,1,opt,name=notation,proto3" json:"notation,omitempty"`
+	// seed makes the rolls reproducible, a random seed is picked when it is 0.
+	Seed int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *RollDiceRequest) Reset() {
+	*x = RollDiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollDiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollDiceRequest) ProtoMessage() {}
+
+func (x *RollDiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollDiceRequest.ProtoReflect.Descriptor instead.
+func (*RollDiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RollDiceRequest) GetNotation() string {
+	if x != nil {
+		return x.Notation
+	}
+	return ""
+}
+
+func (x *RollDiceRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type RollDiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed  int64       `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Total int64       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Terms []*DiceTerm `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *RollDiceResponse) Reset() {
+	*x = RollDiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollDiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollDiceResponse) ProtoMessage() {}
+
+func (x *RollDiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollDiceResponse.ProtoReflect.Descriptor instead.
+func (*RollDiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RollDiceResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RollDiceResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RollDiceResponse) GetTerms() []*DiceTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// DiceTerm is a single group of dice or a constant of the notation.
+type DiceTerm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notation string `protobuf:"bytes,1,opt,name=notation,proto3" json:"notation,omitempty"`
+	// rolls holds every die in the order it was rolled, empty for constants.
+	Rolls []int64 `protobuf:"varint,2,rep,packed,name=rolls,proto3" json:"rolls,omitempty"`
+	// kept holds the rolls that count towards the value.
+	Kept []int64 `protobuf:"varint,3,rep,packed,name=kept,proto3" json:"kept,omitempty"`
+	// value is the signed contribution of the term to the total.
+	Value int64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DiceTerm) Reset() {
+	*x = DiceTerm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiceTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiceTerm) ProtoMessage() {}
+
+func (x *DiceTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiceTerm.ProtoReflect.Descriptor instead.
+func (*DiceTerm) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiceTerm) GetNotation() string {
+	if x != nil {
+		return x.Notation
+	}
+	return ""
+}
+
+func (x *DiceTerm) GetRolls() []int64 {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *DiceTerm) GetKept() []int64 {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+func (x *DiceTerm) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type CombinatoricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function COMBINATORICS `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.COMBINATORICS" json:"function,omitempty"`
+	N        uint64        `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	K        uint64        `protobuf:"varint,3,opt,name=k,proto3" json:"k,omitempty"`
+	// groups are the group sizes of the multinomial coefficient.
+	Groups []uint64 `protobuf:"varint,4,rep,packed,name=groups,proto3" json:"groups,omitempty"`
+	// probability of success of a single trial as a decimal string, e.g. "0.25".
+	Probability string `protobuf:"bytes,5,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *CombinatoricsRequest) Reset() {
+	*x = CombinatoricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombinatoricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombinatoricsRequest) ProtoMessage() {}
+
+func (x *CombinatoricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombinatoricsRequest.ProtoReflect.Descriptor instead.
+func (*CombinatoricsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CombinatoricsRequest) GetFunction() COMBINATORICS {
+	if x != nil {
+		return x.Function
+	}
+	return COMBINATORICS_DEFAULT_COMBINATORICS
+}
+
+func (x *CombinatoricsRequest) GetN() uint64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *CombinatoricsRequest) GetK() uint64 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *CombinatoricsRequest) GetGroups() []uint64 {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *CombinatoricsRequest) GetProbability() string {
+	if x != nil {
+		return x.Probability
+	}
+	return ""
+}
+
+type CombinatoricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result is an exact decimal integer, or a decimal in scientific notation
+	// for binomial probabilities.
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// digits is the number of decimal digits of an integer result.
+	Digits uint64 `protobuf:"varint,2,opt,name=digits,proto3" json:"digits,omitempty"`
+}
+
+func (x *CombinatoricsResponse) Reset() {
+	*x = CombinatoricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombinatoricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombinatoricsResponse) ProtoMessage() {}
+
+func (x *CombinatoricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombinatoricsResponse.ProtoReflect.Descriptor instead.
+func (*CombinatoricsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CombinatoricsResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *CombinatoricsResponse) GetDigits() uint64 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+type NumberTheoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function NUMBER_THEORY `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.NUMBER_THEORY" json:"function,omitempty"`
+	// operands are decimal integers of arbitrary size.
+	Operands []string `protobuf:"bytes,2,rep,name=operands,proto3" json:"operands,omitempty"`
+	Modulus  string   `protobuf:"bytes,3,opt,name=modulus,proto3" json:"modulus,omitempty"`
+}
+
+func (x *NumberTheoryRequest) Reset() {
+	*x = NumberTheoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberTheoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberTheoryRequest) ProtoMessage() {}
+
+func (x *NumberTheoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberTheoryRequest.ProtoReflect.Descriptor instead.
+func (*NumberTheoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NumberTheoryRequest) GetFunction() NUMBER_THEORY {
+	if x != nil {
+		return x.Function
+	}
+	return NUMBER_THEORY_DEFAULT_NUMBER_THEORY
+}
+
+func (x *NumberTheoryRequest) GetOperands() []string {
+	if x != nil {
+		return x.Operands
+	}
+	return nil
+}
+
+func (x *NumberTheoryRequest) GetModulus() string {
+	if x != nil {
+		return x.Modulus
+	}
+	return ""
+}
+
+type NumberTheoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	IsPrime bool   `protobuf:"varint,2,opt,name=is_prime,json=isPrime,proto3" json:"is_prime,omitempty"`
+	// probable is set when is_prime comes from a probabilistic test.
+	Probable bool           `protobuf:"varint,3,opt,name=probable,proto3" json:"probable,omitempty"`
+	Factors  []*PrimeFactor `protobuf:"bytes,4,rep,name=factors,proto3" json:"factors,omitempty"`
+	// unfactored is the composite cofactor left when factorization ran out of
+	// its iteration budget, it is empty when the factorization is complete.
+	Unfactored string   `protobuf:"bytes,5,opt,name=unfactored,proto3" json:"unfactored,omitempty"`
+	Bezout     []string `protobuf:"bytes,6,rep,name=bezout,proto3" json:"bezout,omitempty"`
+}
+
+func (x *NumberTheoryResponse) Reset() {
+	*x = NumberTheoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberTheoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberTheoryResponse) ProtoMessage() {}
+
+func (x *NumberTheoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberTheoryResponse.ProtoReflect.Descriptor instead.
+func (*NumberTheoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NumberTheoryResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *NumberTheoryResponse) GetIsPrime() bool {
+	if x != nil {
+		return x.IsPrime
+	}
+	return false
+}
+
+func (x *NumberTheoryResponse) GetProbable() bool {
+	if x != nil {
+		return x.Probable
+	}
+	return false
+}
+
+func (x *NumberTheoryResponse) GetFactors() []*PrimeFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+func (x *NumberTheoryResponse) GetUnfactored() string {
+	if x != nil {
+		return x.Unfactored
+	}
+	return ""
+}
+
+func (x *NumberTheoryResponse) GetBezout() []string {
+	if x != nil {
+		return x.Bezout
+	}
+	return nil
+}
+
+type PrimeFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prime    string `protobuf:"bytes,1,opt,name=prime,proto3" json:"prime,omitempty"`
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *PrimeFactor) Reset() {
+	*x = PrimeFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimeFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimeFactor) ProtoMessage() {}
+
+func (x *PrimeFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimeFactor.ProtoReflect.Descriptor instead.
+func (*PrimeFactor) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PrimeFactor) GetPrime() string {
+	if x != nil {
+		return x.Prime
+	}
+	return ""
+}
+
+func (x *PrimeFactor) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+type IntegerCalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator INTEGER_OPERATOR `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.INTEGER_OPERATOR" json:"operator,omitempty"`
+	Type     INTEGER_TYPE     `protobuf:"varint,2,opt,name=type,proto3,enum=calculatorpb.INTEGER_TYPE" json:"type,omitempty"`
+	Overflow OVERFLOW         `protobuf:"varint,3,opt,name=overflow,proto3,enum=calculatorpb.OVERFLOW" json:"overflow,omitempty"`
+	// operands are written in input_radix, operand_2 is ignored by the unary
+	// operators and is the decimal bit count of shifts and rotates. Operands of signed
+	// types may also be given as their unsigned bit pattern, e.g. 0xff for -1.
+	Operand_1 string `protobuf:"bytes,4,opt,name=operand_1,json=operand1,proto3" json:"operand_1,omitempty"`
+	Operand_2 string `protobuf:"bytes,5,opt,name=operand_2,json=operand2,proto3" json:"operand_2,omitempty"`
+	// input_radix is within [2, 36], by default operands are decimal and may
+	// have a 0b, 0o or 0x prefix.
+	InputRadix uint32 `protobuf:"varint,6,opt,name=input_radix,json=inputRadix,proto3" json:"input_radix,omitempty"`
+	// output_radix of result, default 10. Other radixes show the bit pattern.
+	OutputRadix uint32 `protobuf:"varint,7,opt,name=output_radix,json=outputRadix,proto3" json:"output_radix,omitempty"`
+}
+
+func (x *IntegerCalculateRequest) Reset() {
+	*x = IntegerCalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegerCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegerCalculateRequest) ProtoMessage() {}
+
+func (x *IntegerCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegerCalculateRequest.ProtoReflect.Descriptor instead.
+func (*IntegerCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IntegerCalculateRequest) GetOperator() INTEGER_OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return INTEGER_OPERATOR_DEFAULT_INTEGER_OPERATOR
+}
+
+func (x *IntegerCalculateRequest) GetType() INTEGER_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return INTEGER_TYPE_DEFAULT_INTEGER_TYPE
+}
+
+func (x *IntegerCalculateRequest) GetOverflow() OVERFLOW {
+	if x != nil {
+		return x.Overflow
+	}
+	return OVERFLOW_OVERFLOW_WRAP
+}
+
+func (x *IntegerCalculateRequest) GetOperand_1() string {
+	if x != nil {
+		return x.Operand_1
+	}
+	return ""
+}
+
+func (x *IntegerCalculateRequest) GetOperand_2() string {
+	if x != nil {
+		return x.Operand_2
+	}
+	return ""
+}
+
+func (x *IntegerCalculateRequest) GetInputRadix() uint32 {
+	if x != nil {
+		return x.InputRadix
+	}
+	return 0
+}
+
+func (x *IntegerCalculateRequest) GetOutputRadix() uint32 {
+	if x != nil {
+		return x.OutputRadix
+	}
+	return 0
+}
+
+type IntegerCalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Decimal string `protobuf:"bytes,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	// binary, octal and hexadecimal are the bit pattern of the result, binary
+	// and hexadecimal are padded to the width of the type.
+	Binary      string `protobuf:"bytes,3,opt,name=binary,proto3" json:"binary,omitempty"`
+	Octal       string `protobuf:"bytes,4,opt,name=octal,proto3" json:"octal,omitempty"`
+	Hexadecimal string `protobuf:"bytes,5,opt,name=hexadecimal,proto3" json:"hexadecimal,omitempty"`
+	// overflowed is set when the result wrapped around or saturated.
+	Overflowed bool `protobuf:"varint,6,opt,name=overflowed,proto3" json:"overflowed,omitempty"`
+}
+
+func (x *IntegerCalculateResponse) Reset() {
+	*x = IntegerCalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegerCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegerCalculateResponse) ProtoMessage() {}
+
+func (x *IntegerCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegerCalculateResponse.ProtoReflect.Descriptor instead.
+func (*IntegerCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IntegerCalculateResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *IntegerCalculateResponse) GetDecimal() string {
+	if x != nil {
+		return x.Decimal
+	}
+	return ""
+}
+
+func (x *IntegerCalculateResponse) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *IntegerCalculateResponse) GetOctal() string {
+	if x != nil {
+		return x.Octal
+	}
+	return ""
+}
+
+func (x *IntegerCalculateResponse) GetHexadecimal() string {
+	if x != nil {
+		return x.Hexadecimal
+	}
+	return ""
+}
+
+func (x *IntegerCalculateResponse) GetOverflowed() bool {
+	if x != nil {
+		return x.Overflowed
+	}
+	return false
+}
+
+type FloatBitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FloatBitsRequest) Reset() {
+	*x = FloatBitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatBitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatBitsRequest) ProtoMessage() {}
+
+func (x *FloatBitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatBitsRequest.ProtoReflect.Descriptor instead.
+func (*FloatBitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FloatBitsRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type FloatBitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sign           uint32 `protobuf:"varint,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	BiasedExponent uint32 `protobuf:"varint,2,opt,name=biased_exponent,json=biasedExponent,proto3" json:"biased_exponent,omitempty"`
+	// exponent is the unbiased exponent, it is meaningless for infinities and NaN.
+	Exponent int32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// mantissa holds the 52 stored fraction bits.
+	Mantissa uint64      `protobuf:"varint,4,opt,name=mantissa,proto3" json:"mantissa,omitempty"`
+	Class    FLOAT_CLASS `protobuf:"varint,5,opt,name=class,proto3,enum=calculatorpb.FLOAT_CLASS" json:"class,omitempty"`
+	// binary is the sign, exponent and fraction bits separated by spaces.
+	Binary      string `protobuf:"bytes,6,opt,name=binary,proto3" json:"binary,omitempty"`
+	Hexadecimal string `protobuf:"bytes,7,opt,name=hexadecimal,proto3" json:"hexadecimal,omitempty"`
+}
+
+func (x *FloatBitsResponse) Reset() {
+	*x = FloatBitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatBitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatBitsResponse) ProtoMessage() {}
+
+func (x *FloatBitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatBitsResponse.ProtoReflect.Descriptor instead.
+func (*FloatBitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FloatBitsResponse) GetSign() uint32 {
+	if x != nil {
+		return x.Sign
+	}
+	return 0
+}
+
+func (x *FloatBitsResponse) GetBiasedExponent() uint32 {
+	if x != nil {
+		return x.BiasedExponent
+	}
+	return 0
+}
+
+func (x *FloatBitsResponse) GetExponent() int32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *FloatBitsResponse) GetMantissa() uint64 {
+	if x != nil {
+		return x.Mantissa
+	}
+	return 0
+}
+
+func (x *FloatBitsResponse) GetClass() FLOAT_CLASS {
+	if x != nil {
+		return x.Class
+	}
+	return FLOAT_CLASS_FLOAT_CLASS_ZERO
+}
+
+func (x *FloatBitsResponse) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *FloatBitsResponse) GetHexadecimal() string {
+	if x != nil {
+		return x.Hexadecimal
+	}
+	return ""
+}
+
+// Quantity is a value with a unit expression such as "km", "kg*m/s^2" or
+// "°C". An empty unit is a dimensionless number.
+type Quantity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit  string  `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *Quantity) Reset() {
+	*x = Quantity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quantity) ProtoMessage() {}
+
+func (x *Quantity) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quantity.ProtoReflect.Descriptor instead.
+func (*Quantity) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Quantity) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Quantity) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type UnitCalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator UNIT_OPERATOR `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.UNIT_OPERATOR" json:"operator,omitempty"`
+	// operand_2 is ignored by convert.
+	Operand_1 *Quantity `protobuf:"bytes,2,opt,name=operand_1,json=operand1,proto3" json:"operand_1,omitempty"`
+	Operand_2 *Quantity `protobuf:"bytes,3,opt,name=operand_2,json=operand2,proto3" json:"operand_2,omitempty"`
+	// output_unit must have the dimension of the result. By default sums and
+	// differences are in the unit of operand_1, and products and quotients in
+	// the combined units of the operands.
+	OutputUnit string `protobuf:"bytes,4,opt,name=output_unit,json=outputUnit,proto3" json:"output_unit,omitempty"`
+}
+
+func (x *UnitCalculateRequest) Reset() {
+	*x = UnitCalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnitCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitCalculateRequest) ProtoMessage() {}
+
+func (x *UnitCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitCalculateRequest.ProtoReflect.Descriptor instead.
+func (*UnitCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnitCalculateRequest) GetOperator() UNIT_OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return UNIT_OPERATOR_DEFAULT_UNIT_OPERATOR
+}
+
+func (x *UnitCalculateRequest) GetOperand_1() *Quantity {
+	if x != nil {
+		return x.Operand_1
+	}
+	return nil
+}
+
+func (x *UnitCalculateRequest) GetOperand_2() *Quantity {
+	if x != nil {
+		return x.Operand_2
+	}
+	return nil
+}
+
+func (x *UnitCalculateRequest) GetOutputUnit() string {
+	if x != nil {
+		return x.OutputUnit
+	}
+	return ""
+}
+
+type UnitCalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *Quantity `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// base is the result in the base units of its dimension.
+	Base *Quantity `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// dimension is e.g. "length/time^2", empty for dimensionless results.
+	Dimension string `protobuf:"bytes,3,opt,name=dimension,proto3" json:"dimension,omitempty"`
+}
+
+func (x *UnitCalculateResponse) Reset() {
+	*x = UnitCalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnitCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitCalculateResponse) ProtoMessage() {}
+
+func (x *UnitCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitCalculateResponse.ProtoReflect.Descriptor instead.
+func (*UnitCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnitCalculateResponse) GetResult() *Quantity {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *UnitCalculateResponse) GetBase() *Quantity {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnitCalculateResponse) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+// Money is an exact decimal amount, e.g. "19.99", in an ISO 4217 currency.
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Money) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ConvertCurrencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amounts are converted to target_currency and summed.
+	Amounts        []*Money `protobuf:"bytes,1,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	TargetCurrency string   `protobuf:"bytes,2,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	Rounding       ROUNDING `protobuf:"varint,3,opt,name=rounding,proto3,enum=calculatorpb.ROUNDING" json:"rounding,omitempty"`
+}
+
+func (x *ConvertCurrencyRequest) Reset() {
+	*x = ConvertCurrencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertCurrencyRequest) ProtoMessage() {}
+
+func (x *ConvertCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*ConvertCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConvertCurrencyRequest) GetAmounts() []*Money {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *ConvertCurrencyRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *ConvertCurrencyRequest) GetRounding() ROUNDING {
+	if x != nil {
+		return x.Rounding
+	}
+	return ROUNDING_ROUNDING_HALF_EVEN
+}
+
+type ConvertCurrencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result is rounded to the minor unit of the target currency.
+	Result *Money `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// rates holds the rate used for every currency of the amounts.
+	Rates []*ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+}
+
+func (x *ConvertCurrencyResponse) Reset() {
+	*x = ConvertCurrencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertCurrencyResponse) ProtoMessage() {}
+
+func (x *ConvertCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*ConvertCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConvertCurrencyResponse) GetResult() *Money {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ConvertCurrencyResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// ExchangeRate is the price of one unit of base in quote.
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base  string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Rate  string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	AsOf  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExchangeRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type MoneyCalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator MONEY_OPERATOR `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.MONEY_OPERATOR" json:"operator,omitempty"`
+	// amount must not have more decimals than the minor unit of its currency.
+	Amount *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// other is the new amount of a percent change, in the currency of amount.
+	Other *Money `protobuf:"bytes,3,opt,name=other,proto3" json:"other,omitempty"`
+	// percent is a decimal such as "20" or "7.5".
+	Percent string `protobuf:"bytes,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	// ratios of an allocation are non-negative decimals, e.g. "1", "1", "1" or "70", "30".
+	Ratios   []string `protobuf:"bytes,5,rep,name=ratios,proto3" json:"ratios,omitempty"`
+	Rounding ROUNDING `protobuf:"varint,6,opt,name=rounding,proto3,enum=calculatorpb.ROUNDING" json:"rounding,omitempty"`
+}
+
+func (x *MoneyCalculateRequest) Reset() {
+	*x = MoneyCalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoneyCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyCalculateRequest) ProtoMessage() {}
+
+func (x *MoneyCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyCalculateRequest.ProtoReflect.Descriptor instead.
+func (*MoneyCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MoneyCalculateRequest) GetOperator() MONEY_OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return MONEY_OPERATOR_DEFAULT_MONEY_OPERATOR
+}
+
+func (x *MoneyCalculateRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *MoneyCalculateRequest) GetOther() *Money {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *MoneyCalculateRequest) GetPercent() string {
+	if x != nil {
+		return x.Percent
+	}
+	return ""
+}
+
+func (x *MoneyCalculateRequest) GetRatios() []string {
+	if x != nil {
+		return x.Ratios
+	}
+	return nil
+}
+
+func (x *MoneyCalculateRequest) GetRounding() ROUNDING {
+	if x != nil {
+		return x.Rounding
+	}
+	return ROUNDING_ROUNDING_HALF_EVEN
+}
+
+type MoneyCalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result is the total of an allocation, the price of a markup or margin,
+	// and the gross or net amount of a tax conversion.
+	Result *Money `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// parts of an allocation, in the order of the ratios.
+	Parts []*Money `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	// percent of a percent change, rounded to four decimals.
+	Percent string `protobuf:"bytes,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// tax of a tax conversion, the net amount and tax always sum to the gross amount.
+	Tax *Money `protobuf:"bytes,4,opt,name=tax,proto3" json:"tax,omitempty"`
+}
+
+func (x *MoneyCalculateResponse) Reset() {
+	*x = MoneyCalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoneyCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyCalculateResponse) ProtoMessage() {}
+
+func (x *MoneyCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyCalculateResponse.ProtoReflect.Descriptor instead.
+func (*MoneyCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoneyCalculateResponse) GetResult() *Money {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *MoneyCalculateResponse) GetParts() []*Money {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *MoneyCalculateResponse) GetPercent() string {
+	if x != nil {
+		return x.Percent
+	}
+	return ""
+}
+
+func (x *MoneyCalculateResponse) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+type TimeValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function TIME_VALUE `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.TIME_VALUE" json:"function,omitempty"`
+	// rate per period, e.g. 0.05/12 for 5% a year paid monthly.
+	Rate   float64        `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Nper   float64        `protobuf:"fixed64,3,opt,name=nper,proto3" json:"nper,omitempty"`
+	Pmt    float64        `protobuf:"fixed64,4,opt,name=pmt,proto3" json:"pmt,omitempty"`
+	Pv     float64        `protobuf:"fixed64,5,opt,name=pv,proto3" json:"pv,omitempty"`
+	Fv     float64        `protobuf:"fixed64,6,opt,name=fv,proto3" json:"fv,omitempty"`
+	Timing PAYMENT_TIMING `protobuf:"varint,7,opt,name=timing,proto3,enum=calculatorpb.PAYMENT_TIMING" json:"timing,omitempty"`
+	// guess is the starting point of RATE, default 0.1.
+	Guess float64 `protobuf:"fixed64,8,opt,name=guess,proto3" json:"guess,omitempty"`
+}
+
+func (x *TimeValueRequest) Reset() {
+	*x = TimeValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeValueRequest) ProtoMessage() {}
+
+func (x *TimeValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeValueRequest.ProtoReflect.Descriptor instead.
+func (*TimeValueRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TimeValueRequest) GetFunction() TIME_VALUE {
+	if x != nil {
+		return x.Function
+	}
+	return TIME_VALUE_DEFAULT_TIME_VALUE
+}
+
+func (x *TimeValueRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TimeValueRequest) GetNper() float64 {
+	if x != nil {
+		return x.Nper
+	}
+	return 0
+}
+
+func (x *TimeValueRequest) GetPmt() float64 {
+	if x != nil {
+		return x.Pmt
+	}
+	return 0
+}
+
+func (x *TimeValueRequest) GetPv() float64 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *TimeValueRequest) GetFv() float64 {
+	if x != nil {
+		return x.Fv
+	}
+	return 0
+}
+
+func (x *TimeValueRequest) GetTiming() PAYMENT_TIMING {
+	if x != nil {
+		return x.Timing
+	}
+	return PAYMENT_TIMING_PAYMENT_TIMING_END
+}
+
+func (x *TimeValueRequest) GetGuess() float64 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+type TimeValueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	// convergence is set for the functions that are solved iteratively.
+	Convergence *Convergence `protobuf:"bytes,2,opt,name=convergence,proto3" json:"convergence,omitempty"`
+}
+
+func (x *TimeValueResponse) Reset() {
+	*x = TimeValueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeValueResponse) ProtoMessage() {}
+
+func (x *TimeValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeValueResponse.ProtoReflect.Descriptor instead.
+func (*TimeValueResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TimeValueResponse) GetResult() float64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *TimeValueResponse) GetConvergence() *Convergence {
+	if x != nil {
+		return x.Convergence
+	}
+	return nil
+}
+
+// Convergence reports how an iterative solver reached its result.
+type Convergence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Converged  bool   `protobuf:"varint,1,opt,name=converged,proto3" json:"converged,omitempty"`
+	Iterations uint32 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// residual is the value of the solved function at the result.
+	Residual float64 `protobuf:"fixed64,3,opt,name=residual,proto3" json:"residual,omitempty"`
+	Method   string  `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// error estimates the distance of the result from the root, the half width
+	// of the last bracket or the size of the last step.
+	Error float64 `protobuf:"fixed64,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Convergence) Reset() {
+	*x = Convergence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Convergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Convergence) ProtoMessage() {}
+
+func (x *Convergence) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Convergence.ProtoReflect.Descriptor instead.
+func (*Convergence) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Convergence) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+func (x *Convergence) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Convergence) GetResidual() float64 {
+	if x != nil {
+		return x.Residual
+	}
+	return 0
+}
+
+func (x *Convergence) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Convergence) GetError() float64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+type CashFlowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function CASH_FLOW `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.CASH_FLOW" json:"function,omitempty"`
+	Values   []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// dates of XNPV and XIRR are ISO 8601 dates such as 2024-05-02, one for
+	// every value.
+	Dates []string `protobuf:"bytes,3,rep,name=dates,proto3" json:"dates,omitempty"`
+	// rate of NPV and XNPV.
+	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// guess is the starting point of IRR and XIRR, default 0.1.
+	Guess float64 `protobuf:"fixed64,5,opt,name=guess,proto3" json:"guess,omitempty"`
+}
+
+func (x *CashFlowRequest) Reset() {
+	*x = CashFlowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashFlowRequest) ProtoMessage() {}
+
+func (x *CashFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashFlowRequest.ProtoReflect.Descriptor instead.
+func (*CashFlowRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CashFlowRequest) GetFunction() CASH_FLOW {
+	if x != nil {
+		return x.Function
+	}
+	return CASH_FLOW_DEFAULT_CASH_FLOW
+}
+
+func (x *CashFlowRequest) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *CashFlowRequest) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *CashFlowRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CashFlowRequest) GetGuess() float64 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+type CashFlowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result      float64      `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	Convergence *Convergence `protobuf:"bytes,2,opt,name=convergence,proto3" json:"convergence,omitempty"`
+}
+
+func (x *CashFlowResponse) Reset() {
+	*x = CashFlowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashFlowResponse) ProtoMessage() {}
+
+func (x *CashFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashFlowResponse.ProtoReflect.Descriptor instead.
+func (*CashFlowResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CashFlowResponse) GetResult() float64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *CashFlowResponse) GetConvergence() *Convergence {
+	if x != nil {
+		return x.Convergence
+	}
+	return nil
+}
+
+type AmortizationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate   float64        `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Nper   uint32         `protobuf:"varint,2,opt,name=nper,proto3" json:"nper,omitempty"`
+	Pv     float64        `protobuf:"fixed64,3,opt,name=pv,proto3" json:"pv,omitempty"`
+	Fv     float64        `protobuf:"fixed64,4,opt,name=fv,proto3" json:"fv,omitempty"`
+	Timing PAYMENT_TIMING `protobuf:"varint,5,opt,name=timing,proto3,enum=calculatorpb.PAYMENT_TIMING" json:"timing,omitempty"`
+}
+
+func (x *AmortizationRequest) Reset() {
+	*x = AmortizationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmortizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmortizationRequest) ProtoMessage() {}
+
+func (x *AmortizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmortizationRequest.ProtoReflect.Descriptor instead.
+func (*AmortizationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AmortizationRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *AmortizationRequest) GetNper() uint32 {
+	if x != nil {
+		return x.Nper
+	}
+	return 0
+}
+
+func (x *AmortizationRequest) GetPv() float64 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *AmortizationRequest) GetFv() float64 {
+	if x != nil {
+		return x.Fv
+	}
+	return 0
+}
+
+func (x *AmortizationRequest) GetTiming() PAYMENT_TIMING {
+	if x != nil {
+		return x.Timing
+	}
+	return PAYMENT_TIMING_PAYMENT_TIMING_END
+}
+
+// AmortizationRow is one period of a loan, interest and principal are the
+// spreadsheet IPMT and PPMT of the period.
+type AmortizationRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period    uint32  `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Payment   float64 `protobuf:"fixed64,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	Interest  float64 `protobuf:"fixed64,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal float64 `protobuf:"fixed64,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	// balance is left to pay after the period.
+	Balance float64 `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *AmortizationRow) Reset() {
+	*x = AmortizationRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmortizationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmortizationRow) ProtoMessage() {}
+
+func (x *AmortizationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmortizationRow.ProtoReflect.Descriptor instead.
+func (*AmortizationRow) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AmortizationRow) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *AmortizationRow) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *AmortizationRow) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *AmortizationRow) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *AmortizationRow) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type DepreciationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method  DEPRECIATION `protobuf:"varint,1,opt,name=method,proto3,enum=calculatorpb.DEPRECIATION" json:"method,omitempty"`
+	Cost    float64      `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Salvage float64      `protobuf:"fixed64,3,opt,name=salvage,proto3" json:"salvage,omitempty"`
+	// life in periods.
+	Life uint32 `protobuf:"varint,4,opt,name=life,proto3" json:"life,omitempty"`
+	// month is the number of months in the first year of a declining balance,
+	// default 12. Fewer months add a partial period at the end.
+	Month uint32 `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
+	// factor of the double-declining balance, default 2.
+	Factor float64 `protobuf:"fixed64,6,opt,name=factor,proto3" json:"factor,omitempty"`
+}
+
+func (x *DepreciationRequest) Reset() {
+	*x = DepreciationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepreciationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepreciationRequest) ProtoMessage() {}
+
+func (x *DepreciationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepreciationRequest.ProtoReflect.Descriptor instead.
+func (*DepreciationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DepreciationRequest) GetMethod() DEPRECIATION {
+	if x != nil {
+		return x.Method
+	}
+	return DEPRECIATION_DEFAULT_DEPRECIATION
+}
+
+func (x *DepreciationRequest) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *DepreciationRequest) GetSalvage() float64 {
+	if x != nil {
+		return x.Salvage
+	}
+	return 0
+}
+
+func (x *DepreciationRequest) GetLife() uint32 {
+	if x != nil {
+		return x.Life
+	}
+	return 0
+}
+
+func (x *DepreciationRequest) GetMonth() uint32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *DepreciationRequest) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type DepreciationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*DepreciationRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *DepreciationResponse) Reset() {
+	*x = DepreciationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepreciationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepreciationResponse) ProtoMessage() {}
+
+func (x *DepreciationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepreciationResponse.ProtoReflect.Descriptor instead.
+func (*DepreciationResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DepreciationResponse) GetRows() []*DepreciationRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type DepreciationRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period       uint32  `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Depreciation float64 `protobuf:"fixed64,2,opt,name=depreciation,proto3" json:"depreciation,omitempty"`
+	Accumulated  float64 `protobuf:"fixed64,3,opt,name=accumulated,proto3" json:"accumulated,omitempty"`
+	BookValue    float64 `protobuf:"fixed64,4,opt,name=book_value,json=bookValue,proto3" json:"book_value,omitempty"`
+}
+
+func (x *DepreciationRow) Reset() {
+	*x = DepreciationRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepreciationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepreciationRow) ProtoMessage() {}
+
+func (x *DepreciationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepreciationRow.ProtoReflect.Descriptor instead.
+func (*DepreciationRow) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DepreciationRow) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *DepreciationRow) GetDepreciation() float64 {
+	if x != nil {
+		return x.Depreciation
+	}
+	return 0
+}
+
+func (x *DepreciationRow) GetAccumulated() float64 {
+	if x != nil {
+		return x.Accumulated
+	}
+	return 0
+}
+
+func (x *DepreciationRow) GetBookValue() float64 {
+	if x != nil {
+		return x.BookValue
+	}
+	return 0
+}
+
+type DateCalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator DATE_OPERATOR `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.DATE_OPERATOR" json:"operator,omitempty"`
+	// time and other are ISO 8601 dates such as 2024-05-02 or date-times such
+	// as 2024-05-02T09:30:00+02:00. Date-times without an offset are read in
+	// time_zone.
+	Time  string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Other string `protobuf:"bytes,3,opt,name=other,proto3" json:"other,omitempty"`
+	// duration is an ISO 8601 duration such as P1Y2M10DT2H30M or -P3M.
+	Duration string `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// days is the number of business days to add.
+	Days int32 `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
+	// calendar names the holiday calendar of business days, the default
+	// calendar has Saturday and Sunday as its weekend and no holidays.
+	Calendar string `protobuf:"bytes,6,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	// time_zone is an IANA time zone such as Europe/Berlin, default UTC.
+	TimeZone       string `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	TargetTimeZone string `protobuf:"bytes,8,opt,name=target_time_zone,json=targetTimeZone,proto3" json:"target_time_zone,omitempty"`
+}
+
+func (x *DateCalculateRequest) Reset() {
+	*x = DateCalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateCalculateRequest) ProtoMessage() {}
+
+func (x *DateCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateCalculateRequest.ProtoReflect.Descriptor instead.
+func (*DateCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DateCalculateRequest) GetOperator() DATE_OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return DATE_OPERATOR_DEFAULT_DATE_OPERATOR
+}
+
+func (x *DateCalculateRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DateCalculateRequest) GetOther() string {
+	if x != nil {
+		return x.Other
+	}
+	return ""
+}
+
+func (x *DateCalculateRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *DateCalculateRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DateCalculateRequest) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *DateCalculateRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *DateCalculateRequest) GetTargetTimeZone() string {
+	if x != nil {
+		return x.TargetTimeZone
+	}
+	return ""
+}
+
+type DateCalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time is the resulting date or date-time in ISO 8601, a date when the
+	// input was a date.
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// duration of a difference in ISO 8601, in years, months, days and time.
+	Duration string `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// days of a difference are whole calendar days, of business days the count.
+	Days int64 `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	// seconds of a difference are the elapsed seconds.
+	Seconds     float64 `protobuf:"fixed64,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	BusinessDay bool    `protobuf:"varint,5,opt,name=business_day,json=businessDay,proto3" json:"business_day,omitempty"`
+}
+
+func (x *DateCalculateResponse) Reset() {
+	*x = DateCalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateCalculateResponse) ProtoMessage() {}
+
+func (x *DateCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateCalculateResponse.ProtoReflect.Descriptor instead.
+func (*DateCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DateCalculateResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DateCalculateResponse) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *DateCalculateResponse) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DateCalculateResponse) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *DateCalculateResponse) GetBusinessDay() bool {
+	if x != nil {
+		return x.BusinessDay
+	}
+	return false
+}
+
+type RuleTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// table is the name of a rule table loaded by the service.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// amount is a non-negative decimal such as "52000" or "149.99".
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// rounding of the amount of every bracket to the decimals of the table.
+	Rounding ROUNDING `protobuf:"varint,3,opt,name=rounding,proto3,enum=calculatorpb.ROUNDING" json:"rounding,omitempty"`
+}
+
+func (x *RuleTableRequest) Reset() {
+	*x = RuleTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTableRequest) ProtoMessage() {}
+
+func (x *RuleTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTableRequest.ProtoReflect.Descriptor instead.
+func (*RuleTableRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RuleTableRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *RuleTableRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RuleTableRequest) GetRounding() ROUNDING {
+	if x != nil {
+		return x.Rounding
+	}
+	return ROUNDING_ROUNDING_HALF_EVEN
+}
+
+type RuleTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// result is the sum of the amounts of the brackets.
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// effective_percent is result as a percent of amount, rounded to four
+	// decimals, and empty for an amount of zero.
+	EffectivePercent string `protobuf:"bytes,2,opt,name=effective_percent,json=effectivePercent,proto3" json:"effective_percent,omitempty"`
+	// marginal_percent is the percent of the bracket the amount falls in.
+	MarginalPercent string `protobuf:"bytes,3,opt,name=marginal_percent,json=marginalPercent,proto3" json:"marginal_percent,omitempty"`
+	// brackets the amount reaches, in the order of the table.
+	Brackets []*RuleTableBracket `protobuf:"bytes,4,rep,name=brackets,proto3" json:"brackets,omitempty"`
+}
+
+func (x *RuleTableResponse) Reset() {
+	*x = RuleTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTableResponse) ProtoMessage() {}
+
+func (x *RuleTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTableResponse.ProtoReflect.Descriptor instead.
+func (*RuleTableResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RuleTableResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *RuleTableResponse) GetEffectivePercent() string {
+	if x != nil {
+		return x.EffectivePercent
+	}
+	return ""
+}
+
+func (x *RuleTableResponse) GetMarginalPercent() string {
+	if x != nil {
+		return x.MarginalPercent
+	}
+	return ""
+}
+
+func (x *RuleTableResponse) GetBrackets() []*RuleTableBracket {
+	if x != nil {
+		return x.Brackets
+	}
+	return nil
+}
+
+type RuleTableBracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is empty for the last bracket.
+	To      string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Percent string `protobuf:"bytes,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Fixed   string `protobuf:"bytes,4,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	// base is the part of amount in the bracket of a marginal table, and the
+	// whole amount for a flat one.
+	Base string `protobuf:"bytes,5,opt,name=base,proto3" json:"base,omitempty"`
+	// amount is fixed plus percent of base, rounded.
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RuleTableBracket) Reset() {
+	*x = RuleTableBracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleTableBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTableBracket) ProtoMessage() {}
+
+func (x *RuleTableBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTableBracket.ProtoReflect.Descriptor instead.
+func (*RuleTableBracket) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RuleTableBracket) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RuleTableBracket) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RuleTableBracket) GetPercent() string {
+	if x != nil {
+		return x.Percent
+	}
+	return ""
+}
+
+func (x *RuleTableBracket) GetFixed() string {
+	if x != nil {
+		return x.Fixed
+	}
+	return ""
+}
+
+func (x *RuleTableBracket) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *RuleTableBracket) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// SymbolicRequest carries an infix expression such as "x^3 + 2x*sin(y)".
+// Expressions have the operators + - * / ^, parentheses, numbers, which may
+// be followed by a variable or function for implicit multiplication, the
+// constants pi and e, and the functions sin, cos, tan, asin, acos, atan, sinh,
+// cosh, tanh, exp, ln, log (base 10), sqrt and abs.
+type SymbolicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation  SYMBOLIC `protobuf:"varint,1,opt,name=operation,proto3,enum=calculatorpb.SYMBOLIC" json:"operation,omitempty"`
+	Expression string   `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// variable to differentiate with respect to, default x.
+	Variable string `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	// order of the derivative, default 1.
+	Order uint32 `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	// values of variables, substituted after the operation.
+	Values map[string]float64 `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *SymbolicRequest) Reset() {
+	*x = SymbolicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicRequest) ProtoMessage() {}
+
+func (x *SymbolicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicRequest.ProtoReflect.Descriptor instead.
+func (*SymbolicRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SymbolicRequest) GetOperation() SYMBOLIC {
+	if x != nil {
+		return x.Operation
+	}
+	return SYMBOLIC_DEFAULT_SYMBOLIC
+}
+
+func (x *SymbolicRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SymbolicRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *SymbolicRequest) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *SymbolicRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SymbolicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expression is the simplified result.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// constant tells whether the result has no variables left, its value is
+	// then value.
+	Constant bool    `protobuf:"varint,2,opt,name=constant,proto3" json:"constant,omitempty"`
+	Value    float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// variables left in the result, in alphabetical order.
+	Variables []string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+}
+
+func (x *SymbolicResponse) Reset() {
+	*x = SymbolicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicResponse) ProtoMessage() {}
+
+func (x *SymbolicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicResponse.ProtoReflect.Descriptor instead.
+func (*SymbolicResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SymbolicResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SymbolicResponse) GetConstant() bool {
+	if x != nil {
+		return x.Constant
+	}
+	return false
+}
+
+func (x *SymbolicResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SymbolicResponse) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type EvaluateExpressionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expression in the syntax of SymbolicRequest.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// values of the variables of the expression.
+	Values map[string]float64 `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// gradient also computes the partial derivatives of the expression at
+	// values, by forward-mode automatic differentiation.
+	Gradient bool `protobuf:"varint,3,opt,name=gradient,proto3" json:"gradient,omitempty"`
+	// with_respect_to are the variables of the gradient, default all the
+	// variables of the expression in alphabetical order.
+	WithRespectTo []string `protobuf:"bytes,4,rep,name=with_respect_to,json=withRespectTo,proto3" json:"with_respect_to,omitempty"`
+}
+
+func (x *EvaluateExpressionRequest) Reset() {
+	*x = EvaluateExpressionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionRequest) ProtoMessage() {}
+
+func (x *EvaluateExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EvaluateExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionRequest) GetGradient() bool {
+	if x != nil {
+		return x.Gradient
+	}
+	return false
+}
+
+func (x *EvaluateExpressionRequest) GetWithRespectTo() []string {
+	if x != nil {
+		return x.WithRespectTo
+	}
+	return nil
+}
+
+type EvaluateExpressionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// gradient holds the partial derivatives in the order of variables.
+	Gradient  []float64 `protobuf:"fixed64,2,rep,packed,name=gradient,proto3" json:"gradient,omitempty"`
+	Variables []string  `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+}
+
+func (x *EvaluateExpressionResponse) Reset() {
+	*x = EvaluateExpressionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionResponse) ProtoMessage() {}
+
+func (x *EvaluateExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EvaluateExpressionResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EvaluateExpressionResponse) GetGradient() []float64 {
+	if x != nil {
+		return x.Gradient
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+// SolveRequest finds the roots of an equation in one variable.
+type SolveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solver SOLVER `protobuf:"varint,1,opt,name=solver,proto3,enum=calculatorpb.SOLVER" json:"solver,omitempty"`
+	// equation in the syntax of SymbolicRequest, either an expression f that
+	// is solved for f = 0 or two expressions such as "x^2 = 2".
+	Equation string `protobuf:"bytes,2,opt,name=equation,proto3" json:"equation,omitempty"`
+	// variable to solve for, default x.
+	Variable string `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	// values of the other variables of the equation.
+	Values map[string]float64 `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// lower and upper bracket a root for bisection and Brent's method, the
+	// equation must change sign between them.
+	Lower float64 `protobuf:"fixed64,5,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,6,opt,name=upper,proto3" json:"upper,omitempty"`
+	// initial guess of Newton's method.
+	Initial float64 `protobuf:"fixed64,7,opt,name=initial,proto3" json:"initial,omitempty"`
+	// tolerance on the root, default 1e-12.
+	Tolerance float64 `protobuf:"fixed64,8,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// max_iterations of the numerical methods, default 100.
+	MaxIterations uint32 `protobuf:"varint,9,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SolveRequest) GetSolver() SOLVER {
+	if x != nil {
+		return x.Solver
+	}
+	return SOLVER_DEFAULT_SOLVER
+}
+
+func (x *SolveRequest) GetEquation() string {
+	if x != nil {
+		return x.Equation
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetInitial() float64 {
+	if x != nil {
+		return x.Initial
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetMaxIterations() uint32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+type Root struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Real float64 `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imag float64 `protobuf:"fixed64,2,opt,name=imag,proto3" json:"imag,omitempty"`
+}
+
+func (x *Root) Reset() {
+	*x = Root{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Root) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Root) ProtoMessage() {}
+
+func (x *Root) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Root.ProtoReflect.Descriptor instead.
+func (*Root) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Root) GetReal() float64 {
+	if x != nil {
+		return x.Real
+	}
+	return 0
+}
+
+func (x *Root) GetImag() float64 {
+	if x != nil {
+		return x.Imag
+	}
+	return 0
+}
+
+type SolveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// roots found, ordered by real and then imaginary part. The numerical
+	// methods find a single real root.
+	Roots       []*Root      `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	Convergence *Convergence `protobuf:"bytes,2,opt,name=convergence,proto3" json:"convergence,omitempty"`
+}
+
+func (x *SolveResponse) Reset() {
+	*x = SolveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResponse) ProtoMessage() {}
+
+func (x *SolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResponse.ProtoReflect.Descriptor instead.
+func (*SolveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SolveResponse) GetRoots() []*Root {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *SolveResponse) GetConvergence() *Convergence {
+	if x != nil {
+		return x.Convergence
+	}
+	return nil
+}
+
+// IntegrateRequest is the definite integral of an expression over lower to
+// upper. Infinite bounds integrate over a change of variable that maps them
+// to finite ones.
+type IntegrateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method QUADRATURE `protobuf:"varint,1,opt,name=method,proto3,enum=calculatorpb.QUADRATURE" json:"method,omitempty"`
+	// expression in the syntax of SymbolicRequest.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// variable of integration, default x.
+	Variable string `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	// values of the other variables of the expression.
+	Values map[string]float64 `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Lower  float64            `protobuf:"fixed64,5,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper  float64            `protobuf:"fixed64,6,opt,name=upper,proto3" json:"upper,omitempty"`
+	// tolerance on the error, relative to the value when it is above 1, default
+	// 1e-10.
+	Tolerance float64 `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// max_evaluations of the expression, default and at most the limit of the
+	// service.
+	MaxEvaluations uint64 `protobuf:"varint,8,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+}
+
+func (x *IntegrateRequest) Reset() {
+	*x = IntegrateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegrateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrateRequest) ProtoMessage() {}
+
+func (x *IntegrateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrateRequest.ProtoReflect.Descriptor instead.
+func (*IntegrateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IntegrateRequest) GetMethod() QUADRATURE {
+	if x != nil {
+		return x.Method
+	}
+	return QUADRATURE_DEFAULT_QUADRATURE
+}
+
+func (x *IntegrateRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *IntegrateRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *IntegrateRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *IntegrateRequest) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+type IntegrateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// error estimates the absolute error of value.
+	Error float64 `protobuf:"fixed64,2,opt,name=error,proto3" json:"error,omitempty"`
+	// converged tells whether error is within the tolerance.
+	Converged   bool   `protobuf:"varint,3,opt,name=converged,proto3" json:"converged,omitempty"`
+	Evaluations uint64 `protobuf:"varint,4,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+}
+
+func (x *IntegrateResponse) Reset() {
+	*x = IntegrateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegrateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrateResponse) ProtoMessage() {}
+
+func (x *IntegrateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrateResponse.ProtoReflect.Descriptor instead.
+func (*IntegrateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *IntegrateResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IntegrateResponse) GetError() float64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *IntegrateResponse) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+func (x *IntegrateResponse) GetEvaluations() uint64 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+// SumSeriesRequest is the sum of an expression over the integers start to
+// end, or start to infinity for an infinite series.
+type SumSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expression in the syntax of SymbolicRequest.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// variable of summation, default n.
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// values of the other variables of the expression.
+	Values map[string]float64 `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Start  int64              `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End    int64              `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	// infinite sums from start without end.
+	Infinite bool `protobuf:"varint,6,opt,name=infinite,proto3" json:"infinite,omitempty"`
+	// tolerance on the error of an infinite series, relative to the value when
+	// it is above 1, default 1e-10.
+	Tolerance float64 `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// max_evaluations of the expression, default and at most the limit of the
+	// service.
+	MaxEvaluations uint64 `protobuf:"varint,8,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+}
+
+func (x *SumSeriesRequest) Reset() {
+	*x = SumSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SumSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SumSeriesRequest) ProtoMessage() {}
+
+func (x *SumSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SumSeriesRequest.ProtoReflect.Descriptor instead.
+func (*SumSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SumSeriesRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SumSeriesRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *SumSeriesRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SumSeriesRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SumSeriesRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SumSeriesRequest) GetInfinite() bool {
+	if x != nil {
+		return x.Infinite
+	}
+	return false
+}
+
+func (x *SumSeriesRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *SumSeriesRequest) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+type SumSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// error estimates the absolute error of value, from rounding for a finite
+	// series.
+	Error float64 `protobuf:"fixed64,2,opt,name=error,proto3" json:"error,omitempty"`
+	// terms of the series evaluated.
+	Terms uint64 `protobuf:"varint,3,opt,name=terms,proto3" json:"terms,omitempty"`
+	// method is "direct" for the partial sums or "levin" for the Levin
+	// u-transform that accelerates an infinite series.
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *SumSeriesResponse) Reset() {
+	*x = SumSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SumSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SumSeriesResponse) ProtoMessage() {}
+
+func (x *SumSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SumSeriesResponse.ProtoReflect.Descriptor instead.
+func (*SumSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SumSeriesResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SumSeriesResponse) GetError() float64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *SumSeriesResponse) GetTerms() uint64 {
+	if x != nil {
+		return x.Terms
+	}
+	return 0
+}
+
+func (x *SumSeriesResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// ODEEquation is dy/dt = derivative for a variable y.
+type ODEEquation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variable string `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	// derivative in the syntax of SymbolicRequest, of the time variable, the
+	// variables of the system and the values of the request.
+	Derivative string `protobuf:"bytes,2,opt,name=derivative,proto3" json:"derivative,omitempty"`
+	// initial value of the variable at start.
+	Initial float64 `protobuf:"fixed64,3,opt,name=initial,proto3" json:"initial,omitempty"`
+}
+
+func (x *ODEEquation) Reset() {
+	*x = ODEEquation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ODEEquation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ODEEquation) ProtoMessage() {}
+
+func (x *ODEEquation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ODEEquation.ProtoReflect.Descriptor instead.
+func (*ODEEquation) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ODEEquation) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *ODEEquation) GetDerivative() string {
+	if x != nil {
+		return x.Derivative
+	}
+	return ""
+}
+
+func (x *ODEEquation) GetInitial() float64 {
+	if x != nil {
+		return x.Initial
+	}
+	return 0
+}
+
+// SolveODERequest is an initial value problem of a system of ordinary
+// differential equations.
+type SolveODERequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Solver    ODE_SOLVER     `protobuf:"varint,1,opt,name=solver,proto3,enum=calculatorpb.ODE_SOLVER" json:"solver,omitempty"`
+	Equations []*ODEEquation `protobuf:"bytes,2,rep,name=equations,proto3" json:"equations,omitempty"`
+	// time_variable of the derivatives, default t.
+	TimeVariable string `protobuf:"bytes,3,opt,name=time_variable,json=timeVariable,proto3" json:"time_variable,omitempty"`
+	// start and end of the time range, end may be before start.
+	Start float64 `protobuf:"fixed64,4,opt,name=start,proto3" json:"start,omitempty"`
+	End   float64 `protobuf:"fixed64,5,opt,name=end,proto3" json:"end,omitempty"`
+	// step of RK4 and the first step of Dormand-Prince, default a hundredth of
+	// the time range.
+	Step float64 `protobuf:"fixed64,6,opt,name=step,proto3" json:"step,omitempty"`
+	// tolerance of the local error of Dormand-Prince, relative to the values
+	// when they are above 1, default 1e-8.
+	Tolerance float64 `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// values of the other variables of the derivatives.
+	Values map[string]float64 `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// max_evaluations of the derivatives, default and at most the limit of the
+	// service.
+	MaxEvaluations uint64 `protobuf:"varint,9,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+}
+
+func (x *SolveODERequest) Reset() {
+	*x = SolveODERequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveODERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveODERequest) ProtoMessage() {}
+
+func (x *SolveODERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveODERequest.ProtoReflect.Descriptor instead.
+func (*SolveODERequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SolveODERequest) GetSolver() ODE_SOLVER {
+	if x != nil {
+		return x.Solver
+	}
+	return ODE_SOLVER_DEFAULT_ODE_SOLVER
+}
+
+func (x *SolveODERequest) GetEquations() []*ODEEquation {
+	if x != nil {
+		return x.Equations
+	}
+	return nil
+}
+
+func (x *SolveODERequest) GetTimeVariable() string {
+	if x != nil {
+		return x.TimeVariable
+	}
+	return ""
+}
+
+func (x *SolveODERequest) GetStart() float64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SolveODERequest) GetEnd() float64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SolveODERequest) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *SolveODERequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *SolveODERequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SolveODERequest) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+// ODEPoint is a point of the trajectory, the first is the initial values at
+// start and the last the values at end.
+type ODEPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T float64 `protobuf:"fixed64,1,opt,name=t,proto3" json:"t,omitempty"`
+	// values of the variables in the order of the equations.
+	Values []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ODEPoint) Reset() {
+	*x = ODEPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ODEPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ODEPoint) ProtoMessage() {}
+
+func (x *ODEPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ODEPoint.ProtoReflect.Descriptor instead.
+func (*ODEPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ODEPoint) GetT() float64 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *ODEPoint) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// PlotSeries is an expression to plot.
+type PlotSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expression in the syntax of SymbolicRequest, of the variable of the plot
+	// and the values of the request.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// label of the series in the legend, default the expression.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *PlotSeries) Reset() {
+	*x = PlotSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotSeries) ProtoMessage() {}
+
+func (x *PlotSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotSeries.ProtoReflect.Descriptor instead.
+func (*PlotSeries) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PlotSeries) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *PlotSeries) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// PlotRequest samples expressions over a range of the variable, and renders
+// them as an SVG chart.
+type PlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Series []*PlotSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	// variable of the expressions, default x.
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// lower and upper bounds of the range of the variable.
+	Lower float64 `protobuf:"fixed64,3,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	// samples evenly spaced over the range before refinement, default 200.
+	Samples uint32 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// values of the other variables of the expressions.
+	Values map[string]float64 `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// svg renders the chart.
+	Svg bool `protobuf:"varint,7,opt,name=svg,proto3" json:"svg,omitempty"`
+	// width and height of the chart in pixels, default 640 by 400.
+	Width  uint32 `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Title  string `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
+	// y_lower and y_upper bound the y axis, chosen to fit the samples when both
+	// are 0.
+	YLower float64 `protobuf:"fixed64,11,opt,name=y_lower,json=yLower,proto3" json:"y_lower,omitempty"`
+	YUpper float64 `protobuf:"fixed64,12,opt,name=y_upper,json=yUpper,proto3" json:"y_upper,omitempty"`
+	// max_evaluations of the expressions, default and at most the limit of the
+	// service.
+	MaxEvaluations uint64 `protobuf:"varint,13,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+}
+
+func (x *PlotRequest) Reset() {
+	*x = PlotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotRequest) ProtoMessage() {}
+
+func (x *PlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotRequest.ProtoReflect.Descriptor instead.
+func (*PlotRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PlotRequest) GetSeries() []*PlotSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *PlotRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *PlotRequest) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PlotRequest) GetSvg() bool {
+	if x != nil {
+		return x.Svg
+	}
+	return false
+}
+
+func (x *PlotRequest) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlotRequest) GetYLower() float64 {
+	if x != nil {
+		return x.YLower
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetYUpper() float64 {
+	if x != nil {
+		return x.YUpper
+	}
+	return 0
+}
+
+func (x *PlotRequest) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+type PlotPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *PlotPoint) Reset() {
+	*x = PlotPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotPoint) ProtoMessage() {}
+
+func (x *PlotPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotPoint.ProtoReflect.Descriptor instead.
+func (*PlotPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PlotPoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PlotPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// PlotSegment is a run of points between which the expression is continuous
+// as far as the sampling can tell.
+type PlotSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*PlotPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *PlotSegment) Reset() {
+	*x = PlotSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotSegment) ProtoMessage() {}
+
+func (x *PlotSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotSegment.ProtoReflect.Descriptor instead.
+func (*PlotSegment) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PlotSegment) GetPoints() []*PlotPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PlotData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// segments of the series, broken where the expression is undefined or
+	// jumps.
+	Segments []*PlotSegment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *PlotData) Reset() {
+	*x = PlotData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotData) ProtoMessage() {}
+
+func (x *PlotData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotData.ProtoReflect.Descriptor instead.
+func (*PlotData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PlotData) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PlotData) GetSegments() []*PlotSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type PlotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// series in the order of the request.
+	Series []*PlotData `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	// y_lower and y_upper are the bounds of the y axis.
+	YLower float64 `protobuf:"fixed64,2,opt,name=y_lower,json=yLower,proto3" json:"y_lower,omitempty"`
+	YUpper float64 `protobuf:"fixed64,3,opt,name=y_upper,json=yUpper,proto3" json:"y_upper,omitempty"`
+	// svg is the chart when the request asks for it.
+	Svg string `protobuf:"bytes,4,opt,name=svg,proto3" json:"svg,omitempty"`
+	// evaluations of the expressions.
+	Evaluations uint64 `protobuf:"varint,5,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+}
+
+func (x *PlotResponse) Reset() {
+	*x = PlotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotResponse) ProtoMessage() {}
+
+func (x *PlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotResponse.ProtoReflect.Descriptor instead.
+func (*PlotResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PlotResponse) GetSeries() []*PlotData {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *PlotResponse) GetYLower() float64 {
+	if x != nil {
+		return x.YLower
+	}
+	return 0
+}
+
+func (x *PlotResponse) GetYUpper() float64 {
+	if x != nil {
+		return x.YUpper
+	}
+	return 0
+}
+
+func (x *PlotResponse) GetSvg() string {
+	if x != nil {
+		return x.Svg
+	}
+	return ""
+}
+
+func (x *PlotResponse) GetEvaluations() uint64 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+// InterpolateRequest interpolates tabulated points y = f(x).
+type InterpolateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method INTERPOLATION `protobuf:"varint,1,opt,name=method,proto3,enum=calculatorpb.INTERPOLATION" json:"method,omitempty"`
+	// x of the points in any order, without repeats.
+	X []float64 `protobuf:"fixed64,2,rep,packed,name=x,proto3" json:"x,omitempty"`
+	Y []float64 `protobuf:"fixed64,3,rep,packed,name=y,proto3" json:"y,omitempty"`
+	// at are the x to interpolate at.
+	At []float64 `protobuf:"fixed64,4,rep,packed,name=at,proto3" json:"at,omitempty"`
+	// extrapolate allows at outside the range of x, which otherwise is an
+	// error.
+	Extrapolate bool `protobuf:"varint,5,opt,name=extrapolate,proto3" json:"extrapolate,omitempty"`
+}
+
+func (x *InterpolateRequest) Reset() {
+	*x = InterpolateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterpolateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterpolateRequest) ProtoMessage() {}
+
+func (x *InterpolateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterpolateRequest.ProtoReflect.Descriptor instead.
+func (*InterpolateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InterpolateRequest) GetMethod() INTERPOLATION {
+	if x != nil {
+		return x.Method
+	}
+	return INTERPOLATION_DEFAULT_INTERPOLATION
+}
+
+func (x *InterpolateRequest) GetX() []float64 {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *InterpolateRequest) GetY() []float64 {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+func (x *InterpolateRequest) GetAt() []float64 {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *InterpolateRequest) GetExtrapolate() bool {
+	if x != nil {
+		return x.Extrapolate
+	}
+	return false
+}
+
+type InterpolateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// values at the x of at, in its order.
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *InterpolateResponse) Reset() {
+	*x = InterpolateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterpolateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterpolateResponse) ProtoMessage() {}
+
+func (x *InterpolateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterpolateResponse.ProtoReflect.Descriptor instead.
+func (*InterpolateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *InterpolateResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// FitRequest fits a model to points by least squares.
+type FitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model FIT_MODEL `protobuf:"varint,1,opt,name=model,proto3,enum=calculatorpb.FIT_MODEL" json:"model,omitempty"`
+	X     []float64 `protobuf:"fixed64,2,rep,packed,name=x,proto3" json:"x,omitempty"`
+	Y     []float64 `protobuf:"fixed64,3,rep,packed,name=y,proto3" json:"y,omitempty"`
+	// degree of a polynomial model, default 2, at most 10.
+	Degree uint32 `protobuf:"varint,4,opt,name=degree,proto3" json:"degree,omitempty"`
+	// variable of the expression of the response, default x.
+	Variable string `protobuf:"bytes,5,opt,name=variable,proto3" json:"variable,omitempty"`
+}
+
+func (x *FitRequest) Reset() {
+	*x = FitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FitRequest) ProtoMessage() {}
+
+func (x *FitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FitRequest.ProtoReflect.Descriptor instead.
+func (*FitRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FitRequest) GetModel() FIT_MODEL {
+	if x != nil {
+		return x.Model
+	}
+	return FIT_MODEL_DEFAULT_FIT_MODEL
+}
+
+func (x *FitRequest) GetX() []float64 {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *FitRequest) GetY() []float64 {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+func (x *FitRequest) GetDegree() uint32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *FitRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+type FitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coefficients c0, c1, ... of the model.
+	Coefficients []float64 `protobuf:"fixed64,1,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	// r_squared is the coefficient of determination, 1 less the sum of
+	// squared residuals over the total sum of squares of y.
+	RSquared float64 `protobuf:"fixed64,2,opt,name=r_squared,json=rSquared,proto3" json:"r_squared,omitempty"`
+	// residuals are y less the model at x, in the order of the points.
+	Residuals []float64 `protobuf:"fixed64,3,rep,packed,name=residuals,proto3" json:"residuals,omitempty"`
+	// expression is the fitted model in the syntax of SymbolicRequest.
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *FitResponse) Reset() {
+	*x = FitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FitResponse) ProtoMessage() {}
+
+func (x *FitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FitResponse.ProtoReflect.Descriptor instead.
+func (*FitResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FitResponse) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *FitResponse) GetRSquared() float64 {
+	if x != nil {
+		return x.RSquared
+	}
+	return 0
+}
+
+func (x *FitResponse) GetResiduals() []float64 {
+	if x != nil {
+		return x.Residuals
+	}
+	return nil
+}
+
+func (x *FitResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// LinearConstraint is coefficients . x relation rhs.
+type LinearConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coefficients of the variables, one for each.
+	Coefficients []float64           `protobuf:"fixed64,1,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	Relation     CONSTRAINT_RELATION `protobuf:"varint,2,opt,name=relation,proto3,enum=calculatorpb.CONSTRAINT_RELATION" json:"relation,omitempty"`
+	Rhs          float64             `protobuf:"fixed64,3,opt,name=rhs,proto3" json:"rhs,omitempty"`
+}
+
+func (x *LinearConstraint) Reset() {
+	*x = LinearConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearConstraint) ProtoMessage() {}
+
+func (x *LinearConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearConstraint.ProtoReflect.Descriptor instead.
+func (*LinearConstraint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *LinearConstraint) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *LinearConstraint) GetRelation() CONSTRAINT_RELATION {
+	if x != nil {
+		return x.Relation
+	}
+	return CONSTRAINT_RELATION_DEFAULT_CONSTRAINT_RELATION
+}
+
+func (x *LinearConstraint) GetRhs() float64 {
+	if x != nil {
+		return x.Rhs
+	}
+	return 0
+}
+
+// VariableBounds are the lower and upper bounds of a variable, -Infinity and
+// Infinity for none.
+type VariableBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+}
+
+func (x *VariableBounds) Reset() {
+	*x = VariableBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariableBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableBounds) ProtoMessage() {}
+
+func (x *VariableBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableBounds.ProtoReflect.Descriptor instead.
+func (*VariableBounds) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VariableBounds) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *VariableBounds) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+// LinearProgramRequest optimizes a linear objective of variables subject to
+// linear constraints.
+type LinearProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sense LP_SENSE `protobuf:"varint,1,opt,name=sense,proto3,enum=calculatorpb.LP_SENSE" json:"sense,omitempty"`
+	// objective coefficients, one for each variable.
+	Objective   []float64           `protobuf:"fixed64,2,rep,packed,name=objective,proto3" json:"objective,omitempty"`
+	Constraints []*LinearConstraint `protobuf:"bytes,3,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	// bounds of the variables, one for each, default all in [0, Infinity).
+	Bounds []*VariableBounds `protobuf:"bytes,4,rep,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (x *LinearProgramRequest) Reset() {
+	*x = LinearProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearProgramRequest) ProtoMessage() {}
+
+func (x *LinearProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearProgramRequest.ProtoReflect.Descriptor instead.
+func (*LinearProgramRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LinearProgramRequest) GetSense() LP_SENSE {
+	if x != nil {
+		return x.Sense
+	}
+	return LP_SENSE_DEFAULT_LP_SENSE
+}
+
+func (x *LinearProgramRequest) GetObjective() []float64 {
+	if x != nil {
+		return x.Objective
+	}
+	return nil
+}
+
+func (x *LinearProgramRequest) GetConstraints() []*LinearConstraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *LinearProgramRequest) GetBounds() []*VariableBounds {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+type LinearProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LP_STATUS `protobuf:"varint,1,opt,name=status,proto3,enum=calculatorpb.LP_STATUS" json:"status,omitempty"`
+	// solution and objective of an optimal program.
+	Solution  []float64 `protobuf:"fixed64,2,rep,packed,name=solution,proto3" json:"solution,omitempty"`
+	Objective float64   `protobuf:"fixed64,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	// duals are the shadow prices of the constraints, the change of the
+	// objective by a unit increase of rhs.
+	Duals []float64 `protobuf:"fixed64,4,rep,packed,name=duals,proto3" json:"duals,omitempty"`
+	// slacks are how far the constraints are from binding, 0 when they bind.
+	Slacks []float64 `protobuf:"fixed64,5,rep,packed,name=slacks,proto3" json:"slacks,omitempty"`
+	// pivots of the simplex method.
+	Pivots uint32 `protobuf:"varint,6,opt,name=pivots,proto3" json:"pivots,omitempty"`
+}
+
+func (x *LinearProgramResponse) Reset() {
+	*x = LinearProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinearProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearProgramResponse) ProtoMessage() {}
+
+func (x *LinearProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinearProgramResponse.ProtoReflect.Descriptor instead.
+func (*LinearProgramResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LinearProgramResponse) GetStatus() LP_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return LP_STATUS_DEFAULT_LP_STATUS
+}
+
+func (x *LinearProgramResponse) GetSolution() []float64 {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+func (x *LinearProgramResponse) GetObjective() float64 {
+	if x != nil {
+		return x.Objective
+	}
+	return 0
+}
+
+func (x *LinearProgramResponse) GetDuals() []float64 {
+	if x != nil {
+		return x.Duals
+	}
+	return nil
+}
+
+func (x *LinearProgramResponse) GetSlacks() []float64 {
+	if x != nil {
+		return x.Slacks
+	}
+	return nil
+}
+
+func (x *LinearProgramResponse) GetPivots() uint32 {
+	if x != nil {
+		return x.Pivots
+	}
+	return 0
+}
+
+// FourierTransformRequest is the discrete Fourier transform of samples of any
+// length, X[k] = sum x[j] exp(-2 pi i j k / n).
+type FourierTransformRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// samples are real, or with complex the real and imaginary parts of the
+	// samples in pairs, at most 1048576 samples.
+	Samples []float64 `protobuf:"fixed64,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	Complex bool      `protobuf:"varint,2,opt,name=complex,proto3" json:"complex,omitempty"`
+	// inverse transforms a spectrum back to samples, scaled by 1/n.
+	Inverse bool `protobuf:"varint,3,opt,name=inverse,proto3" json:"inverse,omitempty"`
+	// window of the samples of a forward transform.
+	Window WINDOW `protobuf:"varint,4,opt,name=window,proto3,enum=calculatorpb.WINDOW" json:"window,omitempty"`
+}
+
+func (x *FourierTransformRequest) Reset() {
+	*x = FourierTransformRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FourierTransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FourierTransformRequest) ProtoMessage() {}
+
+func (x *FourierTransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FourierTransformRequest.ProtoReflect.Descriptor instead.
+func (*FourierTransformRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FourierTransformRequest) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *FourierTransformRequest) GetComplex() bool {
+	if x != nil {
+		return x.Complex
+	}
+	return false
+}
+
+func (x *FourierTransformRequest) GetInverse() bool {
+	if x != nil {
+		return x.Inverse
+	}
+	return false
+}
+
+func (x *FourierTransformRequest) GetWindow() WINDOW {
+	if x != nil {
+		return x.Window
+	}
+	return WINDOW_WINDOW_RECTANGULAR
+}
+
+type FourierTransformResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// values are the real and imaginary parts of the transform in pairs.
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *FourierTransformResponse) Reset() {
+	*x = FourierTransformResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FourierTransformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FourierTransformResponse) ProtoMessage() {}
+
+func (x *FourierTransformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FourierTransformResponse.ProtoReflect.Descriptor instead.
+func (*FourierTransformResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FourierTransformResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// PowerSpectrumRequest is the one-sided power spectral density of real
+// samples by the periodogram.
+type PowerSpectrumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []float64 `protobuf:"fixed64,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	// sample_rate of the samples, default 1.
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Window     WINDOW  `protobuf:"varint,3,opt,name=window,proto3,enum=calculatorpb.WINDOW" json:"window,omitempty"`
+}
+
+func (x *PowerSpectrumRequest) Reset() {
+	*x = PowerSpectrumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerSpectrumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerSpectrumRequest) ProtoMessage() {}
+
+func (x *PowerSpectrumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerSpectrumRequest.ProtoReflect.Descriptor instead.
+func (*PowerSpectrumRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PowerSpectrumRequest) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *PowerSpectrumRequest) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *PowerSpectrumRequest) GetWindow() WINDOW {
+	if x != nil {
+		return x.Window
+	}
+	return WINDOW_WINDOW_RECTANGULAR
+}
+
+type PowerSpectrumResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// frequencies from 0 to half the sample rate in steps of the sample rate
+	// over the samples.
+	Frequencies []float64 `protobuf:"fixed64,1,rep,packed,name=frequencies,proto3" json:"frequencies,omitempty"`
+	// power at the frequencies per unit of frequency, whose sum times the
+	// step is the mean square of the samples with the rectangular window.
+	Power []float64 `protobuf:"fixed64,2,rep,packed,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *PowerSpectrumResponse) Reset() {
+	*x = PowerSpectrumResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerSpectrumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerSpectrumResponse) ProtoMessage() {}
+
+func (x *PowerSpectrumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerSpectrumResponse.ProtoReflect.Descriptor instead.
+func (*PowerSpectrumResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PowerSpectrumResponse) GetFrequencies() []float64 {
+	if x != nil {
+		return x.Frequencies
+	}
+	return nil
+}
+
+func (x *PowerSpectrumResponse) GetPower() []float64 {
+	if x != nil {
+		return x.Power
+	}
+	return nil
+}
+
+// WindowRequest multiplies samples by a window of their length.
+type WindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window  WINDOW    `protobuf:"varint,1,opt,name=window,proto3,enum=calculatorpb.WINDOW" json:"window,omitempty"`
+	Samples []float64 `protobuf:"fixed64,2,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *WindowRequest) Reset() {
+	*x = WindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowRequest) ProtoMessage() {}
+
+func (x *WindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowRequest.ProtoReflect.Descriptor instead.
+func (*WindowRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *WindowRequest) GetWindow() WINDOW {
+	if x != nil {
+		return x.Window
+	}
+	return WINDOW_WINDOW_RECTANGULAR
+}
+
+func (x *WindowRequest) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type WindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []float64 `protobuf:"fixed64,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *WindowResponse) Reset() {
+	*x = WindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowResponse) ProtoMessage() {}
+
+func (x *WindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowResponse.ProtoReflect.Descriptor instead.
+func (*WindowResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *WindowResponse) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// FIRFilterRequest filters samples with a finite impulse response filter,
+// either of given taps or designed by the window method.
+type FIRFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []float64  `protobuf:"fixed64,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	Filter  FIR_FILTER `protobuf:"varint,2,opt,name=filter,proto3,enum=calculatorpb.FIR_FILTER" json:"filter,omitempty"`
+	// taps of FIR_FILTER_TAPS, the impulse response of the filter.
+	Taps []float64 `protobuf:"fixed64,3,rep,packed,name=taps,proto3" json:"taps,omitempty"`
+	// sample_rate of the samples, default 1, and the cutoff frequencies of the
+	// designed filters, between 0 and half the sample rate.
+	SampleRate float64 `protobuf:"fixed64,4,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Cutoff     float64 `protobuf:"fixed64,5,opt,name=cutoff,proto3" json:"cutoff,omitempty"`
+	CutoffHigh float64 `protobuf:"fixed64,6,opt,name=cutoff_high,json=cutoffHigh,proto3" json:"cutoff_high,omitempty"`
+	// length of a designed filter, odd, default 101 and at most 10001.
+	Length uint32 `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`
+	// window of a designed filter, the rectangular window truncates the ideal
+	// response.
+	Window WINDOW `protobuf:"varint,8,opt,name=window,proto3,enum=calculatorpb.WINDOW" json:"window,omitempty"`
+}
+
+func (x *FIRFilterRequest) Reset() {
+	*x = FIRFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FIRFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIRFilterRequest) ProtoMessage() {}
+
+func (x *FIRFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIRFilterRequest.ProtoReflect.Descriptor instead.
+func (*FIRFilterRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *FIRFilterRequest) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *FIRFilterRequest) GetFilter() FIR_FILTER {
+	if x != nil {
+		return x.Filter
+	}
+	return FIR_FILTER_FIR_FILTER_TAPS
+}
+
+func (x *FIRFilterRequest) GetTaps() []float64 {
+	if x != nil {
+		return x.Taps
+	}
+	return nil
+}
+
+func (x *FIRFilterRequest) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *FIRFilterRequest) GetCutoff() float64 {
+	if x != nil {
+		return x.Cutoff
+	}
+	return 0
+}
+
+func (x *FIRFilterRequest) GetCutoffHigh() float64 {
+	if x != nil {
+		return x.CutoffHigh
+	}
+	return 0
+}
+
+func (x *FIRFilterRequest) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *FIRFilterRequest) GetWindow() WINDOW {
+	if x != nil {
+		return x.Window
+	}
+	return WINDOW_WINDOW_RECTANGULAR
+}
+
+type FIRFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// samples filtered, y[n] = sum taps[k] x[n-k] with x 0 before the first
+	// sample, as many as the samples.
+	Samples []float64 `protobuf:"fixed64,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	// taps of the filter, a designed one has unit gain in its pass band.
+	Taps []float64 `protobuf:"fixed64,2,rep,packed,name=taps,proto3" json:"taps,omitempty"`
+}
+
+func (x *FIRFilterResponse) Reset() {
+	*x = FIRFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FIRFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIRFilterResponse) ProtoMessage() {}
+
+func (x *FIRFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIRFilterResponse.ProtoReflect.Descriptor instead.
+func (*FIRFilterResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FIRFilterResponse) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *FIRFilterResponse) GetTaps() []float64 {
+	if x != nil {
+		return x.Taps
+	}
+	return nil
+}
+
+// Point is a point in the plane, or in space with z.
+type Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float64 `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Point) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Point) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Point) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// Polygon is a polygon in the plane of its vertices in order, either
+// clockwise or counterclockwise, without repeating the first.
+type Polygon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertices []*Point `protobuf:"bytes,1,rep,name=vertices,proto3" json:"vertices,omitempty"`
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Polygon) GetVertices() []*Point {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+// GeoPoint is a point on the Earth in degrees.
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Triangle has sides a, b and c opposite the angles alpha, beta and gamma
+// in degrees, 0 for those to solve for.
+type Triangle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A     float64 `protobuf:"fixed64,1,opt,name=a,proto3" json:"a,omitempty"`
+	B     float64 `protobuf:"fixed64,2,opt,name=b,proto3" json:"b,omitempty"`
+	C     float64 `protobuf:"fixed64,3,opt,name=c,proto3" json:"c,omitempty"`
+	Alpha float64 `protobuf:"fixed64,4,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Beta  float64 `protobuf:"fixed64,5,opt,name=beta,proto3" json:"beta,omitempty"`
+	Gamma float64 `protobuf:"fixed64,6,opt,name=gamma,proto3" json:"gamma,omitempty"`
+}
+
+func (x *Triangle) Reset() {
+	*x = Triangle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Triangle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Triangle) ProtoMessage() {}
+
+func (x *Triangle) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Triangle.ProtoReflect.Descriptor instead.
+func (*Triangle) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Triangle) GetA() float64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+func (x *Triangle) GetB() float64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Triangle) GetC() float64 {
+	if x != nil {
+		return x.C
+	}
+	return 0
+}
+
+func (x *Triangle) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *Triangle) GetBeta() float64 {
+	if x != nil {
+		return x.Beta
+	}
+	return 0
+}
+
+func (x *Triangle) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+type GeometryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function GEOMETRY `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.GEOMETRY" json:"function,omitempty"`
+	Shape    SHAPE    `protobuf:"varint,2,opt,name=shape,proto3,enum=calculatorpb.SHAPE" json:"shape,omitempty"`
+	// dimensions of the shape.
+	Dimensions []float64 `protobuf:"fixed64,3,rep,packed,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Triangle   *Triangle `protobuf:"bytes,4,opt,name=triangle,proto3" json:"triangle,omitempty"`
+	// from and to are the points of a distance.
+	From    *Point   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To      *Point   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Polygon *Polygon `protobuf:"bytes,7,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	// origin and destination of a geodesic.
+	Origin      *GeoPoint `protobuf:"bytes,8,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination *GeoPoint `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
+	// radius of the sphere of haversine, default the mean radius of the Earth
+	// 6371008.8 meters.
+	Radius float64 `protobuf:"fixed64,10,opt,name=radius,proto3" json:"radius,omitempty"`
+}
+
+func (x *GeometryRequest) Reset() {
+	*x = GeometryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeometryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeometryRequest) ProtoMessage() {}
+
+func (x *GeometryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeometryRequest.ProtoReflect.Descriptor instead.
+func (*GeometryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GeometryRequest) GetFunction() GEOMETRY {
+	if x != nil {
+		return x.Function
+	}
+	return GEOMETRY_DEFAULT_GEOMETRY
+}
+
+func (x *GeometryRequest) GetShape() SHAPE {
+	if x != nil {
+		return x.Shape
+	}
+	return SHAPE_DEFAULT_SHAPE
+}
+
+func (x *GeometryRequest) GetDimensions() []float64 {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetTriangle() *Triangle {
+	if x != nil {
+		return x.Triangle
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetFrom() *Point {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetTo() *Point {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetPolygon() *Polygon {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetOrigin() *GeoPoint {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetDestination() *GeoPoint {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *GeometryRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type GeometryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// area and perimeter of a shape, a triangle or a polygon.
+	Area      float64 `protobuf:"fixed64,1,opt,name=area,proto3" json:"area,omitempty"`
+	Perimeter float64 `protobuf:"fixed64,2,opt,name=perimeter,proto3" json:"perimeter,omitempty"`
+	// triangle solved, all its sides and angles.
+	Triangle *Triangle `protobuf:"bytes,3,opt,name=triangle,proto3" json:"triangle,omitempty"`
+	// distance between points, in the unit of radius for haversine and in
+	// meters for Vincenty.
+	Distance float64 `protobuf:"fixed64,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	// initial_bearing and final_bearing of a geodesic in degrees clockwise
+	// from north, in [0, 360).
+	InitialBearing float64 `protobuf:"fixed64,5,opt,name=initial_bearing,json=initialBearing,proto3" json:"initial_bearing,omitempty"`
+	FinalBearing   float64 `protobuf:"fixed64,6,opt,name=final_bearing,json=finalBearing,proto3" json:"final_bearing,omitempty"`
+	// centroid of a polygon.
+	Centroid *Point `protobuf:"bytes,7,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	// iterations of Vincenty's formula.
+	Iterations uint32 `protobuf:"varint,8,opt,name=iterations,proto3" json:"iterations,omitempty"`
+}
+
+func (x *GeometryResponse) Reset() {
+	*x = GeometryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeometryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeometryResponse) ProtoMessage() {}
+
+func (x *GeometryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeometryResponse.ProtoReflect.Descriptor instead.
+func (*GeometryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GeometryResponse) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *GeometryResponse) GetPerimeter() float64 {
+	if x != nil {
+		return x.Perimeter
+	}
+	return 0
+}
+
+func (x *GeometryResponse) GetTriangle() *Triangle {
+	if x != nil {
+		return x.Triangle
+	}
+	return nil
+}
+
+func (x *GeometryResponse) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *GeometryResponse) GetInitialBearing() float64 {
+	if x != nil {
+		return x.InitialBearing
+	}
+	return 0
+}
+
+func (x *GeometryResponse) GetFinalBearing() float64 {
+	if x != nil {
+		return x.FinalBearing
+	}
+	return 0
+}
+
+func (x *GeometryResponse) GetCentroid() *Point {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *GeometryResponse) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+// LogicRequest is about a boolean expression of variables, the constants 0,
+// 1, true and false, and the operators from the tightest binding
+//
+//	not, ! or ~
+//	and, & or &&, and nand
+//	xor or ^
+//	or, | or ||, and nor
+//	implies, -> or =>, which is right associative
+//	iff, <-> or <=>, and xnor
+//
+// with parentheses. The words of the operators are case insensitive.
+type LogicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function   LOGIC  `protobuf:"varint,1,opt,name=function,proto3,enum=calculatorpb.LOGIC" json:"function,omitempty"`
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// values of the variables of evaluate.
+	Values map[string]bool `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// variables in the order of the truth table and of the minterms, the
+	// first the most significant, default the variables of the expression in
+	// alphabetical order.
+	Variables []string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	// minterms and dont_cares are the rows, numbered from 0, where a
+	// minimized function without an expression is true and where it may be
+	// either.
+	Minterms  []uint32 `protobuf:"varint,5,rep,packed,name=minterms,proto3" json:"minterms,omitempty"`
+	DontCares []uint32 `protobuf:"varint,6,rep,packed,name=dont_cares,json=dontCares,proto3" json:"dont_cares,omitempty"`
+	// max_evaluations of the implicants of minimize, default and at most the
+	// limit of the service.
+	MaxEvaluations uint64 `protobuf:"varint,7,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+}
+
+func (x *LogicRequest) Reset() {
+	*x = LogicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogicRequest) ProtoMessage() {}
+
+func (x *LogicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogicRequest.ProtoReflect.Descriptor instead.
+func (*LogicRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *LogicRequest) GetFunction() LOGIC {
+	if x != nil {
+		return x.Function
+	}
+	return LOGIC_DEFAULT_LOGIC
+}
+
+func (x *LogicRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *LogicRequest) GetValues() map[string]bool {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *LogicRequest) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *LogicRequest) GetMinterms() []uint32 {
+	if x != nil {
+		return x.Minterms
+	}
+	return nil
+}
+
+func (x *LogicRequest) GetDontCares() []uint32 {
+	if x != nil {
+		return x.DontCares
+	}
+	return nil
+}
+
+func (x *LogicRequest) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+type TruthTableRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inputs are the values of the variables.
+	Inputs []bool `protobuf:"varint,1,rep,packed,name=inputs,proto3" json:"inputs,omitempty"`
+	Output bool   `protobuf:"varint,2,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *TruthTableRow) Reset() {
+	*x = TruthTableRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TruthTableRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TruthTableRow) ProtoMessage() {}
+
+func (x *TruthTableRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TruthTableRow.ProtoReflect.Descriptor instead.
+func (*TruthTableRow) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TruthTableRow) GetInputs() []bool {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *TruthTableRow) GetOutput() bool {
+	if x != nil {
+		return x.Output
+	}
+	return false
+}
+
+type LogicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value of evaluate.
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// variables of the truth table and the minterms.
+	Variables []string         `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Rows      []*TruthTableRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	// minterms are the rows where the expression is true.
+	Minterms []uint32 `protobuf:"varint,4,rep,packed,name=minterms,proto3" json:"minterms,omitempty"`
+	// expression minimized as a sum of products in the syntax of the request,
+	// "0" and "1" for constants.
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// prime_implicants of the function as patterns of 1, 0 and - for the
+	// variables that are true, false and absent.
+	PrimeImplicants []string `protobuf:"bytes,6,rep,name=prime_implicants,json=primeImplicants,proto3" json:"prime_implicants,omitempty"`
+	// implicants of the minimized expression, as patterns.
+	Implicants []string `protobuf:"bytes,7,rep,name=implicants,proto3" json:"implicants,omitempty"`
+}
+
+func (x *LogicResponse) Reset() {
+	*x = LogicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogicResponse) ProtoMessage() {}
+
+func (x *LogicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogicResponse.ProtoReflect.Descriptor instead.
+func (*LogicResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *LogicResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *LogicResponse) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *LogicResponse) GetRows() []*TruthTableRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *LogicResponse) GetMinterms() []uint32 {
+	if x != nil {
+		return x.Minterms
+	}
+	return nil
+}
+
+func (x *LogicResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *LogicResponse) GetPrimeImplicants() []string {
+	if x != nil {
+		return x.PrimeImplicants
+	}
+	return nil
+}
+
+func (x *LogicResponse) GetImplicants() []string {
+	if x != nil {
+		return x.Implicants
+	}
+	return nil
+}
+
+// Interval is the closed interval [lo, hi] of the reals, whose bounds may be
+// infinite.
+type Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lo float64 `protobuf:"fixed64,1,opt,name=lo,proto3" json:"lo,omitempty"`
+	Hi float64 `protobuf:"fixed64,2,opt,name=hi,proto3" json:"hi,omitempty"`
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Interval) GetLo() float64 {
+	if x != nil {
+		return x.Lo
+	}
+	return 0
+}
+
+func (x *Interval) GetHi() float64 {
+	if x != nil {
+		return x.Hi
+	}
+	return 0
+}
+
+// IntervalCalculateRequest computes an interval holding every value of
+// operand_1 operator operand_2, or of expression, for the values of the
+// operands within their intervals. Every operation rounds its bounds
+// outwards, so that the result encloses the exact value whatever the
+// rounding errors.
+type IntervalCalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator of the operands, unless expression is given.
+	Operator  OPERATOR  `protobuf:"varint,1,opt,name=operator,proto3,enum=calculatorpb.OPERATOR" json:"operator,omitempty"`
+	Operand_1 *Interval `protobuf:"bytes,2,opt,name=operand_1,json=operand1,proto3" json:"operand_1,omitempty"`
+	Operand_2 *Interval `protobuf:"bytes,3,opt,name=operand_2,json=operand2,proto3" json:"operand_2,omitempty"`
+	// expression in the syntax of SymbolicRequest, its decimal numbers that
+	// are not exact doubles, and the constants pi and e, are enclosed by the
+	// doubles around them. Powers of intervals by a non-integer exponent, and
+	// the functions, are of the part of their argument within their domain.
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// values of the variables of the expression.
+	Values map[string]*Interval `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *IntervalCalculateRequest) Reset() {
+	*x = IntervalCalculateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntervalCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalCalculateRequest) ProtoMessage() {}
+
+func (x *IntervalCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalCalculateRequest.ProtoReflect.Descriptor instead.
+func (*IntervalCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *IntervalCalculateRequest) GetOperator() OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return OPERATOR_DEFAULT_OPERATOR
+}
+
+func (x *IntervalCalculateRequest) GetOperand_1() *Interval {
+	if x != nil {
+		return x.Operand_1
+	}
+	return nil
+}
+
+func (x *IntervalCalculateRequest) GetOperand_2() *Interval {
+	if x != nil {
+		return x.Operand_2
+	}
+	return nil
+}
+
+func (x *IntervalCalculateRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *IntervalCalculateRequest) GetValues() map[string]*Interval {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IntervalCalculateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// intervals are the disjoint parts of the result in increasing order.
+	// There are several after a division by an interval holding zero, e.g.
+	// 1 / [-1, 2] is [-inf, -1] and [0.5, inf].
+	Intervals []*Interval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	// hull is the smallest interval holding intervals.
+	Hull *Interval `protobuf:"bytes,2,opt,name=hull,proto3" json:"hull,omitempty"`
+}
+
+func (x *IntervalCalculateResponse) Reset() {
+	*x = IntervalCalculateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntervalCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalCalculateResponse) ProtoMessage() {}
+
+func (x *IntervalCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_calculatorpb_calculator_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalCalculateResponse.ProtoReflect.Descriptor instead.
+func (*IntervalCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *IntervalCalculateResponse) GetIntervals() []*Interval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *IntervalCalculateResponse) GetHull() *Interval {
+	if x != nil {
+		return x.Hull
+	}
+	return nil
+}
+
+var File_rpc_proto_calculatorpb_calculator_proto protoreflect.FileDescriptor
+
+var file_rpc_proto_calculatorpb_calculator_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x32, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x4e, 0x44, 0x53, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x4e, 0x44, 0x53,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x81,
+	0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x6d, 0x61, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x6d, 0x61, 0x12,
+	0x39, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0d, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xda, 0x01,
+	0x0a, 0x0c, 0x54, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x54, 0x45, 0x53,
+	0x54, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x31, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x32, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x32, 0x12, 0x0e, 0x0a,
+	0x02, 0x6d, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6d, 0x75, 0x12, 0x3b, 0x0a,
+	0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x52, 0x0b, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x14, 0x43,
+	0x68, 0x69, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x43, 0x48, 0x49, 0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54,
+	0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x23, 0x0a,
+	0x09, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x4c, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x52, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x48, 0x79, 0x70, 0x6f, 0x74, 0x68,
+	0x65, 0x73, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2c,
+	0x0a, 0x12, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x72, 0x65,
+	0x65, 0x64, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x65, 0x67, 0x72,
+	0x65, 0x65, 0x73, 0x4f, 0x66, 0x46, 0x72, 0x65, 0x65, 0x64, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x51, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0d, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12,
+	0x3e, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4b, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x3c, 0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x41, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x44, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x22, 0x6a, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x44, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2c, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x22, 0x66,
+	0x0a, 0x08, 0x44, 0x69, 0x63, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x65, 0x70, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x53, 0x52, 0x08,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x01, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x47,
+	0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x52, 0x08,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x75, 0x73, 0x22, 0xd2,
+	0x01, 0x0a, 0x14, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x75, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x65, 0x7a, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x7a,
+	0x6f, 0x75, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x56,
+	0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x31, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x31, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x32, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x61, 0x64, 0x69, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x61, 0x64, 0x69, 0x78, 0x22, 0xbc,
+	0x01, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x63, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x63, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x68,
+	0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x28, 0x0a,
+	0x10, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x11, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x69, 0x61, 0x73,
+	0x65, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73,
+	0x73, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73,
+	0x73, 0x61, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x52, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x68,
+	0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0x34, 0x0a,
+	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64,
+	0x5f, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x31, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x32, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x22, 0x91, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x78, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x30, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f,
+	0x66, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x4f, 0x4e,
+	0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12,
+	0x32, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x03, 0x74, 0x61, 0x78, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x54, 0x69, 0x6d, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x70, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x70, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6d,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x6d, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x70, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x70, 0x76, 0x12, 0x0e, 0x0a, 0x02,
+	0x66, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x66, 0x76, 0x12, 0x34, 0x0a, 0x06,
+	0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x49, 0x4e, 0x47, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x22, 0x68, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x43,
+	0x61, 0x73, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x22, 0x67, 0x0a, 0x10, 0x43,
+	0x61, 0x73, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x6e, 0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x70, 0x76, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x66, 0x76, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x49,
+	0x4e, 0x47, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0x97, 0x01, 0x0a, 0x0f, 0x41,
+	0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x45, 0x50, 0x52,
+	0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x66, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69,
+	0x66, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x49, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0f,
+	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8c, 0x02, 0x0a,
+	0x14, 0x44, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x15,
+	0x44, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x44, 0x61, 0x79, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xbf, 0x01, 0x0a,
+	0x11, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x08, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x92,
+	0x01, 0x0a, 0x10, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
+	0x49, 0x43, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x41, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x01,
+	0x0a, 0x10, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x19, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x1a,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x4f, 0x4c, 0x56, 0x45,
+	0x52, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x71, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x71, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x65, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x69, 0x6d, 0x61, 0x67, 0x22, 0x76, 0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f,
+	0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0xf2, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x51, 0x55, 0x41, 0x44, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x10, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x6d, 0x0a, 0x11, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22,
+	0x63, 0x0a, 0x0b, 0x4f, 0x44, 0x45, 0x45, 0x71, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x22, 0xa2, 0x03, 0x0a, 0x0f, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x44,
+	0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x52, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x71,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x44, 0x45,
+	0x45, 0x71, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x71, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x08, 0x4f, 0x44, 0x45,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x0a, 0x50,
+	0x6c, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+	0xcc, 0x03, 0x0a, 0x0b, 0x50, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x76, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x73, 0x76, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x79, 0x5f, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x79, 0x4c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x79, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x79, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27,
+	0x0a, 0x09, 0x50, 0x6c, 0x6f, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x3e, 0x0a, 0x0b, 0x50, 0x6c, 0x6f, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x08, 0x50, 0x6c, 0x6f, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x79, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x79, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x79, 0x5f,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x79, 0x55, 0x70,
+	0x70, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x76, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x76, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x61, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74,
+	0x65, 0x22, 0x2d, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x49,
+	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x65, 0x67, 0x72,
+	0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x8c,
+	0x01, 0x0a, 0x0b, 0x46, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x52, 0x08, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x72, 0x68, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0xda, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x50, 0x5f,
+	0x53, 0x45, 0x4e, 0x53, 0x45, 0x52, 0x05, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x06,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x75, 0x61, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x64, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73,
+	0x6c, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x22, 0x95, 0x01,
+	0x0a, 0x17, 0x46, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x32, 0x0a, 0x18, 0x46, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x14, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x4f, 0x0a, 0x15, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0d, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x22, 0x92, 0x02, 0x0a, 0x10, 0x46, 0x49, 0x52, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46,
+	0x49, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x04, 0x74, 0x61, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x48, 0x69, 0x67, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x41, 0x0a, 0x11, 0x46, 0x49, 0x52, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x04, 0x74, 0x61, 0x70, 0x73, 0x22, 0x31, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
+	0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x3a, 0x0a, 0x07, 0x50,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x74, 0x0a,
+	0x08, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x62, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x62, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x6d, 0x61, 0x22, 0xc5, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52,
+	0x59, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x48, 0x41, 0x50, 0x45, 0x52,
+	0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65,
+	0x52, 0x08, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x79,
+	0x67, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e,
+	0x52, 0x07, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x10,
+	0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x08, 0x74, 0x72,
+	0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x65,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x2f, 0x0a, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xdc, 0x02, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x6f, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x09, 0x64, 0x6f, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x3f, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x74, 0x68, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f,
+	0x77, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x74, 0x68, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x72, 0x69, 0x6d, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x22,
+	0x2a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x6c,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6c, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x68,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x68, 0x69, 0x22, 0xf7, 0x02, 0x0a, 0x18,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64,
+	0x31, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x32, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x51, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x19, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x68, 0x75, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x04,
+	0x68, 0x75, 0x6c, 0x6c, 0x2a, 0x75, 0x0a, 0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x59, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x49, 0x56, 0x49,
+	0x44, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x04, 0x2a, 0x57, 0x0a, 0x05, 0x54,
+	0x54, 0x45, 0x53, 0x54, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
+	0x54, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c,
+	0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x4c,
+	0x43, 0x48, 0x10, 0x03, 0x2a, 0x75, 0x0a, 0x0f, 0x43, 0x48, 0x49, 0x5f, 0x53, 0x51, 0x55, 0x41,
+	0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x43, 0x48, 0x49, 0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x45,
+	0x53, 0x54, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x48, 0x49, 0x5f, 0x53, 0x51, 0x55, 0x41,
+	0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x4e, 0x45, 0x53, 0x53,
+	0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x49, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x48, 0x49,
+	0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x0b, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x41, 0x52, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x45, 0x41,
+	0x52, 0x4d, 0x41, 0x4e, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x54, 0x49, 0x56, 0x45, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f,
+	0x4c, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a,
+	0x96, 0x02, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x54,
+	0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49,
+	0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x48, 0x49, 0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x49, 0x4e,
+	0x4f, 0x4d, 0x49, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x49, 0x53, 0x53, 0x4f, 0x4e, 0x10,
+	0x05, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x06, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x49, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x53,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x4d, 0x41, 0x10,
+	0x08, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x42, 0x45, 0x54, 0x41, 0x10, 0x09, 0x2a, 0x9c, 0x01, 0x0a, 0x15, 0x44, 0x49, 0x53,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x44, 0x46, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x44,
+	0x46, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x41,
+	0x4e, 0x54, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x86, 0x02, 0x0a, 0x0d, 0x43, 0x4f, 0x4d, 0x42,
+	0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x53, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49,
+	0x43, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54,
+	0x4f, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49,
+	0x43, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49,
+	0x43, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49,
+	0x43, 0x53, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x4e, 0x4f, 0x4d, 0x49, 0x41, 0x4c, 0x10, 0x04,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49, 0x43,
+	0x53, 0x5f, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43,
+	0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49, 0x42,
+	0x4f, 0x4e, 0x41, 0x43, 0x43, 0x49, 0x10, 0x06, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4f, 0x4d, 0x42,
+	0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x42, 0x49, 0x4e, 0x4f, 0x4d, 0x49,
+	0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x07,
+	0x2a, 0x86, 0x02, 0x0a, 0x0d, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f,
+	0x52, 0x59, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x49,
+	0x53, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x55, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f,
+	0x52, 0x49, 0x5a, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52,
+	0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x47, 0x43, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a,
+	0x11, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x4c,
+	0x43, 0x4d, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54,
+	0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x47,
+	0x43, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54,
+	0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x45, 0x52, 0x53,
+	0x45, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48,
+	0x45, 0x4f, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x5f, 0x50, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x19,
+	0x0a, 0x15, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4f, 0x52, 0x59, 0x5f,
+	0x54, 0x4f, 0x54, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x2a, 0xea, 0x01, 0x0a, 0x0c, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x31,
+	0x36, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x36,
+	0x34, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54,
+	0x31, 0x36, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x07, 0x12, 0x17, 0x0a,
+	0x13, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49,
+	0x4e, 0x54, 0x36, 0x34, 0x10, 0x08, 0x2a, 0x4a, 0x0a, 0x08, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c,
+	0x4f, 0x57, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x57,
+	0x52, 0x41, 0x50, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f,
+	0x57, 0x5f, 0x53, 0x41, 0x54, 0x55, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0xd1, 0x03, 0x0a, 0x10, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e,
+	0x45, 0x47, 0x41, 0x54, 0x45, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x47,
+	0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4e, 0x44, 0x10,
+	0x07, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x58,
+	0x4f, 0x52, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x0a, 0x12, 0x1f,
+	0x0a, 0x1b, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x0b, 0x12,
+	0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10,
+	0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x45, 0x46,
+	0x54, 0x10, 0x0d, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x49, 0x47, 0x48, 0x54, 0x10, 0x0e, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x46, 0x4c, 0x4f, 0x41, 0x54,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x46, 0x4c, 0x4f, 0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x4e,
+	0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4c, 0x4f, 0x41, 0x54,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49,
+	0x4e, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4e, 0x41, 0x4e, 0x10, 0x04, 0x2a, 0xae,
+	0x01, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x55,
+	0x4e, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x49, 0x56, 0x49,
+	0x44, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x10, 0x05, 0x2a,
+	0x9e, 0x01, 0x0a, 0x08, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x44, 0x4f, 0x57, 0x4e,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x44,
+	0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x55, 0x50, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x45, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4c, 0x4f, 0x4f, 0x52, 0x10, 0x06,
+	0x2a, 0xfc, 0x01, 0x0a, 0x0e, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4d,
+	0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x46, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4d,
+	0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x45,
+	0x52, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x55, 0x50, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x4f, 0x4e,
+	0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x41, 0x52, 0x47,
+	0x49, 0x4e, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x54, 0x41, 0x58, 0x10, 0x06,
+	0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x58, 0x10, 0x07, 0x2a,
+	0x88, 0x01, 0x0a, 0x0a, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x12, 0x16,
+	0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x50, 0x4d, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x50, 0x56, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x46, 0x56, 0x10, 0x03,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x4e,
+	0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x56, 0x41,
+	0x4c, 0x55, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x05, 0x2a, 0x42, 0x0a, 0x0e, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x49, 0x4e, 0x47, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x45,
+	0x4e, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x49, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x70,
+	0x0a, 0x09, 0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x12, 0x15, 0x0a, 0x11, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x4f, 0x57,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x4e, 0x50, 0x56, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c,
+	0x4f, 0x57, 0x5f, 0x49, 0x52, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x41, 0x53, 0x48,
+	0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x58, 0x4e, 0x50, 0x56, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
+	0x43, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x58, 0x49, 0x52, 0x52, 0x10, 0x04,
+	0x2a, 0x97, 0x01, 0x0a, 0x0c, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x45, 0x50,
+	0x52, 0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
+	0x45, 0x50, 0x52, 0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41,
+	0x49, 0x47, 0x48, 0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x44,
+	0x45, 0x50, 0x52, 0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12,
+	0x29, 0x0a, 0x25, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x2a, 0x89, 0x02, 0x0a, 0x0d, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x49, 0x46, 0x46,
+	0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x42, 0x55,
+	0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x04, 0x12, 0x1f, 0x0a,
+	0x1b, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x42,
+	0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x05, 0x12, 0x21,
+	0x0a, 0x1d, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x49, 0x53, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x06, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x5a, 0x4f, 0x4e, 0x45, 0x10, 0x07, 0x2a, 0x6c, 0x0a, 0x08, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
+	0x49, 0x43, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x59,
+	0x4d, 0x42, 0x4f, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x59, 0x4d, 0x42,
+	0x4f, 0x4c, 0x49, 0x43, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x54, 0x49, 0x41,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x49, 0x43,
+	0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x49, 0x46, 0x59, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x49, 0x43, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55,
+	0x54, 0x45, 0x10, 0x03, 0x2a, 0x6e, 0x0a, 0x06, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x12, 0x12,
+	0x0a, 0x0e, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c,
+	0x59, 0x4e, 0x4f, 0x4d, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x4c,
+	0x56, 0x45, 0x52, 0x5f, 0x42, 0x49, 0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x42, 0x52, 0x45, 0x4e, 0x54, 0x10,
+	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x57, 0x54,
+	0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x5a, 0x0a, 0x0a, 0x51, 0x55, 0x41, 0x44, 0x52, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x51, 0x55,
+	0x41, 0x44, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55,
+	0x41, 0x44, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x49, 0x4d, 0x50, 0x53, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x41, 0x44, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x47, 0x41, 0x55, 0x53, 0x53, 0x5f, 0x4b, 0x52, 0x4f, 0x4e, 0x52, 0x4f, 0x44, 0x10, 0x02,
+	0x2a, 0x57, 0x0a, 0x0a, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x12, 0x16,
+	0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x52, 0x5f, 0x52, 0x4b, 0x34, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x44,
+	0x5f, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x95, 0x01, 0x0a, 0x0d, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x4e, 0x4f, 0x4d, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x50, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x43, 0x48, 0x49, 0x50, 0x10,
+	0x04, 0x2a, 0x82, 0x01, 0x0a, 0x09, 0x46, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x12,
+	0x15, 0x0a, 0x11, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x49, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x4c, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x46, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x4e, 0x4f,
+	0x4d, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x49, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10,
+	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x4e, 0x0a, 0x08, 0x4c, 0x50, 0x5f, 0x53, 0x45, 0x4e,
+	0x53, 0x45, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4c, 0x50,
+	0x5f, 0x53, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x50, 0x5f, 0x53,
+	0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x49, 0x5a, 0x45, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x49,
+	0x4d, 0x49, 0x5a, 0x45, 0x10, 0x02, 0x2a, 0xa0, 0x01, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x53, 0x54,
+	0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x1f,
+	0x0a, 0x1b, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52,
+	0x41, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12,
+	0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x51, 0x55, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f,
+	0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x6c, 0x0a, 0x09, 0x4c, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x4c, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x4c, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4d,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x49, 0x4e, 0x46, 0x45, 0x41, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x43, 0x54,
+	0x41, 0x4e, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x48, 0x41, 0x4e, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x48, 0x41, 0x4d, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13,
+	0x0a, 0x0f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x4d, 0x41,
+	0x4e, 0x10, 0x03, 0x2a, 0x88, 0x01, 0x0a, 0x0a, 0x46, 0x49, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54,
+	0x45, 0x52, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x5f, 0x54, 0x41, 0x50, 0x53, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x52, 0x5f, 0x46,
+	0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x48,
+	0x49, 0x47, 0x48, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49,
+	0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x52, 0x5f, 0x46, 0x49, 0x4c, 0x54,
+	0x45, 0x52, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x04, 0x2a, 0xa7,
+	0x01, 0x0a, 0x08, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x48,
+	0x41, 0x50, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52,
+	0x59, 0x5f, 0x54, 0x52, 0x49, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x43,
+	0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x50, 0x4f, 0x4c, 0x59, 0x47, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x45, 0x4f,
+	0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x48, 0x41, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4e, 0x45, 0x10,
+	0x05, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x45, 0x4f, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x56, 0x49,
+	0x4e, 0x43, 0x45, 0x4e, 0x54, 0x59, 0x10, 0x06, 0x2a, 0x96, 0x01, 0x0a, 0x05, 0x53, 0x48, 0x41,
+	0x50, 0x45, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x48,
+	0x41, 0x50, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43,
+	0x49, 0x52, 0x43, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x41, 0x50, 0x45,
+	0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48, 0x41,
+	0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x54, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x45, 0x4c, 0x4c, 0x49, 0x50, 0x53, 0x45, 0x10,
+	0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x47, 0x55, 0x4c,
+	0x41, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x47, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x50, 0x45, 0x5a, 0x4f, 0x49, 0x44, 0x10,
+	0x06, 0x2a, 0x59, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x5f, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x55, 0x54, 0x48,
+	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x49,
+	0x43, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x49, 0x5a, 0x45, 0x10, 0x03, 0x32, 0xa8, 0x19, 0x0a,
+	0x11, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x05, 0x54, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x6f, 0x74,
+	0x68, 0x65, 0x73, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x69, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x48, 0x79, 0x70, 0x6f, 0x74, 0x68, 0x65, 0x73,
+	0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x48, 0x79, 0x70, 0x6f, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x1b, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x52, 0x6f, 0x6c,
+	0x6c, 0x44, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x44, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x44, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x68, 0x65, 0x6f,
+	0x72, 0x79, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x68, 0x65, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x68, 0x65, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x42, 0x69, 0x74, 0x73, 0x12, 0x1e,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68,
+	0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x46,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x0c, 0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6f,
+	0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x77, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e,
+	0x44, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x12, 0x1d, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69,
+	0x0a, 0x12, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x05, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x09, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x09, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x6d, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x08, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x44, 0x45, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x44,
+	0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x44, 0x45, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x6c, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x03, 0x46,
+	0x69, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x46, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x46, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x12, 0x22, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x09, 0x46, 0x49, 0x52, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x49, 0x52, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x49, 0x52, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x08, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x63, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67,
+	0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x2e, 0x3b, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_proto_calculatorpb_calculator_proto_rawDescOnce sync.Once
+	file_rpc_proto_calculatorpb_calculator_proto_rawDescData = file_rpc_proto_calculatorpb_calculator_proto_rawDesc
+)
+
+func file_rpc_proto_calculatorpb_calculator_proto_rawDescGZIP() []byte {
+	file_rpc_proto_calculatorpb_calculator_proto_rawDescOnce.Do(func() {
+		file_rpc_proto_calculatorpb_calculator_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_proto_calculatorpb_calculator_proto_rawDescData)
+	})
+	return file_rpc_proto_calculatorpb_calculator_proto_rawDescData
+}
+
+var file_rpc_proto_calculatorpb_calculator_proto_enumTypes = make([]protoimpl.EnumInfo, 35)
+var file_rpc_proto_calculatorpb_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
+var file_rpc_proto_calculatorpb_calculator_proto_goTypes = []interface{}{
+	(OPERATOR)(0),                      // 0: calculatorpb.OPERATOR
+	(TTEST)(0),                         // 1: calculatorpb.TTEST
+	(CHI_SQUARE_TEST)(0),               // 2: calculatorpb.CHI_SQUARE_TEST
+	(CORRELATION)(0),                   // 3: calculatorpb.CORRELATION
+	(ALTERNATIVE)(0),                   // 4: calculatorpb.ALTERNATIVE
+	(DISTRIBUTION)(0),                  // 5: calculatorpb.DISTRIBUTION
+	(DISTRIBUTION_FUNCTION)(0),         // 6: calculatorpb.DISTRIBUTION_FUNCTION
+	(COMBINATORICS)(0),                 // 7: calculatorpb.COMBINATORICS
+	(NUMBER_THEORY)(0),                 // 8: calculatorpb.NUMBER_THEORY
+	(INTEGER_TYPE)(0),                  // 9: calculatorpb.INTEGER_TYPE
+	(OVERFLOW)(0),                      // 10: calculatorpb.OVERFLOW
+	(INTEGER_OPERATOR)(0),              // 11: calculatorpb.INTEGER_OPERATOR
+	(FLOAT_CLASS)(0),                   // 12: calculatorpb.FLOAT_CLASS
+	(UNIT_OPERATOR)(0),                 // 13: calculatorpb.UNIT_OPERATOR
+	(ROUNDING)(0),                      // 14: calculatorpb.ROUNDING
+	(MONEY_OPERATOR)(0),                // 15: calculatorpb.MONEY_OPERATOR
+	(TIME_VALUE)(0),                    // 16: calculatorpb.TIME_VALUE
+	(PAYMENT_TIMING)(0),                // 17: calculatorpb.PAYMENT_TIMING
+	(CASH_FLOW)(0),                     // 18: calculatorpb.CASH_FLOW
+	(DEPRECIATION)(0),                  // 19: calculatorpb.DEPRECIATION
+	(DATE_OPERATOR)(0),                 // 20: calculatorpb.DATE_OPERATOR
+	(SYMBOLIC)(0),                      // 21: calculatorpb.SYMBOLIC
+	(SOLVER)(0),                        // 22: calculatorpb.SOLVER
+	(QUADRATURE)(0),                    // 23: calculatorpb.QUADRATURE
+	(ODE_SOLVER)(0),                    // 24: calculatorpb.ODE_SOLVER
+	(INTERPOLATION)(0),                 // 25: calculatorpb.INTERPOLATION
+	(FIT_MODEL)(0),                     // 26: calculatorpb.FIT_MODEL
+	(LP_SENSE)(0),                      // 27: calculatorpb.LP_SENSE
+	(CONSTRAINT_RELATION)(0),           // 28: calculatorpb.CONSTRAINT_RELATION
+	(LP_STATUS)(0),                     // 29: calculatorpb.LP_STATUS
+	(WINDOW)(0),                        // 30: calculatorpb.WINDOW
+	(FIR_FILTER)(0),                    // 31: calculatorpb.FIR_FILTER
+	(GEOMETRY)(0),                      // 32: calculatorpb.GEOMETRY
+	(SHAPE)(0),                         // 33: calculatorpb.SHAPE
+	(LOGIC)(0),                         // 34: calculatorpb.LOGIC
+	(*CalculateRequest)(nil),           // 35: calculatorpb.CalculateRequest
+	(*OPERANDS)(nil),                   // 36: calculatorpb.OPERANDS
+	(*CalculateResponse)(nil),          // 37: calculatorpb.CalculateResponse
+	(*StatisticsStreamRequest)(nil),    // 38: calculatorpb.StatisticsStreamRequest
+	(*StatisticsOptions)(nil),          // 39: calculatorpb.StatisticsOptions
+	(*StatisticsSnapshot)(nil),         // 40: calculatorpb.StatisticsSnapshot
+	(*QuantileValue)(nil),              // 41: calculatorpb.QuantileValue
+	(*TTestRequest)(nil),               // 42: calculatorpb.TTestRequest
+	(*ChiSquareTestRequest)(nil),       // 43: calculatorpb.ChiSquareTestRequest
+	(*DoubleRow)(nil),                  // 44: calculatorpb.DoubleRow
+	(*CorrelationRequest)(nil),         // 45: calculatorpb.CorrelationRequest
+	(*HypothesisTestResponse)(nil),     // 46: calculatorpb.HypothesisTestResponse
+	(*ConfidenceInterval)(nil),         // 47: calculatorpb.ConfidenceInterval
+	(*DistributionRequest)(nil),        // 48: calculatorpb.DistributionRequest
+	(*DistributionResponse)(nil),       // 49: calculatorpb.DistributionResponse
+	(*RandomRequest)(nil),              // 50: calculatorpb.RandomRequest
+	(*RandomResponse)(nil),             // 51: calculatorpb.RandomResponse
+	(*RollDiceRequest)(nil),            // 52: calculatorpb.RollDiceRequest
+	(*RollDiceResponse)(nil),           // 53: calculatorpb.RollDiceResponse
+	(*DiceTerm)(nil),                   // 54: calculatorpb.DiceTerm
+	(*CombinatoricsRequest)(nil),       // 55: calculatorpb.CombinatoricsRequest
+	(*CombinatoricsResponse)(nil),      // 56: calculatorpb.CombinatoricsResponse
+	(*NumberTheoryRequest)(nil),        // 57: calculatorpb.NumberTheoryRequest
+	(*NumberTheoryResponse)(nil),       // 58: calculatorpb.NumberTheoryResponse
+	(*PrimeFactor)(nil),                // 59: calculatorpb.PrimeFactor
+	(*IntegerCalculateRequest)(nil),    // 60: calculatorpb.IntegerCalculateRequest
+	(*IntegerCalculateResponse)(nil),   // 61: calculatorpb.IntegerCalculateResponse
+	(*FloatBitsRequest)(nil),           // 62: calculatorpb.FloatBitsRequest
+	(*FloatBitsResponse)(nil),          // 63: calculatorpb.FloatBitsResponse
+	(*Quantity)(nil),                   // 64: calculatorpb.Quantity
+	(*UnitCalculateRequest)(nil),       // 65: calculatorpb.UnitCalculateRequest
+	(*UnitCalculateResponse)(nil),      // 66: calculatorpb.UnitCalculateResponse
+	(*Money)(nil),                      // 67: calculatorpb.Money
+	(*ConvertCurrencyRequest)(nil),     // 68: calculatorpb.ConvertCurrencyRequest
+	(*ConvertCurrencyResponse)(nil),    // 69: calculatorpb.ConvertCurrencyResponse
+	(*ExchangeRate)(nil),               // 70: calculatorpb.ExchangeRate
+	(*MoneyCalculateRequest)(nil),      // 71: calculatorpb.MoneyCalculateRequest
+	(*MoneyCalculateResponse)(nil),     // 72: calculatorpb.MoneyCalculateResponse
+	(*TimeValueRequest)(nil),           // 73: calculatorpb.TimeValueRequest
+	(*TimeValueResponse)(nil),          // 74: calculatorpb.TimeValueResponse
+	(*Convergence)(nil),                // 75: calculatorpb.Convergence
+	(*CashFlowRequest)(nil),            // 76: calculatorpb.CashFlowRequest
+	(*CashFlowResponse)(nil),           // 77: calculatorpb.CashFlowResponse
+	(*AmortizationRequest)(nil),        // 78: calculatorpb.AmortizationRequest
+	(*AmortizationRow)(nil),            // 79: calculatorpb.AmortizationRow
+	(*DepreciationRequest)(nil),        // 80: calculatorpb.DepreciationRequest
+	(*DepreciationResponse)(nil),       // 81: calculatorpb.DepreciationResponse
+	(*DepreciationRow)(nil),            // 82: calculatorpb.DepreciationRow
+	(*DateCalculateRequest)(nil),       // 83: calculatorpb.DateCalculateRequest
+	(*DateCalculateResponse)(nil),      // 84: calculatorpb.DateCalculateResponse
+	(*RuleTableRequest)(nil),           // 85: calculatorpb.RuleTableRequest
+	(*RuleTableResponse)(nil),          // 86: calculatorpb.RuleTableResponse
+	(*RuleTableBracket)(nil),           // 87: calculatorpb.RuleTableBracket
+	(*SymbolicRequest)(nil),            // 88: calculatorpb.SymbolicRequest
+	(*SymbolicResponse)(nil),           // 89: calculatorpb.SymbolicResponse
+	(*EvaluateExpressionRequest)(nil),  // 90: calculatorpb.EvaluateExpressionRequest
+	(*EvaluateExpressionResponse)(nil), // 91: calculatorpb.EvaluateExpressionResponse
+	(*SolveRequest)(nil),               // 92: calculatorpb.SolveRequest
+	(*Root)(nil),                       // 93: calculatorpb.Root
+	(*SolveResponse)(nil),              // 94: calculatorpb.SolveResponse
+	(*IntegrateRequest)(nil),           // 95: calculatorpb.IntegrateRequest
+	(*IntegrateResponse)(nil),          // 96: calculatorpb.IntegrateResponse
+	(*SumSeriesRequest)(nil),           // 97: calculatorpb.SumSeriesRequest
+	(*SumSeriesResponse)(nil),          // 98: calculatorpb.SumSeriesResponse
+	(*ODEEquation)(nil),                // 99: calculatorpb.ODEEquation
+	(*SolveODERequest)(nil),            // 100: calculatorpb.SolveODERequest
+	(*ODEPoint)(nil),                   // 101: calculatorpb.ODEPoint
+	(*PlotSeries)(nil),                 // 102: calculatorpb.PlotSeries
+	(*PlotRequest)(nil),                // 103: calculatorpb.PlotRequest
+	(*PlotPoint)(nil),                  // 104: calculatorpb.PlotPoint
+	(*PlotSegment)(nil),                // 105: calculatorpb.PlotSegment
+	(*PlotData)(nil),                   // 106: calculatorpb.PlotData
+	(*PlotResponse)(nil),               // 107: calculatorpb.PlotResponse
+	(*InterpolateRequest)(nil),         // 108: calculatorpb.InterpolateRequest
+	(*InterpolateResponse)(nil),        // 109: calculatorpb.InterpolateResponse
+	(*FitRequest)(nil),                 // 110: calculatorpb.FitRequest
+	(*FitResponse)(nil),                // 111: calculatorpb.FitResponse
+	(*LinearConstraint)(nil),           // 112: calculatorpb.LinearConstraint
+	(*VariableBounds)(nil),             // 113: calculatorpb.VariableBounds
+	(*LinearProgramRequest)(nil),       // 114: calculatorpb.LinearProgramRequest
+	(*LinearProgramResponse)(nil),      // 115: calculatorpb.LinearProgramResponse
+	(*FourierTransformRequest)(nil),    // 116: calculatorpb.FourierTransformRequest
+	(*FourierTransformResponse)(nil),   // 117: calculatorpb.FourierTransformResponse
+	(*PowerSpectrumRequest)(nil),       // 118: calculatorpb.PowerSpectrumRequest
+	(*PowerSpectrumResponse)(nil),      // 119: calculatorpb.PowerSpectrumResponse
+	(*WindowRequest)(nil),              // 120: calculatorpb.WindowRequest
+	(*WindowResponse)(nil),             // 121: calculatorpb.WindowResponse
+	(*FIRFilterRequest)(nil),           // 122: calculatorpb.FIRFilterRequest
+	(*FIRFilterResponse)(nil),          // 123: calculatorpb.FIRFilterResponse
+	(*Point)(nil),                      // 124: calculatorpb.Point
+	(*Polygon)(nil),                    // 125: calculatorpb.Polygon
+	(*GeoPoint)(nil),                   // 126: calculatorpb.GeoPoint
+	(*Triangle)(nil),                   // 127: calculatorpb.Triangle
+	(*GeometryRequest)(nil),            // 128: calculatorpb.GeometryRequest
+	(*GeometryResponse)(nil),           // 129: calculatorpb.GeometryResponse
+	(*LogicRequest)(nil),               // 130: calculatorpb.LogicRequest
+	(*TruthTableRow)(nil),              // 131: calculatorpb.TruthTableRow
+	(*LogicResponse)(nil),              // 132: calculatorpb.LogicResponse
+	(*Interval)(nil),                   // 133: calculatorpb.Interval
+	(*IntervalCalculateRequest)(nil),   // 134: calculatorpb.IntervalCalculateRequest
+	(*IntervalCalculateResponse)(nil),  // 135: calculatorpb.IntervalCalculateResponse
+	nil,                                // 136: calculatorpb.DistributionRequest.ParametersEntry
+	nil,                                // 137: calculatorpb.RandomRequest.ParametersEntry
+	nil,                                // 138: calculatorpb.SymbolicRequest.ValuesEntry
+	nil,                                // 139: calculatorpb.EvaluateExpressionRequest.ValuesEntry
+	nil,                                // 140: calculatorpb.SolveRequest.ValuesEntry
+	nil,                                // 141: calculatorpb.IntegrateRequest.ValuesEntry
+	nil,                                // 142: calculatorpb.SumSeriesRequest.ValuesEntry
+	nil,                                // 143: calculatorpb.SolveODERequest.ValuesEntry
+	nil,                                // 144: calculatorpb.PlotRequest.ValuesEntry
+	nil,                                // 145: calculatorpb.LogicRequest.ValuesEntry
+	nil,                                // 146: calculatorpb.IntervalCalculateRequest.ValuesEntry
+	(*timestamppb.Timestamp)(nil),      // 147: google.protobuf.Timestamp
+}
+var file_rpc_proto_calculatorpb_calculator_proto_depIdxs = []int32{
+	0,   // 0: calculatorpb.CalculateRequest.operator:type_name -> calculatorpb.OPERATOR
+	36,  // 1: calculatorpb.CalculateRequest.operands:type_name -> calculatorpb.OPERANDS
+	39,  // 2: calculatorpb.StatisticsStreamRequest.options:type_name -> calculatorpb.StatisticsOptions
+	41,  // 3: calculatorpb.StatisticsSnapshot.quantiles:type_name -> calculatorpb.QuantileValue
+	1,   // 4: calculatorpb.TTestRequest.test:type_name -> calculatorpb.TTEST
+	4,   // 5: calculatorpb.TTestRequest.alternative:type_name -> calculatorpb.ALTERNATIVE
+	2,   // 6: calculatorpb.ChiSquareTestRequest.test:type_name -> calculatorpb.CHI_SQUARE_TEST
+	44,  // 7: calculatorpb.ChiSquareTestRequest.table:type_name -> calculatorpb.DoubleRow
+	3,   // 8: calculatorpb.CorrelationRequest.method:type_name -> calculatorpb.CORRELATION
+	4,   // 9: calculatorpb.CorrelationRequest.alternative:type_name -> calculatorpb.ALTERNATIVE
+	47,  // 10: calculatorpb.HypothesisTestResponse.confidence_interval:type_name -> calculatorpb.ConfidenceInterval
+	5,   // 11: calculatorpb.DistributionRequest.distribution:type_name -> calculatorpb.DISTRIBUTION
+	6,   // 12: calculatorpb.DistributionRequest.function:type_name -> calculatorpb.DISTRIBUTION_FUNCTION
+	136, // 13: calculatorpb.DistributionRequest.parameters:type_name -> calculatorpb.DistributionRequest.ParametersEntry
+	5,   // 14: calculatorpb.RandomRequest.distribution:type_name -> calculatorpb.DISTRIBUTION
+	137, // 15: calculatorpb.RandomRequest.parameters:type_name -> calculatorpb.RandomRequest.ParametersEntry
+	54,  // 16: calculatorpb.RollDiceResponse.terms:type_name -> calculatorpb.DiceTerm
+	7,   // 17: calculatorpb.CombinatoricsRequest.function:type_name -> calculatorpb.COMBINATORICS
+	8,   // 18: calculatorpb.NumberTheoryRequest.function:type_name -> calculatorpb.NUMBER_THEORY
+	59,  // 19: calculatorpb.NumberTheoryResponse.factors:type_name -> calculatorpb.PrimeFactor
+	11,  // 20: calculatorpb.IntegerCalculateRequest.operator:type_name -> calculatorpb.INTEGER_OPERATOR
+	9,   // 21: calculatorpb.IntegerCalculateRequest.type:type_name -> calculatorpb.INTEGER_TYPE
+	10,  // 22: calculatorpb.IntegerCalculateRequest.overflow:type_name -> calculatorpb.OVERFLOW
+	12,  // 23: calculatorpb.FloatBitsResponse.class:type_name -> calculatorpb.FLOAT_CLASS
+	13,  // 24: calculatorpb.UnitCalculateRequest.operator:type_name -> calculatorpb.UNIT_OPERATOR
+	64,  // 25: calculatorpb.UnitCalculateRequest.operand_1:type_name -> calculatorpb.Quantity
+	64,  // 26: calculatorpb.UnitCalculateRequest.operand_2:type_name -> calculatorpb.Quantity
+	64,  // 27: calculatorpb.UnitCalculateResponse.result:type_name -> calculatorpb.Quantity
+	64,  // 28: calculatorpb.UnitCalculateResponse.base:type_name -> calculatorpb.Quantity
+	67,  // 29: calculatorpb.ConvertCurrencyRequest.amounts:type_name -> calculatorpb.Money
+	14,  // 30: calculatorpb.ConvertCurrencyRequest.rounding:type_name -> calculatorpb.ROUNDING
+	67,  // 31: calculatorpb.ConvertCurrencyResponse.result:type_name -> calculatorpb.Money
+	70,  // 32: calculatorpb.ConvertCurrencyResponse.rates:type_name -> calculatorpb.ExchangeRate
+	147, // 33: calculatorpb.ExchangeRate.as_of:type_name -> google.protobuf.Timestamp
+	15,  // 34: calculatorpb.MoneyCalculateRequest.operator:type_name -> calculatorpb.MONEY_OPERATOR
+	67,  // 35: calculatorpb.MoneyCalculateRequest.amount:type_name -> calculatorpb.Money
+	67,  // 36: calculatorpb.MoneyCalculateRequest.other:type_name -> calculatorpb.Money
+	14,  // 37: calculatorpb.MoneyCalculateRequest.rounding:type_name -> calculatorpb.ROUNDING
+	67,  // 38: calculatorpb.MoneyCalculateResponse.result:type_name -> calculatorpb.Money
+	67,  // 39: calculatorpb.MoneyCalculateResponse.parts:type_name -> calculatorpb.Money
+	67,  // 40: calculatorpb.MoneyCalculateResponse.tax:type_name -> calculatorpb.Money
+	16,  // 41: calculatorpb.TimeValueRequest.function:type_name -> calculatorpb.TIME_VALUE
+	17,  // 42: calculatorpb.TimeValueRequest.timing:type_name -> calculatorpb.PAYMENT_TIMING
+	75,  // 43: calculatorpb.TimeValueResponse.convergence:type_name -> calculatorpb.Convergence
+	18,  // 44: calculatorpb.CashFlowRequest.function:type_name -> calculatorpb.CASH_FLOW
+	75,  // 45: calculatorpb.CashFlowResponse.convergence:type_name -> calculatorpb.Convergence
+	17,  // 46: calculatorpb.AmortizationRequest.timing:type_name -> calculatorpb.PAYMENT_TIMING
+	19,  // 47: calculatorpb.DepreciationRequest.method:type_name -> calculatorpb.DEPRECIATION
+	82,  // 48: calculatorpb.DepreciationResponse.rows:type_name -> calculatorpb.DepreciationRow
+	20,  // 49: calculatorpb.DateCalculateRequest.operator:type_name -> calculatorpb.DATE_OPERATOR
+	14,  // 50: calculatorpb.RuleTableRequest.rounding:type_name -> calculatorpb.ROUNDING
+	87,  // 51: calculatorpb.RuleTableResponse.brackets:type_name -> calculatorpb.RuleTableBracket
+	21,  // 52: calculatorpb.SymbolicRequest.operation:type_name -> calculatorpb.SYMBOLIC
+	138, // 53: calculatorpb.SymbolicRequest.values:type_name -> calculatorpb.SymbolicRequest.ValuesEntry
+	139, // 54: calculatorpb.EvaluateExpressionRequest.values:type_name -> calculatorpb.EvaluateExpressionRequest.ValuesEntry
+	22,  // 55: calculatorpb.SolveRequest.solver:type_name -> calculatorpb.SOLVER
+	140, // 56: calculatorpb.SolveRequest.values:type_name -> calculatorpb.SolveRequest.ValuesEntry
+	93,  // 57: calculatorpb.SolveResponse.roots:type_name -> calculatorpb.Root
+	75,  // 58: calculatorpb.SolveResponse.convergence:type_name -> calculatorpb.Convergence
+	23,  // 59: calculatorpb.IntegrateRequest.method:type_name -> calculatorpb.QUADRATURE
+	141, // 60: calculatorpb.IntegrateRequest.values:type_name -> calculatorpb.IntegrateRequest.ValuesEntry
+	142, // 61: calculatorpb.SumSeriesRequest.values:type_name -> calculatorpb.SumSeriesRequest.ValuesEntry
+	24,  // 62: calculatorpb.SolveODERequest.solver:type_name -> calculatorpb.ODE_SOLVER
+	99,  // 63: calculatorpb.SolveODERequest.equations:type_name -> calculatorpb.ODEEquation
+	143, // 64: calculatorpb.SolveODERequest.values:type_name -> calculatorpb.SolveODERequest.ValuesEntry
+	102, // 65: calculatorpb.PlotRequest.series:type_name -> calculatorpb.PlotSeries
+	144, // 66: calculatorpb.PlotRequest.values:type_name -> calculatorpb.PlotRequest.ValuesEntry
+	104, // 67: calculatorpb.PlotSegment.points:type_name -> calculatorpb.PlotPoint
+	105, // 68: calculatorpb.PlotData.segments:type_name -> calculatorpb.PlotSegment
+	106, // 69: calculatorpb.PlotResponse.series:type_name -> calculatorpb.PlotData
+	25,  // 70: calculatorpb.InterpolateRequest.method:type_name -> calculatorpb.INTERPOLATION
+	26,  // 71: calculatorpb.FitRequest.model:type_name -> calculatorpb.FIT_MODEL
+	28,  // 72: calculatorpb.LinearConstraint.relation:type_name -> calculatorpb.CONSTRAINT_RELATION
+	27,  // 73: calculatorpb.LinearProgramRequest.sense:type_name -> calculatorpb.LP_SENSE
+	112, // 74: calculatorpb.LinearProgramRequest.constraints:type_name -> calculatorpb.LinearConstraint
+	113, // 75: calculatorpb.LinearProgramRequest.bounds:type_name -> calculatorpb.VariableBounds
+	29,  // 76: calculatorpb.LinearProgramResponse.status:type_name -> calculatorpb.LP_STATUS
+	30,  // 77: calculatorpb.FourierTransformRequest.window:type_name -> calculatorpb.WINDOW
+	30,  // 78: calculatorpb.PowerSpectrumRequest.window:type_name -> calculatorpb.WINDOW
+	30,  // 79: calculatorpb.WindowRequest.window:type_name -> calculatorpb.WINDOW
+	31,  // 80: calculatorpb.FIRFilterRequest.filter:type_name -> calculatorpb.FIR_FILTER
+	30,  // 81: calculatorpb.FIRFilterRequest.window:type_name -> calculatorpb.WINDOW
+	124, // 82: calculatorpb.Polygon.vertices:type_name -> calculatorpb.Point
+	32,  // 83: calculatorpb.GeometryRequest.function:type_name -> calculatorpb.GEOMETRY
+	33,  // 84: calculatorpb.GeometryRequest.shape:type_name -> calculatorpb.SHAPE
+	127, // 85: calculatorpb.GeometryRequest.triangle:type_name -> calculatorpb.Triangle
+	124, // 86: calculatorpb.GeometryRequest.from:type_name -> calculatorpb.Point
+	124, // 87: calculatorpb.GeometryRequest.to:type_name -> calculatorpb.Point
+	125, // 88: calculatorpb.GeometryRequest.polygon:type_name -> calculatorpb.Polygon
+	126, // 89: calculatorpb.GeometryRequest.origin:type_name -> calculatorpb.GeoPoint
+	126, // 90: calculatorpb.GeometryRequest.destination:type_name -> calculatorpb.GeoPoint
+	127, // 91: calculatorpb.GeometryResponse.triangle:type_name -> calculatorpb.Triangle
+	124, // 92: calculatorpb.GeometryResponse.centroid:type_name -> calculatorpb.Point
+	34,  // 93: calculatorpb.LogicRequest.function:type_name -> calculatorpb.LOGIC
+	145, // 94: calculatorpb.LogicRequest.values:type_name -> calculatorpb.LogicRequest.ValuesEntry
+	131, // 95: calculatorpb.LogicResponse.rows:type_name -> calculatorpb.TruthTableRow
+	0,   // 96: calculatorpb.IntervalCalculateRequest.operator:type_name -> calculatorpb.OPERATOR
+	133, // 97: calculatorpb.IntervalCalculateRequest.operand_1:type_name -> calculatorpb.Interval
+	133, // 98: calculatorpb.IntervalCalculateRequest.operand_2:type_name -> calculatorpb.Interval
+	146, // 99: calculatorpb.IntervalCalculateRequest.values:type_name -> calculatorpb.IntervalCalculateRequest.ValuesEntry
+	133, // 100: calculatorpb.IntervalCalculateResponse.intervals:type_name -> calculatorpb.Interval
+	133, // 101: calculatorpb.IntervalCalculateResponse.hull:type_name -> calculatorpb.Interval
+	133, // 102: calculatorpb.IntervalCalculateRequest.ValuesEntry.value:type_name -> calculatorpb.Interval
+	35,  // 103: calculatorpb.CalculatorService.Calculator:input_type -> calculatorpb.CalculateRequest
+	38,  // 104: calculatorpb.CalculatorService.StreamStatistics:input_type -> calculatorpb.StatisticsStreamRequest
+	42,  // 105: calculatorpb.CalculatorService.TTest:input_type -> calculatorpb.TTestRequest
+	43,  // 106: calculatorpb.CalculatorService.ChiSquareTest:input_type -> calculatorpb.ChiSquareTestRequest
+	45,  // 107: calculatorpb.CalculatorService.Correlation:input_type -> calculatorpb.CorrelationRequest
+	48,  // 108: calculatorpb.CalculatorService.Distribution:input_type -> calculatorpb.DistributionRequest
+	50,  // 109: calculatorpb.CalculatorService.Random:input_type -> calculatorpb.RandomRequest
+	52,  // 110: calculatorpb.CalculatorService.RollDice:input_type -> calculatorpb.RollDiceRequest
+	55,  // 111: calculatorpb.CalculatorService.Combinatorics:input_type -> calculatorpb.CombinatoricsRequest
+	57,  // 112: calculatorpb.CalculatorService.NumberTheory:input_type -> calculatorpb.NumberTheoryRequest
+	60,  // 113: calculatorpb.CalculatorService.IntegerCalculator:input_type -> calculatorpb.IntegerCalculateRequest
+	62,  // 114: calculatorpb.CalculatorService.FloatBits:input_type -> calculatorpb.FloatBitsRequest
+	65,  // 115: calculatorpb.CalculatorService.UnitCalculator:input_type -> calculatorpb.UnitCalculateRequest
+	68,  // 116: calculatorpb.CalculatorService.ConvertCurrency:input_type -> calculatorpb.ConvertCurrencyRequest
+	71,  // 117: calculatorpb.CalculatorService.MoneyCalculator:input_type -> calculatorpb.MoneyCalculateRequest
+	73,  // 118: calculatorpb.CalculatorService.TimeValue:input_type -> calculatorpb.TimeValueRequest
+	76,  // 119: calculatorpb.CalculatorService.CashFlow:input_type -> calculatorpb.CashFlowRequest
+	78,  // 120: calculatorpb.CalculatorService.Amortization:input_type -> calculatorpb.AmortizationRequest
+	80,  // 121: calculatorpb.CalculatorService.Depreciation:input_type -> calculatorpb.DepreciationRequest
+	83,  // 122: calculatorpb.CalculatorService.DateCalculator:input_type -> calculatorpb.DateCalculateRequest
+	85,  // 123: calculatorpb.CalculatorService.EvaluateRuleTable:input_type -> calculatorpb.RuleTableRequest
+	88,  // 124: calculatorpb.CalculatorService.Symbolic:input_type -> calculatorpb.SymbolicRequest
+	90,  // 125: calculatorpb.CalculatorService.EvaluateExpression:input_type -> calculatorpb.EvaluateExpressionRequest
+	92,  // 126: calculatorpb.CalculatorService.Solve:input_type -> calculatorpb.SolveRequest
+	95,  // 127: calculatorpb.CalculatorService.Integrate:input_type -> calculatorpb.IntegrateRequest
+	97,  // 128: calculatorpb.CalculatorService.SumSeries:input_type -> calculatorpb.SumSeriesRequest
+	100, // 129: calculatorpb.CalculatorService.SolveODE:input_type -> calculatorpb.SolveODERequest
+	103, // 130: calculatorpb.CalculatorService.Plot:input_type -> calculatorpb.PlotRequest
+	108, // 131: calculatorpb.CalculatorService.Interpolate:input_type -> calculatorpb.InterpolateRequest
+	110, // 132: calculatorpb.CalculatorService.Fit:input_type -> calculatorpb.FitRequest
+	114, // 133: calculatorpb.CalculatorService.LinearProgram:input_type -> calculatorpb.LinearProgramRequest
+	116, // 134: calculatorpb.CalculatorService.FourierTransform:input_type -> calculatorpb.FourierTransformRequest
+	118, // 135: calculatorpb.CalculatorService.PowerSpectrum:input_type -> calculatorpb.PowerSpectrumRequest
+	120, // 136: calculatorpb.CalculatorService.Window:input_type -> calculatorpb.WindowRequest
+	122, // 137: calculatorpb.CalculatorService.FIRFilter:input_type -> calculatorpb.FIRFilterRequest
+	128, // 138: calculatorpb.CalculatorService.Geometry:input_type -> calculatorpb.GeometryRequest
+	130, // 139: calculatorpb.CalculatorService.Logic:input_type -> calculatorpb.LogicRequest
+	134, // 140: calculatorpb.CalculatorService.IntervalCalculator:input_type -> calculatorpb.IntervalCalculateRequest
+	37,  // 141: calculatorpb.CalculatorService.Calculator:output_type -> calculatorpb.CalculateResponse
+	40,  // 142: calculatorpb.CalculatorService.StreamStatistics:output_type -> calculatorpb.StatisticsSnapshot
+	46,  // 143: calculatorpb.CalculatorService.TTest:output_type -> calculatorpb.HypothesisTestResponse
+	46,  // 144: calculatorpb.CalculatorService.ChiSquareTest:output_type -> calculatorpb.HypothesisTestResponse
+	46,  // 145: calculatorpb.CalculatorService.Correlation:output_type -> calculatorpb.HypothesisTestResponse
+	49,  // 146: calculatorpb.CalculatorService.Distribution:output_type -> calculatorpb.DistributionResponse
+	51,  // 147: calculatorpb.CalculatorService.Random:output_type -> calculatorpb.RandomResponse
+	53,  // 148: calculatorpb.CalculatorService.RollDice:output_type -> calculatorpb.RollDiceResponse
+	56,  // 149: calculatorpb.CalculatorService.Combinatorics:output_type -> calculatorpb.CombinatoricsResponse
+	58,  // 150: calculatorpb.CalculatorService.NumberTheory:output_type -> calculatorpb.NumberTheoryResponse
+	61,  // 151: calculatorpb.CalculatorService.IntegerCalculator:output_type -> calculatorpb.IntegerCalculateResponse
+	63,  // 152: calculatorpb.CalculatorService.FloatBits:output_type -> calculatorpb.FloatBitsResponse
+	66,  // 153: calculatorpb.CalculatorService.UnitCalculator:output_type -> calculatorpb.UnitCalculateResponse
+	69,  // 154: calculatorpb.CalculatorService.ConvertCurrency:output_type -> calculatorpb.ConvertCurrencyResponse
+	72,  // 155: calculatorpb.CalculatorService.MoneyCalculator:output_type -> calculatorpb.MoneyCalculateResponse
+	74,  // 156: calculatorpb.CalculatorService.TimeValue:output_type -> calculatorpb.TimeValueResponse
+	77,  // 157: calculatorpb.CalculatorService.CashFlow:output_type -> calculatorpb.CashFlowResponse
+	79,  // 158: calculatorpb.CalculatorService.Amortization:output_type -> calculatorpb.AmortizationRow
+	81,  // 159: calculatorpb.CalculatorService.Depreciation:output_type -> calculatorpb.DepreciationResponse
+	84,  // 160: calculatorpb.CalculatorService.DateCalculator:output_type -> calculatorpb.DateCalculateResponse
+	86,  // 161: calculatorpb.CalculatorService.EvaluateRuleTable:output_type -> calculatorpb.RuleTableResponse
+	89,  // 162: calculatorpb.CalculatorService.Symbolic:output_type -> calculatorpb.SymbolicResponse
+	91,  // 163: calculatorpb.CalculatorService.EvaluateExpression:output_type -> calculatorpb.EvaluateExpressionResponse
+	94,  // 164: calculatorpb.CalculatorService.Solve:output_type -> calculatorpb.SolveResponse
+	96,  // 165: calculatorpb.CalculatorService.Integrate:output_type -> calculatorpb.IntegrateResponse
+	98,  // 166: calculatorpb.CalculatorService.SumSeries:output_type -> calculatorpb.SumSeriesResponse
+	101, // 167: calculatorpb.CalculatorService.SolveODE:output_type -> calculatorpb.ODEPoint
+	107, // 168: calculatorpb.CalculatorService.Plot:output_type -> calculatorpb.PlotResponse
+	109, // 169: calculatorpb.CalculatorService.Interpolate:output_type -> calculatorpb.InterpolateResponse
+	111, // 170: calculatorpb.CalculatorService.Fit:output_type -> calculatorpb.FitResponse
+	115, // 171: calculatorpb.CalculatorService.LinearProgram:output_type -> calculatorpb.LinearProgramResponse
+	117, // 172: calculatorpb.CalculatorService.FourierTransform:output_type -> calculatorpb.FourierTransformResponse
+	119, // 173: calculatorpb.CalculatorService.PowerSpectrum:output_type -> calculatorpb.PowerSpectrumResponse
+	121, // 174: calculatorpb.CalculatorService.Window:output_type -> calculatorpb.WindowResponse
+	123, // 175: calculatorpb.CalculatorService.FIRFilter:output_type -> calculatorpb.FIRFilterResponse
+	129, // 176: calculatorpb.CalculatorService.Geometry:output_type -> calculatorpb.GeometryResponse
+	132, // 177: calculatorpb.CalculatorService.Logic:output_type -> calculatorpb.LogicResponse
+	135, // 178: calculatorpb.CalculatorService.IntervalCalculator:output_type -> calculatorpb.IntervalCalculateResponse
+	141, // [141:179] is the sub-list for method output_type
+	103, // [103:141] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
+}
+
+func init() { file_rpc_proto_calculatorpb_calculator_proto_init() }
+func file_rpc_proto_calculatorpb_calculator_proto_init() {
+	if File_rpc_proto_calculatorpb_calculator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OPERANDS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatisticsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatisticsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatisticsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuantileValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChiSquareTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HypothesisTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfidenceInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollDiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollDiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiceTerm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinatoricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinatoricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberTheoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberTheoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimeFactor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegerCalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegerCalculateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatBitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatBitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quantity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitCalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitCalculateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertCurrencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertCurrencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoneyCalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoneyCalculateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Convergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashFlowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashFlowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmortizationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmortizationRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepreciationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepreciationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepreciationRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateCalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateCalculateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleTableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleTableBracket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateExpressionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateExpressionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Root); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegrateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegrateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SumSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SumSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ODEEquation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolveODERequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ODEPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotSeries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterpolateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
 			}
 		}
-		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OPERANDS); i {
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterpolateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -333,8 +12465,308 @@ func file_rpc_proto_calculatorpb_calculator_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalculateResponse); i {
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableBounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FourierTransformRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FourierTransformResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerSpectrumRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerSpectrumResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FIRFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FIRFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Polygon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Triangle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeometryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeometryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TruthTableRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntervalCalculateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_calculatorpb_calculator_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntervalCalculateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -351,8 +12783,8 @@ func file_rpc_proto_calculatorpb_calculator_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_proto_calculatorpb_calculator_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      35,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
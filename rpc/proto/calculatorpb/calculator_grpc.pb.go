@@ -23,6 +23,43 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CalculatorServiceClient interface {
 	Calculator(ctx context.Context, in *CalculateRequest, opts ...grpc.CallOption) (*CalculateResponse, error)
+	StreamStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_StreamStatisticsClient, error)
+	TTest(ctx context.Context, in *TTestRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error)
+	ChiSquareTest(ctx context.Context, in *ChiSquareTestRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error)
+	Correlation(ctx context.Context, in *CorrelationRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error)
+	Distribution(ctx context.Context, in *DistributionRequest, opts ...grpc.CallOption) (*DistributionResponse, error)
+	Random(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomResponse, error)
+	RollDice(ctx context.Context, in *RollDiceRequest, opts ...grpc.CallOption) (*RollDiceResponse, error)
+	Combinatorics(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*CombinatoricsResponse, error)
+	NumberTheory(ctx context.Context, in *NumberTheoryRequest, opts ...grpc.CallOption) (*NumberTheoryResponse, error)
+	IntegerCalculator(ctx context.Context, in *IntegerCalculateRequest, opts ...grpc.CallOption) (*IntegerCalculateResponse, error)
+	FloatBits(ctx context.Context, in *FloatBitsRequest, opts ...grpc.CallOption) (*FloatBitsResponse, error)
+	UnitCalculator(ctx context.Context, in *UnitCalculateRequest, opts ...grpc.CallOption) (*UnitCalculateResponse, error)
+	ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*ConvertCurrencyResponse, error)
+	MoneyCalculator(ctx context.Context, in *MoneyCalculateRequest, opts ...grpc.CallOption) (*MoneyCalculateResponse, error)
+	TimeValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*TimeValueResponse, error)
+	CashFlow(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*CashFlowResponse, error)
+	Amortization(ctx context.Context, in *AmortizationRequest, opts ...grpc.CallOption) (CalculatorService_AmortizationClient, error)
+	Depreciation(ctx context.Context, in *DepreciationRequest, opts ...grpc.CallOption) (*DepreciationResponse, error)
+	DateCalculator(ctx context.Context, in *DateCalculateRequest, opts ...grpc.CallOption) (*DateCalculateResponse, error)
+	EvaluateRuleTable(ctx context.Context, in *RuleTableRequest, opts ...grpc.CallOption) (*RuleTableResponse, error)
+	Symbolic(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicResponse, error)
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error)
+	Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error)
+	Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*IntegrateResponse, error)
+	SumSeries(ctx context.Context, in *SumSeriesRequest, opts ...grpc.CallOption) (*SumSeriesResponse, error)
+	SolveODE(ctx context.Context, in *SolveODERequest, opts ...grpc.CallOption) (CalculatorService_SolveODEClient, error)
+	Plot(ctx context.Context, in *PlotRequest, opts ...grpc.CallOption) (*PlotResponse, error)
+	Interpolate(ctx context.Context, in *InterpolateRequest, opts ...grpc.CallOption) (*InterpolateResponse, error)
+	Fit(ctx context.Context, in *FitRequest, opts ...grpc.CallOption) (*FitResponse, error)
+	LinearProgram(ctx context.Context, in *LinearProgramRequest, opts ...grpc.CallOption) (*LinearProgramResponse, error)
+	FourierTransform(ctx context.Context, in *FourierTransformRequest, opts ...grpc.CallOption) (*FourierTransformResponse, error)
+	PowerSpectrum(ctx context.Context, in *PowerSpectrumRequest, opts ...grpc.CallOption) (*PowerSpectrumResponse, error)
+	Window(ctx context.Context, in *WindowRequest, opts ...grpc.CallOption) (*WindowResponse, error)
+	FIRFilter(ctx context.Context, in *FIRFilterRequest, opts ...grpc.CallOption) (*FIRFilterResponse, error)
+	Geometry(ctx context.Context, in *GeometryRequest, opts ...grpc.CallOption) (*GeometryResponse, error)
+	Logic(ctx context.Context, in *LogicRequest, opts ...grpc.CallOption) (*LogicResponse, error)
+	IntervalCalculator(ctx context.Context, in *IntervalCalculateRequest, opts ...grpc.CallOption) (*IntervalCalculateResponse, error)
 }
 
 type calculatorServiceClient struct {
@@ -42,48 +79,1277 @@ func (c *calculatorServiceClient) Calculator(ctx context.Context, in *CalculateR
 	return out, nil
 }
 
+func (c *calculatorServiceClient) StreamStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_StreamStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[0], "/calculatorpb.CalculatorService/StreamStatistics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceStreamStatisticsClient{stream}
+	return x, nil
+}
+
+type CalculatorService_StreamStatisticsClient interface {
+	Send(*StatisticsStreamRequest) error
+	Recv() (*StatisticsSnapshot, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceStreamStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceStreamStatisticsClient) Send(m *StatisticsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceStreamStatisticsClient) Recv() (*StatisticsSnapshot, error) {
+	m := new(StatisticsSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) TTest(ctx context.Context, in *TTestRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error) {
+	out := new(HypothesisTestResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/TTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ChiSquareTest(ctx context.Context, in *ChiSquareTestRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error) {
+	out := new(HypothesisTestResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/ChiSquareTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Correlation(ctx context.Context, in *CorrelationRequest, opts ...grpc.CallOption) (*HypothesisTestResponse, error) {
+	out := new(HypothesisTestResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Correlation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Distribution(ctx context.Context, in *DistributionRequest, opts ...grpc.CallOption) (*DistributionResponse, error) {
+	out := new(DistributionResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Distribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Random(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomResponse, error) {
+	out := new(RandomResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Random", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) RollDice(ctx context.Context, in *RollDiceRequest, opts ...grpc.CallOption) (*RollDiceResponse, error) {
+	out := new(RollDiceResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/RollDice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Combinatorics(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*CombinatoricsResponse, error) {
+	out := new(CombinatoricsResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Combinatorics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) NumberTheory(ctx context.Context, in *NumberTheoryRequest, opts ...grpc.CallOption) (*NumberTheoryResponse, error) {
+	out := new(NumberTheoryResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/NumberTheory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) IntegerCalculator(ctx context.Context, in *IntegerCalculateRequest, opts ...grpc.CallOption) (*IntegerCalculateResponse, error) {
+	out := new(IntegerCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/IntegerCalculator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) FloatBits(ctx context.Context, in *FloatBitsRequest, opts ...grpc.CallOption) (*FloatBitsResponse, error) {
+	out := new(FloatBitsResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/FloatBits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) UnitCalculator(ctx context.Context, in *UnitCalculateRequest, opts ...grpc.CallOption) (*UnitCalculateResponse, error) {
+	out := new(UnitCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/UnitCalculator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*ConvertCurrencyResponse, error) {
+	out := new(ConvertCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/ConvertCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) MoneyCalculator(ctx context.Context, in *MoneyCalculateRequest, opts ...grpc.CallOption) (*MoneyCalculateResponse, error) {
+	out := new(MoneyCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/MoneyCalculator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) TimeValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*TimeValueResponse, error) {
+	out := new(TimeValueResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/TimeValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) CashFlow(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*CashFlowResponse, error) {
+	out := new(CashFlowResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/CashFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Amortization(ctx context.Context, in *AmortizationRequest, opts ...grpc.CallOption) (CalculatorService_AmortizationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[1], "/calculatorpb.CalculatorService/Amortization", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceAmortizationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_AmortizationClient interface {
+	Recv() (*AmortizationRow, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceAmortizationClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceAmortizationClient) Recv() (*AmortizationRow, error) {
+	m := new(AmortizationRow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) Depreciation(ctx context.Context, in *DepreciationRequest, opts ...grpc.CallOption) (*DepreciationResponse, error) {
+	out := new(DepreciationResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Depreciation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) DateCalculator(ctx context.Context, in *DateCalculateRequest, opts ...grpc.CallOption) (*DateCalculateResponse, error) {
+	out := new(DateCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/DateCalculator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) EvaluateRuleTable(ctx context.Context, in *RuleTableRequest, opts ...grpc.CallOption) (*RuleTableResponse, error) {
+	out := new(RuleTableResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/EvaluateRuleTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Symbolic(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicResponse, error) {
+	out := new(SymbolicResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Symbolic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error) {
+	out := new(EvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/EvaluateExpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error) {
+	out := new(SolveResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Solve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*IntegrateResponse, error) {
+	out := new(IntegrateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Integrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) SumSeries(ctx context.Context, in *SumSeriesRequest, opts ...grpc.CallOption) (*SumSeriesResponse, error) {
+	out := new(SumSeriesResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/SumSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) SolveODE(ctx context.Context, in *SolveODERequest, opts ...grpc.CallOption) (CalculatorService_SolveODEClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[2], "/calculatorpb.CalculatorService/SolveODE", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceSolveODEClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_SolveODEClient interface {
+	Recv() (*ODEPoint, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceSolveODEClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceSolveODEClient) Recv() (*ODEPoint, error) {
+	m := new(ODEPoint)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) Plot(ctx context.Context, in *PlotRequest, opts ...grpc.CallOption) (*PlotResponse, error) {
+	out := new(PlotResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Plot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Interpolate(ctx context.Context, in *InterpolateRequest, opts ...grpc.CallOption) (*InterpolateResponse, error) {
+	out := new(InterpolateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Interpolate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Fit(ctx context.Context, in *FitRequest, opts ...grpc.CallOption) (*FitResponse, error) {
+	out := new(FitResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Fit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) LinearProgram(ctx context.Context, in *LinearProgramRequest, opts ...grpc.CallOption) (*LinearProgramResponse, error) {
+	out := new(LinearProgramResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/LinearProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) FourierTransform(ctx context.Context, in *FourierTransformRequest, opts ...grpc.CallOption) (*FourierTransformResponse, error) {
+	out := new(FourierTransformResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/FourierTransform", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) PowerSpectrum(ctx context.Context, in *PowerSpectrumRequest, opts ...grpc.CallOption) (*PowerSpectrumResponse, error) {
+	out := new(PowerSpectrumResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/PowerSpectrum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Window(ctx context.Context, in *WindowRequest, opts ...grpc.CallOption) (*WindowResponse, error) {
+	out := new(WindowResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Window", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) FIRFilter(ctx context.Context, in *FIRFilterRequest, opts ...grpc.CallOption) (*FIRFilterResponse, error) {
+	out := new(FIRFilterResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/FIRFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Geometry(ctx context.Context, in *GeometryRequest, opts ...grpc.CallOption) (*GeometryResponse, error) {
+	out := new(GeometryResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Geometry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Logic(ctx context.Context, in *LogicRequest, opts ...grpc.CallOption) (*LogicResponse, error) {
+	out := new(LogicResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/Logic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) IntervalCalculator(ctx context.Context, in *IntervalCalculateRequest, opts ...grpc.CallOption) (*IntervalCalculateResponse, error) {
+	out := new(IntervalCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calculatorpb.CalculatorService/IntervalCalculator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CalculatorServiceServer is the server API for CalculatorService service.
 // All implementations must embed UnimplementedCalculatorServiceServer
 // for forward compatibility
 type CalculatorServiceServer interface {
 	Calculator(context.Context, *CalculateRequest) (*CalculateResponse, error)
+	StreamStatistics(CalculatorService_StreamStatisticsServer) error
+	TTest(context.Context, *TTestRequest) (*HypothesisTestResponse, error)
+	ChiSquareTest(context.Context, *ChiSquareTestRequest) (*HypothesisTestResponse, error)
+	Correlation(context.Context, *CorrelationRequest) (*HypothesisTestResponse, error)
+	Distribution(context.Context, *DistributionRequest) (*DistributionResponse, error)
+	Random(context.Context, *RandomRequest) (*RandomResponse, error)
+	RollDice(context.Context, *RollDiceRequest) (*RollDiceResponse, error)
+	Combinatorics(context.Context, *CombinatoricsRequest) (*CombinatoricsResponse, error)
+	NumberTheory(context.Context, *NumberTheoryRequest) (*NumberTheoryResponse, error)
+	IntegerCalculator(context.Context, *IntegerCalculateRequest) (*IntegerCalculateResponse, error)
+	FloatBits(context.Context, *FloatBitsRequest) (*FloatBitsResponse, error)
+	UnitCalculator(context.Context, *UnitCalculateRequest) (*UnitCalculateResponse, error)
+	ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*ConvertCurrencyResponse, error)
+	MoneyCalculator(context.Context, *MoneyCalculateRequest) (*MoneyCalculateResponse, error)
+	TimeValue(context.Context, *TimeValueRequest) (*TimeValueResponse, error)
+	CashFlow(context.Context, *CashFlowRequest) (*CashFlowResponse, error)
+	Amortization(*AmortizationRequest, CalculatorService_AmortizationServer) error
+	Depreciation(context.Context, *DepreciationRequest) (*DepreciationResponse, error)
+	DateCalculator(context.Context, *DateCalculateRequest) (*DateCalculateResponse, error)
+	EvaluateRuleTable(context.Context, *RuleTableRequest) (*RuleTableResponse, error)
+	Symbolic(context.Context, *SymbolicRequest) (*SymbolicResponse, error)
+	EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error)
+	Solve(context.Context, *SolveRequest) (*SolveResponse, error)
+	Integrate(context.Context, *IntegrateRequest) (*IntegrateResponse, error)
+	SumSeries(context.Context, *SumSeriesRequest) (*SumSeriesResponse, error)
+	SolveODE(*SolveODERequest, CalculatorService_SolveODEServer) error
+	Plot(context.Context, *PlotRequest) (*PlotResponse, error)
+	Interpolate(context.Context, *InterpolateRequest) (*InterpolateResponse, error)
+	Fit(context.Context, *FitRequest) (*FitResponse, error)
+	LinearProgram(context.Context, *LinearProgramRequest) (*LinearProgramResponse, error)
+	FourierTransform(context.Context, *FourierTransformRequest) (*FourierTransformResponse, error)
+	PowerSpectrum(context.Context, *PowerSpectrumRequest) (*PowerSpectrumResponse, error)
+	Window(context.Context, *WindowRequest) (*WindowResponse, error)
+	FIRFilter(context.Context, *FIRFilterRequest) (*FIRFilterResponse, error)
+	Geometry(context.Context, *GeometryRequest) (*GeometryResponse, error)
+	Logic(context.Context, *LogicRequest) (*LogicResponse, error)
+	IntervalCalculator(context.Context, *IntervalCalculateRequest) (*IntervalCalculateResponse, error)
 	mustEmbedUnimplementedCalculatorServiceServer()
 }
 
-// UnimplementedCalculatorServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCalculatorServiceServer struct {
+// UnimplementedCalculatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalculatorServiceServer struct {
+}
+
+func (UnimplementedCalculatorServiceServer) Calculator(context.Context, *CalculateRequest) (*CalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) StreamStatistics(CalculatorService_StreamStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStatistics not implemented")
+}
+func (UnimplementedCalculatorServiceServer) TTest(context.Context, *TTestRequest) (*HypothesisTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TTest not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ChiSquareTest(context.Context, *ChiSquareTestRequest) (*HypothesisTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChiSquareTest not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Correlation(context.Context, *CorrelationRequest) (*HypothesisTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Correlation not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Distribution(context.Context, *DistributionRequest) (*DistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Random(context.Context, *RandomRequest) (*RandomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Random not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RollDice(context.Context, *RollDiceRequest) (*RollDiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollDice not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Combinatorics(context.Context, *CombinatoricsRequest) (*CombinatoricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Combinatorics not implemented")
+}
+func (UnimplementedCalculatorServiceServer) NumberTheory(context.Context, *NumberTheoryRequest) (*NumberTheoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumberTheory not implemented")
+}
+func (UnimplementedCalculatorServiceServer) IntegerCalculator(context.Context, *IntegerCalculateRequest) (*IntegerCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegerCalculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FloatBits(context.Context, *FloatBitsRequest) (*FloatBitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FloatBits not implemented")
+}
+func (UnimplementedCalculatorServiceServer) UnitCalculator(context.Context, *UnitCalculateRequest) (*UnitCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnitCalculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*ConvertCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCurrency not implemented")
+}
+func (UnimplementedCalculatorServiceServer) MoneyCalculator(context.Context, *MoneyCalculateRequest) (*MoneyCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoneyCalculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) TimeValue(context.Context, *TimeValueRequest) (*TimeValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeValue not implemented")
+}
+func (UnimplementedCalculatorServiceServer) CashFlow(context.Context, *CashFlowRequest) (*CashFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashFlow not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Amortization(*AmortizationRequest, CalculatorService_AmortizationServer) error {
+	return status.Errorf(codes.Unimplemented, "method Amortization not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Depreciation(context.Context, *DepreciationRequest) (*DepreciationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Depreciation not implemented")
+}
+func (UnimplementedCalculatorServiceServer) DateCalculator(context.Context, *DateCalculateRequest) (*DateCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DateCalculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) EvaluateRuleTable(context.Context, *RuleTableRequest) (*RuleTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateRuleTable not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Symbolic(context.Context, *SymbolicRequest) (*SymbolicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Symbolic not implemented")
+}
+func (UnimplementedCalculatorServiceServer) EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateExpression not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Solve(context.Context, *SolveRequest) (*SolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Integrate(context.Context, *IntegrateRequest) (*IntegrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) SumSeries(context.Context, *SumSeriesRequest) (*SumSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SumSeries not implemented")
+}
+func (UnimplementedCalculatorServiceServer) SolveODE(*SolveODERequest, CalculatorService_SolveODEServer) error {
+	return status.Errorf(codes.Unimplemented, "method SolveODE not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Plot(context.Context, *PlotRequest) (*PlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plot not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Interpolate(context.Context, *InterpolateRequest) (*InterpolateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Interpolate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Fit(context.Context, *FitRequest) (*FitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fit not implemented")
+}
+func (UnimplementedCalculatorServiceServer) LinearProgram(context.Context, *LinearProgramRequest) (*LinearProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinearProgram not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FourierTransform(context.Context, *FourierTransformRequest) (*FourierTransformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FourierTransform not implemented")
+}
+func (UnimplementedCalculatorServiceServer) PowerSpectrum(context.Context, *PowerSpectrumRequest) (*PowerSpectrumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerSpectrum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Window(context.Context, *WindowRequest) (*WindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Window not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FIRFilter(context.Context, *FIRFilterRequest) (*FIRFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FIRFilter not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Geometry(context.Context, *GeometryRequest) (*GeometryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Geometry not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Logic(context.Context, *LogicRequest) (*LogicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logic not implemented")
+}
+func (UnimplementedCalculatorServiceServer) IntervalCalculator(context.Context, *IntervalCalculateRequest) (*IntervalCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntervalCalculator not implemented")
+}
+func (UnimplementedCalculatorServiceServer) mustEmbedUnimplementedCalculatorServiceServer() {}
+
+// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
+// result in compilation errors.
+type UnsafeCalculatorServiceServer interface {
+	mustEmbedUnimplementedCalculatorServiceServer()
+}
+
+func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
+	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+}
+
+func _CalculatorService_Calculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Calculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Calculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Calculator(ctx, req.(*CalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_StreamStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).StreamStatistics(&calculatorServiceStreamStatisticsServer{stream})
+}
+
+type CalculatorService_StreamStatisticsServer interface {
+	Send(*StatisticsSnapshot) error
+	Recv() (*StatisticsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceStreamStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceStreamStatisticsServer) Send(m *StatisticsSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceStreamStatisticsServer) Recv() (*StatisticsStreamRequest, error) {
+	m := new(StatisticsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_TTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).TTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/TTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).TTest(ctx, req.(*TTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ChiSquareTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChiSquareTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ChiSquareTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/ChiSquareTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ChiSquareTest(ctx, req.(*ChiSquareTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Correlation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Correlation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Correlation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Correlation(ctx, req.(*CorrelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Distribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Distribution(ctx, req.(*DistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Random_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Random(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Random",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Random(ctx, req.(*RandomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_RollDice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollDiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).RollDice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/RollDice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).RollDice(ctx, req.(*RollDiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Combinatorics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinatoricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Combinatorics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Combinatorics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Combinatorics(ctx, req.(*CombinatoricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_NumberTheory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumberTheoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).NumberTheory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/NumberTheory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).NumberTheory(ctx, req.(*NumberTheoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_IntegerCalculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).IntegerCalculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/IntegerCalculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).IntegerCalculator(ctx, req.(*IntegerCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_FloatBits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatBitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).FloatBits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/FloatBits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).FloatBits(ctx, req.(*FloatBitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_UnitCalculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).UnitCalculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/UnitCalculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).UnitCalculator(ctx, req.(*UnitCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ConvertCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ConvertCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/ConvertCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ConvertCurrency(ctx, req.(*ConvertCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_MoneyCalculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoneyCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).MoneyCalculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/MoneyCalculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).MoneyCalculator(ctx, req.(*MoneyCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_TimeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).TimeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/TimeValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).TimeValue(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_CashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).CashFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/CashFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).CashFlow(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Amortization_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AmortizationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).Amortization(m, &calculatorServiceAmortizationServer{stream})
+}
+
+type CalculatorService_AmortizationServer interface {
+	Send(*AmortizationRow) error
+	grpc.ServerStream
+}
+
+type calculatorServiceAmortizationServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceAmortizationServer) Send(m *AmortizationRow) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_Depreciation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepreciationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Depreciation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Depreciation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Depreciation(ctx, req.(*DepreciationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_DateCalculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DateCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).DateCalculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/DateCalculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).DateCalculator(ctx, req.(*DateCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_EvaluateRuleTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).EvaluateRuleTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/EvaluateRuleTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).EvaluateRuleTable(ctx, req.(*RuleTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Symbolic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Symbolic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Symbolic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Symbolic(ctx, req.(*SymbolicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/EvaluateExpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).EvaluateExpression(ctx, req.(*EvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Solve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Solve(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Integrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Integrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Integrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Integrate(ctx, req.(*IntegrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedCalculatorServiceServer) Calculator(context.Context, *CalculateRequest) (*CalculateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Calculator not implemented")
+func _CalculatorService_SumSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SumSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).SumSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/SumSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).SumSeries(ctx, req.(*SumSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCalculatorServiceServer) mustEmbedUnimplementedCalculatorServiceServer() {}
 
-// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
-// result in compilation errors.
-type UnsafeCalculatorServiceServer interface {
-	mustEmbedUnimplementedCalculatorServiceServer()
+func _CalculatorService_SolveODE_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SolveODERequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).SolveODE(m, &calculatorServiceSolveODEServer{stream})
 }
 
-func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
-	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+type CalculatorService_SolveODEServer interface {
+	Send(*ODEPoint) error
+	grpc.ServerStream
 }
 
-func _CalculatorService_Calculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateRequest)
+type calculatorServiceSolveODEServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceSolveODEServer) Send(m *ODEPoint) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_Plot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CalculatorServiceServer).Calculator(ctx, in)
+		return srv.(CalculatorServiceServer).Plot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/calculatorpb.CalculatorService/Calculator",
+		FullMethod: "/calculatorpb.CalculatorService/Plot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServiceServer).Calculator(ctx, req.(*CalculateRequest))
+		return srv.(CalculatorServiceServer).Plot(ctx, req.(*PlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Interpolate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterpolateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Interpolate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Interpolate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Interpolate(ctx, req.(*InterpolateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Fit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Fit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Fit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Fit(ctx, req.(*FitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_LinearProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinearProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).LinearProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/LinearProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).LinearProgram(ctx, req.(*LinearProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_FourierTransform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FourierTransformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).FourierTransform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/FourierTransform",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).FourierTransform(ctx, req.(*FourierTransformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_PowerSpectrum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerSpectrumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).PowerSpectrum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/PowerSpectrum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).PowerSpectrum(ctx, req.(*PowerSpectrumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Window_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Window(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Window",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Window(ctx, req.(*WindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_FIRFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FIRFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).FIRFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/FIRFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).FIRFilter(ctx, req.(*FIRFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Geometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Geometry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Geometry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Geometry(ctx, req.(*GeometryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Logic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Logic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/Logic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Logic(ctx, req.(*LogicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_IntervalCalculator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntervalCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).IntervalCalculator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculatorpb.CalculatorService/IntervalCalculator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).IntervalCalculator(ctx, req.(*IntervalCalculateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -99,7 +1365,160 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Calculator",
 			Handler:    _CalculatorService_Calculator_Handler,
 		},
+		{
+			MethodName: "TTest",
+			Handler:    _CalculatorService_TTest_Handler,
+		},
+		{
+			MethodName: "ChiSquareTest",
+			Handler:    _CalculatorService_ChiSquareTest_Handler,
+		},
+		{
+			MethodName: "Correlation",
+			Handler:    _CalculatorService_Correlation_Handler,
+		},
+		{
+			MethodName: "Distribution",
+			Handler:    _CalculatorService_Distribution_Handler,
+		},
+		{
+			MethodName: "Random",
+			Handler:    _CalculatorService_Random_Handler,
+		},
+		{
+			MethodName: "RollDice",
+			Handler:    _CalculatorService_RollDice_Handler,
+		},
+		{
+			MethodName: "Combinatorics",
+			Handler:    _CalculatorService_Combinatorics_Handler,
+		},
+		{
+			MethodName: "NumberTheory",
+			Handler:    _CalculatorService_NumberTheory_Handler,
+		},
+		{
+			MethodName: "IntegerCalculator",
+			Handler:    _CalculatorService_IntegerCalculator_Handler,
+		},
+		{
+			MethodName: "FloatBits",
+			Handler:    _CalculatorService_FloatBits_Handler,
+		},
+		{
+			MethodName: "UnitCalculator",
+			Handler:    _CalculatorService_UnitCalculator_Handler,
+		},
+		{
+			MethodName: "ConvertCurrency",
+			Handler:    _CalculatorService_ConvertCurrency_Handler,
+		},
+		{
+			MethodName: "MoneyCalculator",
+			Handler:    _CalculatorService_MoneyCalculator_Handler,
+		},
+		{
+			MethodName: "TimeValue",
+			Handler:    _CalculatorService_TimeValue_Handler,
+		},
+		{
+			MethodName: "CashFlow",
+			Handler:    _CalculatorService_CashFlow_Handler,
+		},
+		{
+			MethodName: "Depreciation",
+			Handler:    _CalculatorService_Depreciation_Handler,
+		},
+		{
+			MethodName: "DateCalculator",
+			Handler:    _CalculatorService_DateCalculator_Handler,
+		},
+		{
+			MethodName: "EvaluateRuleTable",
+			Handler:    _CalculatorService_EvaluateRuleTable_Handler,
+		},
+		{
+			MethodName: "Symbolic",
+			Handler:    _CalculatorService_Symbolic_Handler,
+		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _CalculatorService_EvaluateExpression_Handler,
+		},
+		{
+			MethodName: "Solve",
+			Handler:    _CalculatorService_Solve_Handler,
+		},
+		{
+			MethodName: "Integrate",
+			Handler:    _CalculatorService_Integrate_Handler,
+		},
+		{
+			MethodName: "SumSeries",
+			Handler:    _CalculatorService_SumSeries_Handler,
+		},
+		{
+			MethodName: "Plot",
+			Handler:    _CalculatorService_Plot_Handler,
+		},
+		{
+			MethodName: "Interpolate",
+			Handler:    _CalculatorService_Interpolate_Handler,
+		},
+		{
+			MethodName: "Fit",
+			Handler:    _CalculatorService_Fit_Handler,
+		},
+		{
+			MethodName: "LinearProgram",
+			Handler:    _CalculatorService_LinearProgram_Handler,
+		},
+		{
+			MethodName: "FourierTransform",
+			Handler:    _CalculatorService_FourierTransform_Handler,
+		},
+		{
+			MethodName: "PowerSpectrum",
+			Handler:    _CalculatorService_PowerSpectrum_Handler,
+		},
+		{
+			MethodName: "Window",
+			Handler:    _CalculatorService_Window_Handler,
+		},
+		{
+			MethodName: "FIRFilter",
+			Handler:    _CalculatorService_FIRFilter_Handler,
+		},
+		{
+			MethodName: "Geometry",
+			Handler:    _CalculatorService_Geometry_Handler,
+		},
+		{
+			MethodName: "Logic",
+			Handler:    _CalculatorService_Logic_Handler,
+		},
+		{
+			MethodName: "IntervalCalculator",
+			Handler:    _CalculatorService_IntervalCalculator_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStatistics",
+			Handler:       _CalculatorService_StreamStatistics_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Amortization",
+			Handler:       _CalculatorService_Amortization_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SolveODE",
+			Handler:       _CalculatorService_SolveODE_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/proto/calculatorpb/calculator.proto",
 }
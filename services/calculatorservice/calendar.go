@@ -0,0 +1,135 @@
+package calculatorservice
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// calendarFile is the YAML or JSON format of a holiday calendar. Holidays are
+// ISO 8601 dates such as 2024-05-09, or month-days such as --12-25 for the
+// holidays that fall on the same date every year.
+type calendarFile struct {
+	Name     string    `yaml:"name"`
+	Weekend  *[]string `yaml:"weekend"`
+	Holidays []string  `yaml:"holidays"`
+}
+
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+type holidayCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[civilDate]bool
+	annual   map[monthDay]bool
+}
+
+// defaultCalendar has a Saturday and Sunday weekend and no holidays
+var defaultCalendar = &holidayCalendar{
+	weekend: map[time.Weekday]bool{time.Saturday: true, time.Sunday: true},
+}
+
+func (c *holidayCalendar) isBusinessDay(t time.Time) bool {
+	y, m, d := t.Date()
+	return !c.weekend[t.Weekday()] && !c.holidays[civilDate{y, m, d}] && !c.annual[monthDay{m, d}]
+}
+
+// loadHolidayCalendars reads every YAML or JSON file of dir as a calendar,
+// named by its name field or else by its file name
+func loadHolidayCalendars(dir string) (map[string]*holidayCalendar, error) {
+	calendars := map[string]*holidayCalendar{}
+	if dir == "" {
+		return calendars, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read holiday calendars: %w", err)
+	}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read holiday calendar: %w", err)
+		}
+		name, calendar, err := parseHolidayCalendar(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse holiday calendar %s: %w", entry.Name(), err)
+		}
+		if name == "" {
+			name = strings.TrimSuffix(entry.Name(), ext)
+		}
+		key := strings.ToUpper(name)
+		if _, ok := calendars[key]; ok {
+			return nil, fmt.Errorf("holiday calendar %s is defined twice", name)
+		}
+		calendars[key] = calendar
+	}
+	return calendars, nil
+}
+
+func parseHolidayCalendar(data []byte) (string, *holidayCalendar, error) {
+	var file calendarFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return "", nil, err
+	}
+	c := &holidayCalendar{
+		weekend:  defaultCalendar.weekend,
+		holidays: map[civilDate]bool{},
+		annual:   map[monthDay]bool{},
+	}
+	if file.Weekend != nil {
+		c.weekend = map[time.Weekday]bool{}
+		for _, name := range *file.Weekend {
+			day, ok := parseWeekday(name)
+			if !ok {
+				return "", nil, fmt.Errorf("unknown weekday %q", name)
+			}
+			c.weekend[day] = true
+		}
+		if len(c.weekend) == 7 {
+			return "", nil, fmt.Errorf("every day of the week is a weekend day")
+		}
+	}
+	for _, h := range file.Holidays {
+		if strings.HasPrefix(h, "--") {
+			// the year 2000 is a leap year, so that --02-29 is accepted
+			t, err := time.Parse(isoDate, "2000-"+h[2:])
+			if err != nil {
+				return "", nil, fmt.Errorf("holiday %q is not a month-day such as --12-25", h)
+			}
+			c.annual[monthDay{t.Month(), t.Day()}] = true
+			continue
+		}
+		t, err := time.Parse(isoDate, h)
+		if err != nil {
+			return "", nil, fmt.Errorf("holiday %q is not a date such as 2024-12-25", h)
+		}
+		y, m, d := t.Date()
+		c.holidays[civilDate{y, m, d}] = true
+	}
+	return file.Name, c, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(name, d.String()) || strings.EqualFold(name, d.String()[:3]) {
+			return d, true
+		}
+	}
+	return 0, false
+}
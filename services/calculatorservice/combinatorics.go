@@ -0,0 +1,125 @@
+package calculatorservice
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultCombinatoricsLimit = 100000
+	// binomialPrecision is the mantissa size in bits of binomial probabilities
+	binomialPrecision = 256
+	binomialDigits    = 20
+)
+
+// Combinatorics computes exact factorials, permutations, combinations, multinomial
+// coefficients, Catalan and Fibonacci numbers, and binomial probabilities
+func (c *Calculator) Combinatorics(ctx context.Context, req *calculatorpb.CombinatoricsRequest) (*calculatorpb.CombinatoricsResponse, error) {
+	n, k := req.N, req.K
+	if err := c.checkCombinatoricsLimit("n", n); err != nil {
+		return nil, err
+	}
+
+	result := new(big.Int)
+	switch req.Function {
+	case calculatorpb.COMBINATORICS_COMBINATORICS_FACTORIAL:
+		result.MulRange(1, int64(n))
+	case calculatorpb.COMBINATORICS_COMBINATORICS_PERMUTATIONS:
+		if k > n {
+			return nil, invalidArgumentf("k (%d) must not be larger than n (%d)", k, n)
+		}
+		result.MulRange(int64(n-k+1), int64(n))
+	case calculatorpb.COMBINATORICS_COMBINATORICS_COMBINATIONS:
+		if k > n {
+			return nil, invalidArgumentf("k (%d) must not be larger than n (%d)", k, n)
+		}
+		result.Binomial(int64(n), int64(k))
+	case calculatorpb.COMBINATORICS_COMBINATORICS_MULTINOMIAL:
+		if len(req.Groups) == 0 {
+			return nil, invalidArgumentf("multinomial coefficients need at least one group")
+		}
+		result.SetInt64(1)
+		total := uint64(0)
+		for _, size := range req.Groups {
+			// compare against the remaining headroom so that the sum can't wrap around
+			if size > c.combinatoricsLimit-total {
+				return nil, invalidArgumentf("the sum of the groups must not be larger than %d", c.combinatoricsLimit)
+			}
+			total += size
+			result.Mul(result, new(big.Int).Binomial(int64(total), int64(size)))
+		}
+	case calculatorpb.COMBINATORICS_COMBINATORICS_CATALAN:
+		result.Binomial(int64(2*n), int64(n))
+		result.Quo(result, big.NewInt(int64(n+1)))
+	case calculatorpb.COMBINATORICS_COMBINATORICS_FIBONACCI:
+		result, _ = fibonacci(n)
+	case calculatorpb.COMBINATORICS_COMBINATORICS_BINOMIAL_PROBABILITY:
+		if k > n {
+			return nil, invalidArgumentf("k (%d) must not be larger than n (%d)", k, n)
+		}
+		p, ok := new(big.Rat).SetString(req.Probability)
+		if !ok || p.Sign() < 0 || p.Cmp(big.NewRat(1, 1)) > 0 {
+			return nil, invalidArgumentf("probability %q must be a decimal within [0, 1]", req.Probability)
+		}
+		return &calculatorpb.CombinatoricsResponse{
+			Result: binomialProbability(n, k, p).Text('g', binomialDigits),
+		}, nil
+	default:
+		return nil, invalidArgumentf("combinatorics function is not supplied")
+	}
+
+	text := result.String()
+	return &calculatorpb.CombinatoricsResponse{
+		Result: text,
+		Digits: uint64(len(text)),
+	}, nil
+}
+
+func (c *Calculator) checkCombinatoricsLimit(name string, n uint64) error {
+	if n > c.combinatoricsLimit {
+		return invalidArgumentf("%s is %d but must not be larger than %d", name, n, c.combinatoricsLimit)
+	}
+	return nil
+}
+
+// fibonacci returns F(n) and F(n+1) using the fast doubling identities
+// F(2k) = F(k)(2F(k+1) - F(k)) and F(2k+1) = F(k)² + F(k+1)²
+func fibonacci(n uint64) (*big.Int, *big.Int) {
+	if n == 0 {
+		return big.NewInt(0), big.NewInt(1)
+	}
+	a, b := fibonacci(n / 2)
+	c := new(big.Int).Lsh(b, 1)
+	c.Sub(c, a)
+	c.Mul(c, a)
+	d := new(big.Int).Mul(a, a)
+	d.Add(d, new(big.Int).Mul(b, b))
+	if n%2 == 0 {
+		return c, d
+	}
+	return d, c.Add(c, d)
+}
+
+// binomialProbability is C(n, k) p^k (1-p)^(n-k), evaluated with an extended
+// exponent range so that it doesn't underflow for large n
+func binomialProbability(n, k uint64, p *big.Rat) *big.Float {
+	q := new(big.Rat).Sub(big.NewRat(1, 1), p)
+	result := new(big.Float).SetPrec(binomialPrecision).SetInt(new(big.Int).Binomial(int64(n), int64(k)))
+	result.Mul(result, powFloat(p, k))
+	return result.Mul(result, powFloat(q, n-k))
+}
+
+// powFloat raises x to the power e by repeated squaring
+func powFloat(x *big.Rat, e uint64) *big.Float {
+	base := new(big.Float).SetPrec(binomialPrecision).SetRat(x)
+	result := new(big.Float).SetPrec(binomialPrecision).SetInt64(1)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result.Mul(result, base)
+		}
+		base.Mul(base, base)
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Combinatorics(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name           string
+		request        *calculatorpb.CombinatoricsRequest
+		expectedResult string
+	}{
+		{
+			name:           "FactorialZero",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_FACTORIAL},
+			expectedResult: "1",
+		},
+		{
+			name:           "FactorialBeyondFloat64",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_FACTORIAL, N: 25},
+			expectedResult: "15511210043330985984000000",
+		},
+		{
+			name:           "Permutations",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_PERMUTATIONS, N: 10, K: 3},
+			expectedResult: "720",
+		},
+		{
+			name:           "Combinations",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_COMBINATIONS, N: 100, K: 50},
+			expectedResult: "100891344545564193334812497256",
+		},
+		{
+			name:           "Multinomial",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_MULTINOMIAL, Groups: []uint64{2, 3, 4}},
+			expectedResult: "1260",
+		},
+		{
+			name:           "Catalan",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_CATALAN, N: 10},
+			expectedResult: "16796",
+		},
+		{
+			name:           "Fibonacci",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_FIBONACCI, N: 100},
+			expectedResult: "354224848179261915075",
+		},
+		{
+			name:           "BinomialProbability",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_BINOMIAL_PROBABILITY, N: 10, K: 5, Probability: "0.5"},
+			expectedResult: "0.24609375",
+		},
+		{
+			name:           "BinomialProbabilityLargeN",
+			request:        &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_BINOMIAL_PROBABILITY, N: 5000, K: 0, Probability: "0.5"},
+			expectedResult: "7.0798112610481728924e-1506",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Combinatorics(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, res.Result)
+		})
+	}
+}
+
+func Test_CombinatoricsLimit(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithCombinatoricsLimit(1000))
+
+	res, err := calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_FACTORIAL, N: 1000})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2568), res.Digits)
+
+	_, err = calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_FACTORIAL, N: 1001})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_MULTINOMIAL, Groups: []uint64{600, 600}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_MULTINOMIAL, Groups: []uint64{1, 18446744073709551615}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_COMBINATIONS, N: 5, K: 6})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.Combinatorics(context.Background(), &calculatorpb.CombinatoricsRequest{Function: calculatorpb.COMBINATORICS_COMBINATORICS_BINOMIAL_PROBABILITY, N: 5, K: 2, Probability: "1.5"})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+}
@@ -0,0 +1,72 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rateDigits is the number of decimals of the rates in responses, the
+// conversion itself uses the exact rate
+const rateDigits = 10
+
+// ConvertCurrency converts amounts in several currencies to one currency and sums them exactly
+func (c *Calculator) ConvertCurrency(ctx context.Context, req *calculatorpb.ConvertCurrencyRequest) (*calculatorpb.ConvertCurrencyResponse, error) {
+	target, err := parseCurrency(req.TargetCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Amounts) == 0 {
+		return nil, invalidArgumentf("at least one amount is required")
+	}
+
+	res := &calculatorpb.ConvertCurrencyResponse{}
+	rates := map[string]*big.Rat{target: big.NewRat(1, 1)}
+	total := new(big.Rat)
+	for _, m := range req.Amounts {
+		currency, err := parseCurrency(m.Currency)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseDecimal(m.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		rate, ok := rates[currency]
+		if !ok {
+			if c.rates == nil {
+				return nil, fmt.Errorf("%w: no exchange rate provider is configured", ErrRateUnavailable)
+			}
+			r, err := c.rates.Rate(ctx, currency, target)
+			if err != nil {
+				return nil, err
+			}
+			rate, rates[currency] = r.Rate, r.Rate
+			res.Rates = append(res.Rates, &calculatorpb.ExchangeRate{
+				Base:  currency,
+				Quote: target,
+				Rate:  formatRate(r.Rate),
+				AsOf:  timestamppb.New(r.AsOf),
+			})
+		}
+		total.Add(total, amount.Mul(amount, rate))
+	}
+
+	places := minorUnit(target)
+	res.Result = &calculatorpb.Money{
+		Amount:   formatDecimal(roundDecimal(total, places, req.Rounding), places),
+		Currency: target,
+	}
+	return res, nil
+}
+
+// formatRate writes a rate with up to rateDigits decimals
+func formatRate(r *big.Rat) string {
+	s := strings.TrimRight(r.FloatString(rateDigits), "0")
+	return strings.TrimSuffix(s, ".")
+}
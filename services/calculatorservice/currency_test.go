@@ -0,0 +1,289 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+const ratesJSON = `{"base": "EUR", "as_of": "2024-05-02T16:00:00Z", "rates": {"USD": "1.0723", "GBP": 0.8562, "JPY": "166.05"}}`
+
+const ratesCSV = `base,quote,rate,as_of
+EUR,USD,1.0723,2024-05-02
+EUR,GBP,0.8562,2024-05-01
+EUR,JPY,166.05,2024-05-02
+`
+
+func writeRates(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func Test_ConvertCurrency(t *testing.T) {
+	for _, file := range []string{"rates.json", "rates.csv"} {
+		content := ratesJSON
+		if file == "rates.csv" {
+			content = ratesCSV
+		}
+		rates, err := calculatorservice.NewFileRateProvider(writeRates(t, file, content))
+		assert.Nil(t, err)
+		calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRateProvider(rates))
+
+		tests := []struct {
+			name           string
+			amounts        []*calculatorpb.Money
+			target         string
+			expectedAmount string
+			expectedRates  []string
+		}{
+			{"CrossRate", []*calculatorpb.Money{{Amount: "19.99", Currency: "EUR"}, {Amount: "5", Currency: "USD"}}, "GBP", "21.11", []string{"0.8562", "0.7984705773"}},
+			{"InverseRate", []*calculatorpb.Money{{Amount: "100", Currency: "JPY"}}, "EUR", "0.60", []string{"0.0060222824"}},
+			{"NoMinorUnit", []*calculatorpb.Money{{Amount: "1000", Currency: "USD"}}, "JPY", "154854", []string{"154.8540520377"}},
+			{"SameCurrency", []*calculatorpb.Money{{Amount: "1.005", Currency: "usd"}, {Amount: "-0.5", Currency: "USD"}}, "USD", "0.50", nil},
+		}
+		for _, tt := range tests {
+			t.Run(file+"/"+tt.name, func(t *testing.T) {
+				res, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+					Amounts:        tt.amounts,
+					TargetCurrency: tt.target,
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectedAmount, res.Result.Amount)
+				assert.Equal(t, tt.target, res.Result.Currency)
+				if tt.expectedRates != nil {
+					assert.Len(t, res.Rates, len(tt.expectedRates))
+					for i, rate := range tt.expectedRates {
+						assert.Equal(t, rate, res.Rates[i].Rate)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_ConvertCurrencyAsOf(t *testing.T) {
+	rates, err := calculatorservice.NewFileRateProvider(writeRates(t, "rates.csv", ratesCSV))
+	assert.Nil(t, err)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRateProvider(rates))
+
+	res, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+		Amounts:        []*calculatorpb.Money{{Amount: "10", Currency: "USD"}},
+		TargetCurrency: "GBP",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Rates, 1)
+	assert.Equal(t, "USD", res.Rates[0].Base)
+	assert.Equal(t, "GBP", res.Rates[0].Quote)
+	// a cross rate is as old as its oldest leg
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), res.Rates[0].AsOf.AsTime())
+}
+
+func Test_ConvertCurrencyRounding(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		rounding calculatorpb.ROUNDING
+		amount   string
+		expected string
+	}{
+		{calculatorpb.ROUNDING_ROUNDING_HALF_EVEN, "2.345", "2.34"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_EVEN, "2.355", "2.36"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_EVEN, "-2.345", "-2.34"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_UP, "2.345", "2.35"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_UP, "-2.345", "-2.35"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_DOWN, "2.345", "2.34"},
+		{calculatorpb.ROUNDING_ROUNDING_HALF_DOWN, "2.3451", "2.35"},
+		{calculatorpb.ROUNDING_ROUNDING_DOWN, "-2.349", "-2.34"},
+		{calculatorpb.ROUNDING_ROUNDING_UP, "2.341", "2.35"},
+		{calculatorpb.ROUNDING_ROUNDING_CEILING, "-2.349", "-2.34"},
+		{calculatorpb.ROUNDING_ROUNDING_FLOOR, "-2.341", "-2.35"},
+		{calculatorpb.ROUNDING_ROUNDING_FLOOR, "2.3", "2.30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rounding.String()+"/"+tt.amount, func(t *testing.T) {
+			res, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+				Amounts:        []*calculatorpb.Money{{Amount: tt.amount, Currency: "EUR"}},
+				TargetCurrency: "EUR",
+				Rounding:       tt.rounding,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, res.Result.Amount)
+		})
+	}
+
+	res, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+		Amounts:        []*calculatorpb.Money{{Amount: "1.2345", Currency: "KWD"}},
+		TargetCurrency: "KWD",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1.234", res.Result.Amount)
+}
+
+func Test_HTTPRateProvider(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(ratesJSON))
+	}))
+	defer server.Close()
+
+	rates := calculatorservice.NewHTTPRateProvider(server.URL, server.Client(), time.Minute)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRateProvider(rates))
+	for i := 0; i < 3; i++ {
+		res, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+			Amounts:        []*calculatorpb.Money{{Amount: "100", Currency: "EUR"}},
+			TargetCurrency: "USD",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "107.23", res.Result.Amount)
+		assert.Equal(t, time.Date(2024, 5, 2, 16, 0, 0, 0, time.UTC), res.Rates[0].AsOf.AsTime())
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	calculatorSvc, _ = calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout),
+		calculatorservice.WithRateProvider(calculatorservice.NewHTTPRateProvider(failing.URL, failing.Client(), 0)))
+	_, err := calculatorSvc.ConvertCurrency(context.Background(), &calculatorpb.ConvertCurrencyRequest{
+		Amounts:        []*calculatorpb.Money{{Amount: "100", Currency: "EUR"}},
+		TargetCurrency: "USD",
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrRateUnavailable))
+}
+
+func Test_HTTPRateProviderSharedFetch(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		_, _ = w.Write([]byte(ratesJSON))
+	}))
+	defer server.Close()
+	rates := calculatorservice.NewHTTPRateProvider(server.URL, server.Client(), time.Minute)
+
+	// a request that gives up does not cancel the fetch the others wait for
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := rates.Rate(ctx, "EUR", "USD")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error %v", err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rate, err := rates.Rate(context.Background(), "EUR", "GBP")
+			assert.Nil(t, err)
+			assert.Equal(t, "0.8562", rate.Rate.FloatString(4))
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func Test_HTTPRateProviderStaleRates(t *testing.T) {
+	var failing int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(ratesJSON))
+	}))
+	defer server.Close()
+	rates := calculatorservice.NewHTTPRateProvider(server.URL, server.Client(), time.Millisecond)
+
+	_, err := rates.Rate(context.Background(), "EUR", "USD")
+	assert.Nil(t, err)
+	atomic.StoreInt32(&failing, 1)
+	time.Sleep(5 * time.Millisecond)
+	rate, err := rates.Rate(context.Background(), "EUR", "USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.0723", rate.Rate.FloatString(4))
+	assert.Equal(t, time.Date(2024, 5, 2, 16, 0, 0, 0, time.UTC), rate.AsOf)
+}
+
+func Test_HTTPRateProviderRefreshInBackground(t *testing.T) {
+	var requests, failing int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			<-release
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(ratesJSON))
+	}))
+	defer server.Close()
+	rates := calculatorservice.NewHTTPRateProvider(server.URL, server.Client(), 200*time.Millisecond)
+
+	_, err := rates.Rate(context.Background(), "EUR", "USD")
+	assert.Nil(t, err)
+	atomic.StoreInt32(&failing, 1)
+	time.Sleep(250 * time.Millisecond)
+
+	// the stale rates are served without waiting for the hanging refresh
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		rate, err := rates.Rate(ctx, "EUR", "USD")
+		cancel()
+		if assert.Nil(t, err) {
+			assert.Equal(t, "1.0723", rate.Rate.FloatString(4))
+		}
+	}
+	close(release)
+	time.Sleep(20 * time.Millisecond)
+
+	// and the failed refresh is not retried straight away
+	for i := 0; i < 5; i++ {
+		_, err := rates.Rate(context.Background(), "EUR", "USD")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func Test_ConvertCurrencyErrors(t *testing.T) {
+	rates, err := calculatorservice.NewFileRateProvider(writeRates(t, "rates.json", ratesJSON))
+	assert.Nil(t, err)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRateProvider(rates))
+	tests := []struct {
+		name          string
+		request       *calculatorpb.ConvertCurrencyRequest
+		expectedError error
+	}{
+		{"NotADecimal", &calculatorpb.ConvertCurrencyRequest{Amounts: []*calculatorpb.Money{{Amount: "1e3", Currency: "EUR"}}, TargetCurrency: "USD"}, calculatorservice.ErrInvalidArgument},
+		{"BadCurrency", &calculatorpb.ConvertCurrencyRequest{Amounts: []*calculatorpb.Money{{Amount: "1", Currency: "EURO"}}, TargetCurrency: "USD"}, calculatorservice.ErrInvalidArgument},
+		{"NoAmounts", &calculatorpb.ConvertCurrencyRequest{TargetCurrency: "USD"}, calculatorservice.ErrInvalidArgument},
+		{"UnknownRate", &calculatorpb.ConvertCurrencyRequest{Amounts: []*calculatorpb.Money{{Amount: "1", Currency: "CHF"}}, TargetCurrency: "USD"}, calculatorservice.ErrRateUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.ConvertCurrency(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, tt.expectedError), "unexpected error %v", err)
+		})
+	}
+
+	_, err = calculatorservice.NewFileRateProvider(writeRates(t, "rates.csv", "base,quote,rate\nEUR,USD,1.07\n"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,348 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+
+	// the IANA time zone database, for hosts and containers without one
+	_ "time/tzdata"
+)
+
+var dateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+}
+
+var localDateTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+var isoDurationPattern = regexp.MustCompile(`^([-+])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:[.,](\d{1,9}))?S)?)?$`)
+
+// isoDuration is an ISO 8601 duration, its years, months and days are
+// calendar units and clock is elapsed time
+type isoDuration struct {
+	negative bool
+	years    int
+	months   int
+	days     int
+	clock    time.Duration
+}
+
+// DateCalculator adds durations and business days to dates, takes the
+// difference of two dates and converts times between time zones
+func (c *Calculator) DateCalculator(ctx context.Context, req *calculatorpb.DateCalculateRequest) (*calculatorpb.DateCalculateResponse, error) {
+	loc, err := loadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	t, dateOnly, err := parseISOTime(req.Time, loc, req.TimeZone != "")
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := c.holidayCalendar(req.Calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Operator {
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, calculatorpb.DATE_OPERATOR_DATE_OPERATOR_SUBTRACT:
+		d, err := parseISODuration(req.Duration)
+		if err != nil {
+			return nil, err
+		}
+		if req.Operator == calculatorpb.DATE_OPERATOR_DATE_OPERATOR_SUBTRACT {
+			d.negative = !d.negative
+		}
+		return dateResponse(d.addTo(t), dateOnly)
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE:
+		other, _, err := parseISOTime(req.Other, loc, req.TimeZone != "")
+		if err != nil {
+			return nil, err
+		}
+		return &calculatorpb.DateCalculateResponse{
+			Duration: durationBetween(t, other).String(),
+			Days:     daysBetween(t, other),
+			Seconds:  float64(other.Unix()-t.Unix()) + float64(other.Nanosecond()-t.Nanosecond())/1e9,
+		}, nil
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD_BUSINESS_DAYS:
+		step := 1
+		if req.Days < 0 {
+			step = -1
+		}
+		for left, i := int(req.Days), 1; left != 0; i++ {
+			t = t.AddDate(0, 0, step)
+			if err := checkYear(t); err != nil {
+				return nil, err
+			}
+			if calendar.isBusinessDay(t) {
+				left -= step
+			}
+			if i%1024 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return dateResponse(t, dateOnly)
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_BUSINESS_DAYS:
+		other, _, err := parseISOTime(req.Other, loc, req.TimeZone != "")
+		if err != nil {
+			return nil, err
+		}
+		other = other.In(t.Location())
+		from, to, sign := t, other, int64(1)
+		if daysBetween(from, to) < 0 {
+			from, to, sign = other, t, -1
+		}
+		var count int64
+		for days := daysBetween(from, to); days > 0; days-- {
+			from = from.AddDate(0, 0, 1)
+			if calendar.isBusinessDay(from) {
+				count++
+			}
+			if days%1024 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &calculatorpb.DateCalculateResponse{Days: sign * count}, nil
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY:
+		return &calculatorpb.DateCalculateResponse{BusinessDay: calendar.isBusinessDay(t)}, nil
+	case calculatorpb.DATE_OPERATOR_DATE_OPERATOR_CONVERT_TIME_ZONE:
+		if req.TargetTimeZone == "" {
+			return nil, invalidArgumentf("target time zone is not supplied")
+		}
+		target, err := loadTimeZone(req.TargetTimeZone)
+		if err != nil {
+			return nil, err
+		}
+		if dateOnly {
+			return nil, invalidArgumentf("%s is a date, only a date-time can be converted to another time zone", req.Time)
+		}
+		return dateResponse(t.In(target), false)
+	default:
+		return nil, invalidArgumentf("date operator is not supplied")
+	}
+}
+
+func (c *Calculator) holidayCalendar(name string) (*holidayCalendar, error) {
+	if name == "" {
+		return defaultCalendar, nil
+	}
+	calendar, ok := c.calendars[strings.ToUpper(name)]
+	if !ok {
+		return nil, invalidArgumentf("unknown holiday calendar %q", name)
+	}
+	return calendar, nil
+}
+
+func loadTimeZone(name string) (*time.Location, error) {
+	switch name {
+	case "":
+		return time.UTC, nil
+	case "Local":
+		// that would depend on the host
+		return nil, invalidArgumentf("unknown time zone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, invalidArgumentf("unknown time zone %q", name)
+	}
+	return loc, nil
+}
+
+// parseISOTime reads an ISO 8601 date or date-time, and reports whether it is
+// a date. Dates and date-times without an offset are in loc, date-times with
+// an offset are moved to loc when inZone is set.
+func parseISOTime(s string, loc *time.Location, inZone bool) (time.Time, bool, error) {
+	if s == "" {
+		return time.Time{}, false, invalidArgumentf("time is not supplied")
+	}
+	if t, err := time.ParseInLocation(isoDate, s, loc); err == nil {
+		return t, true, nil
+	}
+	for _, layout := range localDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			if inZone {
+				t = t.In(loc)
+			}
+			return t, false, nil
+		}
+	}
+	return time.Time{}, false, invalidArgumentf("%q is not an ISO 8601 date or date-time", s)
+}
+
+func dateResponse(t time.Time, dateOnly bool) (*calculatorpb.DateCalculateResponse, error) {
+	if err := checkYear(t); err != nil {
+		return nil, err
+	}
+	if dateOnly {
+		return &calculatorpb.DateCalculateResponse{Time: t.Format(isoDate)}, nil
+	}
+	return &calculatorpb.DateCalculateResponse{Time: t.Format(time.RFC3339Nano)}, nil
+}
+
+// checkYear keeps times within the four-digit years of ISO 8601
+func checkYear(t time.Time) error {
+	if t.Year() < 0 || t.Year() > 9999 {
+		return fmt.Errorf("%w: the year %d is outside of 0000 to 9999", ErrOverflow, t.Year())
+	}
+	return nil
+}
+
+func parseISODuration(s string) (isoDuration, error) {
+	m := isoDurationPattern.FindStringSubmatch(s)
+	// P and PT alone are not durations
+	if m == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return isoDuration{}, invalidArgumentf("%q is not an ISO 8601 duration such as P1Y2M10DT2H30M", s)
+	}
+	field := func(i int) (int64, bool) {
+		if m[i] == "" {
+			return 0, true
+		}
+		n, err := strconv.ParseInt(m[i], 10, 64)
+		// more than enough for the years 0000 to 9999
+		return n, err == nil && n <= 1e9
+	}
+	var parts [7]int64
+	for i := range parts {
+		n, ok := field(i + 2)
+		if !ok {
+			return isoDuration{}, fmt.Errorf("%w: duration %s is too long", ErrOverflow, s)
+		}
+		parts[i] = n
+	}
+	fraction := int64(0)
+	if m[9] != "" {
+		fraction, _ = strconv.ParseInt(m[9]+strings.Repeat("0", 9-len(m[9])), 10, 64)
+	}
+	// each of the hours, minutes and seconds is kept below a quarter of the
+	// range of time.Duration, so that their sum can not overflow
+	if parts[4] > math.MaxInt64/4/int64(time.Hour) || parts[5] > math.MaxInt64/4/int64(time.Minute) ||
+		parts[6] > math.MaxInt64/4/int64(time.Second) {
+		return isoDuration{}, fmt.Errorf("%w: duration %s is too long", ErrOverflow, s)
+	}
+	clock := parts[4]*int64(time.Hour) + parts[5]*int64(time.Minute) + parts[6]*int64(time.Second) + fraction
+	return isoDuration{
+		negative: m[1] == "-",
+		years:    int(parts[0]),
+		months:   int(parts[1]),
+		days:     int(parts[2]*7 + parts[3]),
+		clock:    time.Duration(clock),
+	}, nil
+}
+
+// addTo adds the years and months clamped to the end of the month, then the
+// days and then the elapsed time
+func (d isoDuration) addTo(t time.Time) time.Time {
+	sign := 1
+	if d.negative {
+		sign = -1
+	}
+	t = addMonths(t, sign*(12*d.years+d.months))
+	t = t.AddDate(0, 0, sign*d.days)
+	return t.Add(time.Duration(sign) * d.clock)
+}
+
+func (d isoDuration) String() string {
+	var b strings.Builder
+	if d.negative {
+		b.WriteString("-")
+	}
+	b.WriteString("P")
+	for _, part := range []struct {
+		n    int
+		unit string
+	}{{d.years, "Y"}, {d.months, "M"}, {d.days, "D"}} {
+		if part.n != 0 {
+			fmt.Fprintf(&b, "%d%s", part.n, part.unit)
+		}
+	}
+	if d.clock == 0 {
+		if b.Len() == len("P") || (d.negative && b.Len() == len("-P")) {
+			return "PT0S"
+		}
+		return b.String()
+	}
+	b.WriteString("T")
+	hours, minutes := d.clock/time.Hour, d.clock%time.Hour/time.Minute
+	seconds := d.clock % time.Minute
+	if hours != 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes != 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if seconds%time.Second != 0 {
+		fmt.Fprintf(&b, "%sS", strings.TrimRight(fmt.Sprintf("%d.%09d", seconds/time.Second, seconds%time.Second), "0"))
+	} else if seconds != 0 {
+		fmt.Fprintf(&b, "%dS", seconds/time.Second)
+	}
+	return b.String()
+}
+
+// addMonths moves t by n calendar months, to the end of the month when the
+// day does not exist in it
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	// the day before the first of the month after the target month
+	last := time.Date(y, m+time.Month(n)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if d > last {
+		d = last
+	}
+	return time.Date(y, m+time.Month(n), d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// durationBetween is the calendar duration from the earlier to the later of
+// a and b, so that adding it to the earlier one gives the later one
+func durationBetween(a, b time.Time) isoDuration {
+	negative := b.Before(a)
+	if negative {
+		a, b = b, a
+	}
+	b = b.In(a.Location())
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	if addMonths(a, months).After(b) {
+		months--
+	}
+	t := addMonths(a, months)
+	days := int(daysBetween(t, b))
+	if t.AddDate(0, 0, days).After(b) {
+		days--
+	}
+	t = t.AddDate(0, 0, days)
+	return isoDuration{
+		negative: negative,
+		years:    months / 12,
+		months:   months % 12,
+		days:     days,
+		clock:    b.Sub(t),
+	}
+}
+
+// daysBetween counts the calendar days from the date of a to the date of b,
+// in the time zone of a
+func daysBetween(a, b time.Time) int64 {
+	b = b.In(a.Location())
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	from := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	to := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	// in seconds, time.Duration only spans 292 years
+	return (to.Unix() - from.Unix()) / 86400
+}
@@ -0,0 +1,232 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+const calendarYAML = `# a few German public holidays
+holidays:
+  - 2024-05-09
+  - 2024-05-20
+  - --12-25
+  - --12-26
+`
+
+const calendarJSON = `{"name": "GULF", "weekend": ["Fri", "saturday"], "holidays": []}`
+
+func holidayCalendars(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func Test_DateCalculator(t *testing.T) {
+	dir := holidayCalendars(t, map[string]string{"de.yaml": calendarYAML, "gulf.json": calendarJSON, "README": "not a calendar"})
+	calculatorSvc, err := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithHolidayCalendars(dir))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name                string
+		request             *calculatorpb.DateCalculateRequest
+		expectedTime        string
+		expectedDuration    string
+		expectedDays        int64
+		expectedSeconds     float64
+		expectedBusinessDay bool
+	}{
+		{
+			name:         "AddMonthClampedToLeapDay",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-01-31", Duration: "P1M"},
+			expectedTime: "2024-02-29",
+		},
+		{
+			name:         "AddMonthsClampedToMonthEnd",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-11-30", Duration: "P3M"},
+			expectedTime: "2025-02-28",
+		},
+		{
+			name:         "SubtractMonth",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_SUBTRACT, Time: "2024-03-31", Duration: "P1M"},
+			expectedTime: "2024-02-29",
+		},
+		{
+			name:         "AddNegativeDuration",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-05-02T10:00:00Z", Duration: "-P1W2DT1H30M"},
+			expectedTime: "2024-04-23T08:30:00Z",
+		},
+		{
+			name: "AddDayAcrossDaylightSaving",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-03-30T12:00:00", Duration: "P1D", TimeZone: "Europe/Berlin",
+			},
+			expectedTime: "2024-03-31T12:00:00+02:00",
+		},
+		{
+			name: "AddHoursAcrossDaylightSaving",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-03-30T12:00:00", Duration: "PT24H", TimeZone: "Europe/Berlin",
+			},
+			expectedTime: "2024-03-31T13:00:00+02:00",
+		},
+		{
+			name:             "DaysBetween",
+			request:          &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE, Time: "2024-01-15", Other: "2024-03-20"},
+			expectedDuration: "P2M5D",
+			expectedDays:     65,
+			expectedSeconds:  65 * 86400,
+		},
+		{
+			name:             "DaysBefore",
+			request:          &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE, Time: "2024-03-20", Other: "2024-01-15"},
+			expectedDuration: "-P2M5D",
+			expectedDays:     -65,
+			expectedSeconds:  -65 * 86400,
+		},
+		{
+			name: "DifferenceOfDateTimes",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE, Time: "2020-02-29T10:00:00Z", Other: "2024-03-01T11:30:00+02:00",
+			},
+			expectedDuration: "P4YT23H30M",
+			expectedDays:     1462,
+			expectedSeconds:  1461*86400 + 23.5*3600,
+		},
+		{
+			name: "FractionalSeconds",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE, Time: "2024-01-01T00:00:00Z", Other: "2024-01-01T00:00:01.5Z",
+			},
+			expectedDuration: "PT1.5S",
+			expectedSeconds:  1.5,
+		},
+		{
+			name:             "NoDifference",
+			request:          &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_DIFFERENCE, Time: "2024-01-01", Other: "2024-01-01"},
+			expectedDuration: "PT0S",
+		},
+		{
+			name:         "AddBusinessDays",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD_BUSINESS_DAYS, Time: "2024-05-02", Days: 30},
+			expectedTime: "2024-06-13",
+		},
+		{
+			name:         "AddBusinessDaysWithHolidays",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD_BUSINESS_DAYS, Time: "2024-05-02", Days: 30, Calendar: "de"},
+			expectedTime: "2024-06-17",
+		},
+		{
+			name:         "SubtractBusinessDay",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD_BUSINESS_DAYS, Time: "2024-05-06T17:00:00Z", Days: -1},
+			expectedTime: "2024-05-03T17:00:00Z",
+		},
+		{
+			name:         "BusinessDays",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_BUSINESS_DAYS, Time: "2024-05-02", Other: "2024-06-13"},
+			expectedDays: 30,
+		},
+		{
+			name:         "BusinessDaysBackwardsWithHolidays",
+			request:      &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_BUSINESS_DAYS, Time: "2024-06-13", Other: "2024-05-02", Calendar: "DE"},
+			expectedDays: -28,
+		},
+		{
+			name:                "Weekday",
+			request:             &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2024-12-24", Calendar: "de"},
+			expectedBusinessDay: true,
+		},
+		{
+			name:    "AnnualHoliday",
+			request: &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2031-12-25", Calendar: "de"},
+		},
+		{
+			name:    "CustomWeekend",
+			request: &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2024-05-03", Calendar: "gulf"},
+		},
+		{
+			name:                "SundayInCustomWeekend",
+			request:             &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2024-05-05", Calendar: "gulf"},
+			expectedBusinessDay: true,
+		},
+		{
+			name:                "BusinessDayInTimeZone",
+			request:             &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2024-05-04T23:30:00-04:00", TimeZone: "Asia/Tokyo"},
+			expectedBusinessDay: false,
+		},
+		{
+			name: "ConvertTimeZone",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_CONVERT_TIME_ZONE, Time: "2024-05-02T09:30:00+02:00", TargetTimeZone: "America/New_York",
+			},
+			expectedTime: "2024-05-02T03:30:00-04:00",
+		},
+		{
+			name: "ConvertLocalTime",
+			request: &calculatorpb.DateCalculateRequest{
+				Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_CONVERT_TIME_ZONE, Time: "2024-01-01T09:00", TimeZone: "Asia/Tokyo", TargetTimeZone: "UTC",
+			},
+			expectedTime: "2024-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.DateCalculator(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedTime, res.Time)
+			assert.Equal(t, tt.expectedDuration, res.Duration)
+			assert.Equal(t, tt.expectedDays, res.Days)
+			assert.InDelta(t, tt.expectedSeconds, res.Seconds, 1e-9)
+			assert.Equal(t, tt.expectedBusinessDay, res.BusinessDay)
+		})
+	}
+}
+
+func Test_DateCalculatorErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name          string
+		request       *calculatorpb.DateCalculateRequest
+		expectedError error
+	}{
+		{"NotADate", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "02/05/2024", Duration: "P1D"}, calculatorservice.ErrInvalidArgument},
+		{"EmptyDuration", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-05-02", Duration: "PT"}, calculatorservice.ErrInvalidArgument},
+		{"NotADuration", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-05-02", Duration: "1 day"}, calculatorservice.ErrInvalidArgument},
+		{"UnknownTimeZone", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-05-02", Duration: "P1D", TimeZone: "Mars/Olympus"}, calculatorservice.ErrInvalidArgument},
+		{"UnknownCalendar", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_IS_BUSINESS_DAY, Time: "2024-05-02", Calendar: "de"}, calculatorservice.ErrInvalidArgument},
+		{"ConvertDate", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_CONVERT_TIME_ZONE, Time: "2024-05-02", TargetTimeZone: "UTC"}, calculatorservice.ErrInvalidArgument},
+		{"PastYear9999", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "9999-12-31", Duration: "P1D"}, calculatorservice.ErrOverflow},
+		{"TooManyHours", &calculatorpb.DateCalculateRequest{Operator: calculatorpb.DATE_OPERATOR_DATE_OPERATOR_ADD, Time: "2024-05-02", Duration: "PT999999999H"}, calculatorservice.ErrOverflow},
+		{"MissingOperator", &calculatorpb.DateCalculateRequest{Time: "2024-05-02"}, calculatorservice.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.DateCalculator(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, tt.expectedError), "unexpected error %v", err)
+		})
+	}
+}
+
+func Test_HolidayCalendarFiles(t *testing.T) {
+	for name, files := range map[string]map[string]string{
+		"NoWorkingDays": {"never.yaml": "weekend: [mon, tue, wed, thu, fri, sat, sun]"},
+		"BadHoliday":    {"bad.yaml": "holidays: [2024-13-01]"},
+		"Duplicate":     {"a.yaml": "name: x", "b.json": `{"name": "X"}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithHolidayCalendars(holidayCalendars(t, files)))
+			assert.NotNil(t, err)
+		})
+	}
+}
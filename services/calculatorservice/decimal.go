@@ -0,0 +1,92 @@
+package calculatorservice
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+var (
+	decimalPattern  = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// currencyMinorUnits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit
+var currencyMinorUnits = map[string]int{
+	"BHD": 3, "BIF": 0, "CLF": 4, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 3,
+	"ISK": 0, "JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3, "LYD": 3,
+	"OMR": 3, "PYG": 0, "RWF": 0, "TND": 3, "UGX": 0, "UYI": 0, "UYW": 4,
+	"VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// minorUnit is the number of decimal places of a currency
+func minorUnit(currency string) int {
+	if places, ok := currencyMinorUnits[currency]; ok {
+		return places
+	}
+	return 2
+}
+
+func parseCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !currencyPattern.MatchString(code) {
+		return "", invalidArgumentf("%q is not an ISO 4217 currency code", code)
+	}
+	return code, nil
+}
+
+// parseDecimal reads a plain decimal such as "-19.99" exactly, it doesn't
+// accept exponents or fractions like big.Rat does
+func parseDecimal(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if !decimalPattern.MatchString(s) {
+		return nil, invalidArgumentf("%q is not a decimal number", s)
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return r, nil
+}
+
+// roundDecimal rounds x to the given number of decimal places
+func roundDecimal(x *big.Rat, places int, mode calculatorpb.ROUNDING) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(x, new(big.Rat).SetInt(scale))
+
+	// q is truncated towards zero, r has the sign of the numerator
+	q, r := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if r.Sign() != 0 {
+		// compare twice the remainder to the denominator to find ties
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		cmp := half.Cmp(scaled.Denom())
+
+		away := false
+		switch mode {
+		case calculatorpb.ROUNDING_ROUNDING_HALF_UP:
+			away = cmp >= 0
+		case calculatorpb.ROUNDING_ROUNDING_HALF_DOWN:
+			away = cmp > 0
+		case calculatorpb.ROUNDING_ROUNDING_DOWN:
+		case calculatorpb.ROUNDING_ROUNDING_UP:
+			away = true
+		case calculatorpb.ROUNDING_ROUNDING_CEILING:
+			away = r.Sign() > 0
+		case calculatorpb.ROUNDING_ROUNDING_FLOOR:
+			away = r.Sign() < 0
+		default:
+			away = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+		}
+		if away {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	}
+	return new(big.Rat).SetFrac(q, scale)
+}
+
+// formatDecimal writes x, which must have at most places decimals, with
+// exactly places decimals
+func formatDecimal(x *big.Rat, places int) string {
+	return x.FloatString(places)
+}
@@ -0,0 +1,185 @@
+package calculatorservice
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxDiceTerms = 50
+	maxDice      = 1000
+	maxDieSides  = 1000000
+	maxConstant  = 1 << 40
+)
+
+type diceKeep int
+
+const (
+	keepAll diceKeep = iota
+	keepHighest
+	keepLowest
+	dropHighest
+	dropLowest
+)
+
+// diceTerm is a group of dice such as "4d6kh3" or a constant, count is zero for constants
+type diceTerm struct {
+	notation string
+	sign     int64
+	count    int
+	sides    int64
+	constant int64
+	keep     diceKeep
+	keepN    int
+}
+
+// parseDice parses dice notation: terms of "NdS" dice or integers joined by + and -,
+// where dice may end with a kh, kl, dh or dl modifier and d% is a d100
+func parseDice(notation string) ([]diceTerm, error) {
+	s := strings.ToLower(strings.TrimSpace(notation))
+	if s == "" {
+		return nil, invalidArgumentf("dice notation is not supplied")
+	}
+
+	var terms []diceTerm
+	pos := 0
+	for pos < len(s) {
+		term := diceTerm{sign: 1}
+		switch s[pos] {
+		case '+':
+			pos++
+		case '-':
+			term.sign = -1
+			pos++
+		default:
+			if len(terms) > 0 {
+				return nil, invalidArgumentf("expected + or - at position %d of %q", pos, notation)
+			}
+		}
+		pos = skipDiceSpaces(s, pos)
+		if pos == len(s) {
+			return nil, invalidArgumentf("dice notation %q ends with an operator", notation)
+		}
+		start := pos
+
+		number, next, hasNumber := readDiceNumber(s, pos)
+		pos = next
+		if pos < len(s) && s[pos] == 'd' {
+			pos++
+			term.count = 1
+			if hasNumber {
+				if number < 1 || number > maxDice {
+					return nil, invalidArgumentf("number of dice %d must be within [1, %d]", number, maxDice)
+				}
+				term.count = int(number)
+			}
+			if pos < len(s) && s[pos] == '%' {
+				term.sides = 100
+				pos++
+			} else {
+				term.sides, pos, hasNumber = readDiceNumber(s, pos)
+				if !hasNumber || term.sides < 1 || term.sides > maxDieSides {
+					return nil, invalidArgumentf("dice at position %d need between 1 and %d sides", start, maxDieSides)
+				}
+			}
+
+			if pos+1 < len(s) && (s[pos] == 'k' || s[pos] == 'd') && (s[pos+1] == 'h' || s[pos+1] == 'l') {
+				term.keep = map[string]diceKeep{"kh": keepHighest, "kl": keepLowest, "dh": dropHighest, "dl": dropLowest}[s[pos:pos+2]]
+				keepN, next, hasNumber := readDiceNumber(s, pos+2)
+				if !hasNumber || keepN > int64(term.count) {
+					return nil, invalidArgumentf("%s at position %d needs a count of at most %d dice", s[pos:pos+2], pos, term.count)
+				}
+				term.keepN = int(keepN)
+				pos = next
+			}
+		} else {
+			if !hasNumber {
+				return nil, invalidArgumentf("unexpected %q at position %d of %q", s[pos:pos+1], pos, notation)
+			}
+			if number > maxConstant {
+				return nil, invalidArgumentf("constant %d is larger than %d", number, int64(maxConstant))
+			}
+			term.constant = number
+		}
+
+		term.notation = s[start:pos]
+		terms = append(terms, term)
+		pos = skipDiceSpaces(s, pos)
+		if len(terms) > maxDiceTerms {
+			return nil, invalidArgumentf("dice notation has more than %d terms", maxDiceTerms)
+		}
+	}
+	return terms, nil
+}
+
+func skipDiceSpaces(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t') {
+		pos++
+	}
+	return pos
+}
+
+// readDiceNumber reads the decimal number at pos, if there is one
+func readDiceNumber(s string, pos int) (number int64, next int, ok bool) {
+	next = pos
+	for next < len(s) && s[next] >= '0' && s[next] <= '9' {
+		next++
+	}
+	if next == pos || next-pos > 12 {
+		return 0, next, false
+	}
+	number, _ = strconv.ParseInt(s[pos:next], 10, 64)
+	return number, next, true
+}
+
+func (t diceTerm) roll(rng *rand.Rand) *calculatorpb.DiceTerm {
+	if t.count == 0 {
+		return &calculatorpb.DiceTerm{
+			Notation: t.notation,
+			Value:    t.sign * t.constant,
+		}
+	}
+
+	rolls := make([]int64, t.count)
+	for i := range rolls {
+		rolls[i] = rng.Int63n(t.sides) + 1
+	}
+
+	// order the dice from lowest to highest to decide which ones are kept
+	order := make([]int, len(rolls))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return rolls[order[i]] < rolls[order[j]] })
+	kept := make([]bool, len(rolls))
+	lo, hi := 0, len(rolls)
+	switch t.keep {
+	case keepHighest:
+		lo = len(rolls) - t.keepN
+	case keepLowest:
+		hi = t.keepN
+	case dropHighest:
+		hi = len(rolls) - t.keepN
+	case dropLowest:
+		lo = t.keepN
+	}
+	for _, i := range order[lo:hi] {
+		kept[i] = true
+	}
+
+	term := &calculatorpb.DiceTerm{
+		Notation: t.notation,
+		Rolls:    rolls,
+	}
+	for i, roll := range rolls {
+		if kept[i] {
+			term.Kept = append(term.Kept, roll)
+			term.Value += t.sign * roll
+		}
+	}
+	return term
+}
@@ -0,0 +1,411 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// distribution is a univariate probability distribution, pdf is the probability
+// mass function for discrete distributions
+type distribution interface {
+	pdf(x float64) float64
+	cdf(x float64) float64
+	quantile(p float64) float64
+	sample(rng *rand.Rand) float64
+}
+
+// Distribution evaluates the PDF, CDF or quantile function of a distribution at each of the requested points
+func (c *Calculator) Distribution(ctx context.Context, req *calculatorpb.DistributionRequest) (*calculatorpb.DistributionResponse, error) {
+	dist, err := newDistribution(req.Distribution, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var f func(float64) float64
+	switch req.Function {
+	case calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_PDF:
+		f = dist.pdf
+	case calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_CDF:
+		f = dist.cdf
+	case calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_QUANTILE:
+		f = dist.quantile
+	default:
+		return nil, invalidArgumentf("distribution function is not supplied")
+	}
+
+	values := make([]float64, len(req.Points))
+	for i, x := range req.Points {
+		if math.IsNaN(x) {
+			return nil, invalidArgumentf("point %d is not a number", i)
+		}
+		if req.Function == calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_QUANTILE && (x < 0 || x > 1) {
+			return nil, invalidArgumentf("probability %v must be within [0, 1]", x)
+		}
+		values[i] = f(x)
+	}
+	return &calculatorpb.DistributionResponse{
+		Values: values,
+	}, nil
+}
+
+// distributionParameters reads named parameters, falling back to defaults and
+// rejecting names the distribution does not take
+type distributionParameters struct {
+	values map[string]float64
+	seen   map[string]bool
+	err    error
+}
+
+func (p *distributionParameters) get(name string, def float64, valid func(float64) bool, requirement string) float64 {
+	if p.err != nil {
+		return 0
+	}
+	v, ok := p.values[name]
+	p.seen[name] = true
+	if !ok {
+		if math.IsNaN(def) {
+			p.err = invalidArgumentf("parameter %s is required", name)
+		}
+		return def
+	}
+	if math.IsNaN(v) || !valid(v) {
+		p.err = invalidArgumentf("parameter %s is %v but %s", name, v, requirement)
+	}
+	return v
+}
+
+func (p *distributionParameters) check() error {
+	if p.err != nil {
+		return p.err
+	}
+	var unknown []string
+	for name := range p.values {
+		if !p.seen[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return invalidArgumentf("unknown parameters %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// paramRequired marks a parameter without a default
+var paramRequired = math.NaN()
+
+func isFinite(v float64) bool      { return !math.IsInf(v, 0) }
+func isPositive(v float64) bool    { return v > 0 && !math.IsInf(v, 0) }
+func isProbability(v float64) bool { return v >= 0 && v <= 1 }
+func isCount(v float64) bool       { return v >= 0 && v == math.Trunc(v) && v <= 1<<53 }
+
+func newDistribution(kind calculatorpb.DISTRIBUTION, parameters map[string]float64) (distribution, error) {
+	p := &distributionParameters{values: parameters, seen: map[string]bool{}}
+	var dist distribution
+	switch kind {
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL:
+		dist = normalDist{
+			mu:    p.get("mu", 0, isFinite, "must be finite"),
+			sigma: p.get("sigma", 1, isPositive, "must be positive"),
+		}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_STUDENT_T:
+		dist = studentTDist{df: p.get("df", paramRequired, isPositive, "must be positive")}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_CHI_SQUARE:
+		dist = gammaDist{shape: p.get("df", paramRequired, isPositive, "must be positive") / 2, scale: 2}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL:
+		dist = binomialDist{
+			n: p.get("n", paramRequired, isCount, "must be a non negative integer"),
+			p: p.get("p", paramRequired, isProbability, "must be within [0, 1]"),
+		}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON:
+		dist = poissonDist{lambda: p.get("lambda", paramRequired, isPositive, "must be positive")}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_EXPONENTIAL:
+		dist = exponentialDist{rate: p.get("rate", 1, isPositive, "must be positive")}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_UNIFORM:
+		a := p.get("a", 0, isFinite, "must be finite")
+		b := p.get("b", 1, isFinite, "must be finite")
+		if p.err == nil && a >= b {
+			p.err = invalidArgumentf("parameter a is %v but must be less than b (%v)", a, b)
+		}
+		dist = uniformDist{a: a, b: b}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_GAMMA:
+		dist = gammaDist{
+			shape: p.get("shape", paramRequired, isPositive, "must be positive"),
+			scale: p.get("scale", 1, isPositive, "must be positive"),
+		}
+	case calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA:
+		dist = betaDist{
+			alpha: p.get("alpha", paramRequired, isPositive, "must be positive"),
+			beta:  p.get("beta", paramRequired, isPositive, "must be positive"),
+		}
+	default:
+		return nil, invalidArgumentf("distribution is not supplied")
+	}
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return dist, nil
+}
+
+type normalDist struct {
+	mu, sigma float64
+}
+
+func (d normalDist) pdf(x float64) float64 {
+	z := (x - d.mu) / d.sigma
+	return math.Exp(-z*z/2) / (d.sigma * math.Sqrt(2*math.Pi))
+}
+
+func (d normalDist) cdf(x float64) float64 {
+	return normalCDF((x - d.mu) / d.sigma)
+}
+
+func (d normalDist) quantile(p float64) float64 {
+	return d.mu + d.sigma*normalQuantile(p)
+}
+
+func (d normalDist) sample(rng *rand.Rand) float64 {
+	return d.mu + d.sigma*rng.NormFloat64()
+}
+
+type studentTDist struct {
+	df float64
+}
+
+func (d studentTDist) pdf(x float64) float64 {
+	v := d.df
+	return math.Exp(lgamma((v+1)/2)-lgamma(v/2)-(v+1)/2*math.Log1p(x*x/v)) / math.Sqrt(v*math.Pi)
+}
+
+func (d studentTDist) cdf(x float64) float64 {
+	return studentTCDF(x, d.df)
+}
+
+func (d studentTDist) quantile(p float64) float64 {
+	return studentTQuantile(p, d.df)
+}
+
+// sample is a standard normal over the root of a chi-square with df degrees
+// of freedom divided by df
+func (d studentTDist) sample(rng *rand.Rand) float64 {
+	return rng.NormFloat64() * math.Exp(-(logGammaSample(rng, d.df/2)+math.Log(2/d.df))/2)
+}
+
+type gammaDist struct {
+	shape, scale float64
+}
+
+func (d gammaDist) pdf(x float64) float64 {
+	switch {
+	case x < 0:
+		return 0
+	case x == 0:
+		if d.shape < 1 {
+			return math.Inf(1)
+		}
+		if d.shape == 1 {
+			return 1 / d.scale
+		}
+		return 0
+	}
+	return math.Exp((d.shape-1)*math.Log(x) - x/d.scale - lgamma(d.shape) - d.shape*math.Log(d.scale))
+}
+
+func (d gammaDist) cdf(x float64) float64 {
+	return regIncGammaLower(d.shape, x/d.scale)
+}
+
+func (d gammaDist) quantile(p float64) float64 {
+	return invertCDF(d.cdf, p, 0, math.Inf(1))
+}
+
+func (d gammaDist) sample(rng *rand.Rand) float64 {
+	return d.scale * math.Exp(logGammaSample(rng, d.shape))
+}
+
+type exponentialDist struct {
+	rate float64
+}
+
+func (d exponentialDist) pdf(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return d.rate * math.Exp(-d.rate*x)
+}
+
+func (d exponentialDist) cdf(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-d.rate * x)
+}
+
+func (d exponentialDist) quantile(p float64) float64 {
+	return -math.Log1p(-p) / d.rate
+}
+
+func (d exponentialDist) sample(rng *rand.Rand) float64 {
+	return rng.ExpFloat64() / d.rate
+}
+
+type uniformDist struct {
+	a, b float64
+}
+
+func (d uniformDist) pdf(x float64) float64 {
+	if x < d.a || x > d.b {
+		return 0
+	}
+	return 1 / (d.b - d.a)
+}
+
+func (d uniformDist) cdf(x float64) float64 {
+	return math.Max(0, math.Min(1, (x-d.a)/(d.b-d.a)))
+}
+
+func (d uniformDist) quantile(p float64) float64 {
+	return d.a + p*(d.b-d.a)
+}
+
+func (d uniformDist) sample(rng *rand.Rand) float64 {
+	return d.quantile(rng.Float64())
+}
+
+type betaDist struct {
+	alpha, beta float64
+}
+
+func (d betaDist) pdf(x float64) float64 {
+	switch {
+	case x < 0 || x > 1:
+		return 0
+	case x == 0 && d.alpha < 1, x == 1 && d.beta < 1:
+		return math.Inf(1)
+	case x == 0 && d.alpha > 1, x == 1 && d.beta > 1:
+		return 0
+	}
+	lbeta := lgamma(d.alpha) + lgamma(d.beta) - lgamma(d.alpha+d.beta)
+	return math.Exp((d.alpha-1)*math.Log(x) + (d.beta-1)*math.Log1p(-x) - lbeta)
+}
+
+func (d betaDist) cdf(x float64) float64 {
+	return regIncBeta(d.alpha, d.beta, x)
+}
+
+func (d betaDist) quantile(p float64) float64 {
+	return invertCDF(d.cdf, p, 0, 1)
+}
+
+// sample is X/(X+Y) for X and Y gamma distributed with shapes alpha and beta,
+// taken in logs so that it holds when both underflow
+func (d betaDist) sample(rng *rand.Rand) float64 {
+	x, y := logGammaSample(rng, d.alpha), logGammaSample(rng, d.beta)
+	return 1 / (1 + math.Exp(y-x))
+}
+
+type binomialDist struct {
+	n, p float64
+}
+
+func (d binomialDist) pdf(x float64) float64 {
+	if x < 0 || x > d.n || x != math.Trunc(x) {
+		return 0
+	}
+	switch d.p {
+	case 0:
+		if x == 0 {
+			return 1
+		}
+		return 0
+	case 1:
+		if x == d.n {
+			return 1
+		}
+		return 0
+	}
+	lchoose := lgamma(d.n+1) - lgamma(x+1) - lgamma(d.n-x+1)
+	return math.Exp(lchoose + x*math.Log(d.p) + (d.n-x)*math.Log1p(-d.p))
+}
+
+func (d binomialDist) cdf(x float64) float64 {
+	k := math.Floor(x)
+	switch {
+	case k < 0:
+		return 0
+	case k >= d.n:
+		return 1
+	}
+	return regIncBeta(d.n-k, k+1, 1-d.p)
+}
+
+func (d binomialDist) quantile(p float64) float64 {
+	return discreteQuantile(d.cdf, p, d.n)
+}
+
+func (d binomialDist) sample(rng *rand.Rand) float64 {
+	if d.p > 0.5 {
+		return d.n - binomialSample(rng, d.n, 1-d.p)
+	}
+	return binomialSample(rng, d.n, d.p)
+}
+
+type poissonDist struct {
+	lambda float64
+}
+
+func (d poissonDist) pdf(x float64) float64 {
+	if x < 0 || x != math.Trunc(x) {
+		return 0
+	}
+	return math.Exp(x*math.Log(d.lambda) - d.lambda - lgamma(x+1))
+}
+
+func (d poissonDist) cdf(x float64) float64 {
+	k := math.Floor(x)
+	if k < 0 {
+		return 0
+	}
+	return regIncGammaUpper(k+1, d.lambda)
+}
+
+func (d poissonDist) quantile(p float64) float64 {
+	return discreteQuantile(d.cdf, p, math.Inf(1))
+}
+
+func (d poissonDist) sample(rng *rand.Rand) float64 {
+	return poissonSample(rng, d.lambda)
+}
+
+// discreteQuantile returns the smallest integer k in [0, max] with cdf(k) >= p
+func discreteQuantile(cdf func(float64) float64, p, max float64) float64 {
+	if p >= 1 {
+		return max
+	}
+	if cdf(0) >= p {
+		return 0
+	}
+	lo, hi := 0.0, 1.0
+	for cdf(hi) < p {
+		lo = hi
+		hi *= 2
+		if hi >= max {
+			hi = max
+			break
+		}
+	}
+	for hi-lo > 1 {
+		mid := math.Floor(lo + (hi-lo)/2)
+		if cdf(mid) >= p {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
@@ -0,0 +1,97 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	pdf      = calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_PDF
+	cdf      = calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_CDF
+	quantile = calculatorpb.DISTRIBUTION_FUNCTION_DISTRIBUTION_FUNCTION_QUANTILE
+)
+
+func Test_Distribution(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name           string
+		distribution   calculatorpb.DISTRIBUTION
+		function       calculatorpb.DISTRIBUTION_FUNCTION
+		parameters     map[string]float64
+		points         []float64
+		expectedValues []float64
+	}{
+		{"NormalPDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, pdf, nil, []float64{0}, []float64{0.3989423}},
+		{"NormalCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, cdf, nil, []float64{1.96}, []float64{0.9750021}},
+		{"NormalQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, quantile, map[string]float64{"mu": 10, "sigma": 2}, []float64{0.975}, []float64{13.919928}},
+		{"StudentTQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_STUDENT_T, quantile, map[string]float64{"df": 10}, []float64{0.975}, []float64{2.228139}},
+		{"ChiSquareCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_CHI_SQUARE, cdf, map[string]float64{"df": 3}, []float64{7.814728}, []float64{0.95}},
+		{"BinomialPMF", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, pdf, map[string]float64{"n": 10, "p": 0.5}, []float64{5, 2.5}, []float64{0.2460938, 0}},
+		{"BinomialCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, cdf, map[string]float64{"n": 10, "p": 0.5}, []float64{5}, []float64{0.6230469}},
+		{"BinomialQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, quantile, map[string]float64{"n": 10, "p": 0.5}, []float64{0.5, 1}, []float64{5, 10}},
+		{"PoissonPMF", calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON, pdf, map[string]float64{"lambda": 3}, []float64{2}, []float64{0.2240418}},
+		{"PoissonCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON, cdf, map[string]float64{"lambda": 3}, []float64{2}, []float64{0.4231901}},
+		{"PoissonQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON, quantile, map[string]float64{"lambda": 3}, []float64{0.5}, []float64{3}},
+		{"ExponentialCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_EXPONENTIAL, cdf, map[string]float64{"rate": 2}, []float64{1, -1}, []float64{0.8646647, 0}},
+		{"ExponentialQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_EXPONENTIAL, quantile, map[string]float64{"rate": 2}, []float64{0.5}, []float64{0.3465736}},
+		{"UniformPDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_UNIFORM, pdf, map[string]float64{"a": 2, "b": 6}, []float64{3, 7}, []float64{0.25, 0}},
+		{"UniformQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_UNIFORM, quantile, map[string]float64{"a": 2, "b": 6}, []float64{0.5}, []float64{4}},
+		{"GammaPDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_GAMMA, pdf, map[string]float64{"shape": 2, "scale": 3}, []float64{2}, []float64{0.1140927}},
+		{"GammaCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_GAMMA, cdf, map[string]float64{"shape": 2, "scale": 3}, []float64{2}, []float64{0.1443048}},
+		{"GammaQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_GAMMA, quantile, map[string]float64{"shape": 2, "scale": 3}, []float64{0.1443048}, []float64{2}},
+		{"BetaPDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA, pdf, map[string]float64{"alpha": 2, "beta": 5}, []float64{0.3}, []float64{2.1609}},
+		{"BetaCDF", calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA, cdf, map[string]float64{"alpha": 2, "beta": 5}, []float64{0.3}, []float64{0.579825}},
+		{"BetaQuantile", calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA, quantile, map[string]float64{"alpha": 2, "beta": 5}, []float64{0.579825}, []float64{0.3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Distribution(context.Background(), &calculatorpb.DistributionRequest{
+				Distribution: tt.distribution,
+				Function:     tt.function,
+				Parameters:   tt.parameters,
+				Points:       tt.points,
+			})
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expectedValues, res.Values, 1e-6)
+		})
+	}
+}
+
+func Test_DistributionInvalidParameters(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name         string
+		distribution calculatorpb.DISTRIBUTION
+		function     calculatorpb.DISTRIBUTION_FUNCTION
+		parameters   map[string]float64
+		points       []float64
+	}{
+		{"NegativeSigma", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, pdf, map[string]float64{"sigma": -1}, []float64{0}},
+		{"MissingDegreesOfFreedom", calculatorpb.DISTRIBUTION_DISTRIBUTION_STUDENT_T, cdf, nil, []float64{0}},
+		{"UnknownParameter", calculatorpb.DISTRIBUTION_DISTRIBUTION_EXPONENTIAL, cdf, map[string]float64{"lambda": 1}, []float64{0}},
+		{"FractionalTrials", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, cdf, map[string]float64{"n": 2.5, "p": 0.5}, []float64{0}},
+		{"EmptyUniform", calculatorpb.DISTRIBUTION_DISTRIBUTION_UNIFORM, cdf, map[string]float64{"a": 1, "b": 1}, []float64{0}},
+		{"ProbabilityAboveOne", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, quantile, nil, []float64{2}},
+		{"MissingFunction", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, calculatorpb.DISTRIBUTION_FUNCTION_DEFAULT_DISTRIBUTION_FUNCTION, nil, []float64{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Distribution(context.Background(), &calculatorpb.DistributionRequest{
+				Distribution: tt.distribution,
+				Function:     tt.function,
+				Parameters:   tt.parameters,
+				Points:       tt.points,
+			})
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package calculatorservice
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidArgument is wrapped by the errors returned for invalid requests
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrOverflow is wrapped by the errors returned when a checked result is out of range
+	ErrOverflow = errors.New("overflow")
+	// ErrRateUnavailable is wrapped by the errors returned when no exchange rate can be found
+	ErrRateUnavailable = errors.New("exchange rate unavailable")
+	// ErrBudgetExceeded is wrapped by the errors returned when a request needs
+	// more evaluations than its compute budget
+	ErrBudgetExceeded = errors.New("compute budget exceeded")
+	// ErrNotConverged is wrapped by the errors returned when a numerical method
+	// fails to converge for a valid request
+	ErrNotConverged = errors.New("method did not converge")
+)
+
+// invalidArgumentf formats a validation error that wraps ErrInvalidArgument
+func invalidArgumentf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidArgument, fmt.Sprintf(format, args...))
+}
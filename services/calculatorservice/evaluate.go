@@ -0,0 +1,177 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// dual is a value with its partial derivatives with respect to the variables
+// of a gradient, a dual number with one infinitesimal part per variable
+type dual struct {
+	value    float64
+	partials []float64
+}
+
+// EvaluateExpression computes an expression at values of its variables and,
+// with the gradient flag, its partial derivatives there
+func (c *Calculator) EvaluateExpression(ctx context.Context, req *calculatorpb.EvaluateExpressionRequest) (*calculatorpb.EvaluateExpressionResponse, error) {
+	e, err := parseExpression(req.Expression)
+	if err != nil {
+		return nil, err
+	}
+	for name := range req.Values {
+		if _, ok := exprConstants[name]; ok {
+			return nil, invalidArgumentf("%s is a constant and can not be given a value", name)
+		}
+	}
+
+	if !req.Gradient {
+		if len(req.WithRespectTo) > 0 {
+			return nil, invalidArgumentf("with_respect_to requires the gradient flag")
+		}
+		value, err := evalExpr(e, req.Values)
+		if err != nil {
+			return nil, err
+		}
+		return &calculatorpb.EvaluateExpressionResponse{Value: value}, nil
+	}
+
+	variables := req.WithRespectTo
+	if len(variables) == 0 {
+		vars := map[string]bool{}
+		exprVariables(e, vars)
+		for name := range vars {
+			variables = append(variables, name)
+		}
+		sort.Strings(variables)
+	}
+	index := make(map[string]int, len(variables))
+	for i, name := range variables {
+		if _, ok := exprConstants[name]; ok {
+			return nil, invalidArgumentf("can not differentiate with respect to the constant %s", name)
+		}
+		if _, ok := index[name]; ok {
+			return nil, invalidArgumentf("variable %s is given twice", name)
+		}
+		if _, ok := req.Values[name]; !ok {
+			return nil, invalidArgumentf("variable %s has no value", name)
+		}
+		index[name] = i
+	}
+
+	d, err := evalDual(e, req.Values, index)
+	if err != nil {
+		return nil, err
+	}
+	res := &calculatorpb.EvaluateExpressionResponse{Value: d.value, Variables: variables}
+	res.Gradient = d.partials
+	if res.Gradient == nil {
+		res.Gradient = make([]float64, len(variables))
+	}
+	return res, nil
+}
+
+// evalDual computes e with its partial derivatives with respect to the
+// variables of index, in the order of their indexes. Constants and the
+// variables not in index have no partials, which stand for all zeros.
+func evalDual(e expr, values map[string]float64, index map[string]int) (dual, error) {
+	switch e := e.(type) {
+	case *numberNode:
+		return dual{value: e.value}, nil
+	case *variableNode:
+		if v, ok := exprConstants[e.name]; ok {
+			return dual{value: v}, nil
+		}
+		v, ok := values[e.name]
+		if !ok {
+			return dual{}, invalidArgumentf("variable %s has no value", e.name)
+		}
+		d := dual{value: v}
+		if i, ok := index[e.name]; ok {
+			d.partials = make([]float64, len(index))
+			d.partials[i] = 1
+		}
+		return d, nil
+	case *negNode:
+		x, err := evalDual(e.x, values, index)
+		return x.scale(-x.value, -1), err
+	case *callNode:
+		x, err := evalDual(e.arg, values, index)
+		if err != nil {
+			return dual{}, err
+		}
+		f := exprFunctions[e.fn]
+		if x.partials == nil {
+			return dual{value: f.eval(x.value)}, nil
+		}
+		return x.scale(f.eval(x.value), f.slope(x.value)), nil
+	case *binaryNode:
+		l, err := evalDual(e.left, values, index)
+		if err != nil {
+			return dual{}, err
+		}
+		r, err := evalDual(e.right, values, index)
+		if err != nil {
+			return dual{}, err
+		}
+		return applyDual(e.op, l, r), nil
+	}
+	panic(fmt.Sprintf("unknown expression node %T", e))
+}
+
+// scale is a dual of value with the partials of d multiplied by slope, the
+// chain rule for a function of d
+func (d dual) scale(value, slope float64) dual {
+	res := dual{value: value}
+	if d.partials != nil {
+		res.partials = make([]float64, len(d.partials))
+		for i, p := range d.partials {
+			res.partials[i] = p * slope
+		}
+	}
+	return res
+}
+
+// applyDual applies a binary operator to duals, the partials of the result
+// are a*l' + b*r' for the partials a and b of the operator at l and r
+func applyDual(op byte, l, r dual) dual {
+	value := applyBinary(op, l.value, r.value)
+	var a, b float64
+	switch op {
+	case '+':
+		a, b = 1, 1
+	case '-':
+		a, b = 1, -1
+	case '*':
+		a, b = r.value, l.value
+	case '/':
+		a, b = 1/r.value, -l.value/(r.value*r.value)
+	default:
+		// only the terms of the operands with partials, so that x^2 at 0 and
+		// a constant base of a variable exponent avoid ln(0) and 0*Inf
+		if l.partials != nil {
+			a = r.value * math.Pow(l.value, r.value-1)
+		}
+		if r.partials != nil {
+			b = value * math.Log(l.value)
+		}
+	}
+	if l.partials == nil && r.partials == nil {
+		return dual{value: value}
+	}
+	if r.partials == nil {
+		return l.scale(value, a)
+	}
+	if l.partials == nil {
+		return r.scale(value, b)
+	}
+	res := dual{value: value, partials: make([]float64, len(l.partials))}
+	for i := range res.partials {
+		res.partials[i] = a*l.partials[i] + b*r.partials[i]
+	}
+	return res
+}
@@ -0,0 +1,150 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EvaluateExpression(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name              string
+		request           *calculatorpb.EvaluateExpressionRequest
+		expectedValue     float64
+		expectedGradient  []float64
+		expectedVariables []string
+	}{
+		{
+			name:          "ValueOnly",
+			request:       &calculatorpb.EvaluateExpressionRequest{Expression: "x^2*y", Values: map[string]float64{"x": 3, "y": 2}},
+			expectedValue: 18,
+		},
+		{
+			name:              "AllVariables",
+			request:           &calculatorpb.EvaluateExpressionRequest{Expression: "x^2*y", Values: map[string]float64{"x": 3, "y": 2}, Gradient: true},
+			expectedValue:     18,
+			expectedGradient:  []float64{12, 9},
+			expectedVariables: []string{"x", "y"},
+		},
+		{
+			name: "ChosenVariables",
+			request: &calculatorpb.EvaluateExpressionRequest{
+				Expression: "x^2*y", Values: map[string]float64{"x": 3, "y": 2}, Gradient: true, WithRespectTo: []string{"y"},
+			},
+			expectedValue:     18,
+			expectedGradient:  []float64{9},
+			expectedVariables: []string{"y"},
+		},
+		{
+			name: "VariableNotInExpression",
+			request: &calculatorpb.EvaluateExpressionRequest{
+				Expression: "2x", Values: map[string]float64{"x": 1, "z": 5}, Gradient: true, WithRespectTo: []string{"z", "x"},
+			},
+			expectedValue:     2,
+			expectedGradient:  []float64{0, 2},
+			expectedVariables: []string{"z", "x"},
+		},
+		{
+			name:              "Constant",
+			request:           &calculatorpb.EvaluateExpressionRequest{Expression: "2pi", Gradient: true},
+			expectedValue:     2 * math.Pi,
+			expectedGradient:  []float64{},
+			expectedVariables: nil,
+		},
+		{
+			name:              "PowerAtZero",
+			request:           &calculatorpb.EvaluateExpressionRequest{Expression: "x^2 + 2^x", Values: map[string]float64{"x": 0}, Gradient: true},
+			expectedValue:     1,
+			expectedGradient:  []float64{math.Ln2},
+			expectedVariables: []string{"x"},
+		},
+		{
+			name:              "VariableExponent",
+			request:           &calculatorpb.EvaluateExpressionRequest{Expression: "x^y", Values: map[string]float64{"x": 2, "y": 3}, Gradient: true},
+			expectedValue:     8,
+			expectedGradient:  []float64{12, 8 * math.Ln2},
+			expectedVariables: []string{"x", "y"},
+		},
+		{
+			name:              "Functions",
+			request:           &calculatorpb.EvaluateExpressionRequest{Expression: "sin(x)*exp(y) - ln(x)", Values: map[string]float64{"x": 1, "y": 0}, Gradient: true},
+			expectedValue:     math.Sin(1),
+			expectedGradient:  []float64{math.Cos(1) - 1, math.Sin(1)},
+			expectedVariables: []string{"x", "y"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.EvaluateExpression(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.expectedValue, res.Value, 1e-12)
+			assert.Equal(t, tt.expectedVariables, res.Variables)
+			assert.Len(t, res.Gradient, len(tt.expectedGradient))
+			for i, g := range tt.expectedGradient {
+				assert.InDelta(t, g, res.Gradient[i], 1e-12)
+			}
+		})
+	}
+}
+
+func Test_GradientAgreesWithDifferenceQuotient(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// a price with a volume discount, tax and a rate compounded over years
+	const formula = "q*p*(1 - d*tanh(q/100))*(1 + tax)*(1 + r)^n/sqrt(1 + abs(p - 20)^1.5)"
+	values := map[string]float64{"q": 120, "p": 24.5, "d": 0.15, "tax": 0.2, "r": 0.03, "n": 2.5}
+
+	res, err := calculatorSvc.EvaluateExpression(context.Background(), &calculatorpb.EvaluateExpressionRequest{
+		Expression: formula, Values: values, Gradient: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"d", "n", "p", "q", "r", "tax"}, res.Variables)
+
+	value := func(name string, x float64) float64 {
+		shifted := map[string]float64{}
+		for k, v := range values {
+			shifted[k] = v
+		}
+		shifted[name] = x
+		res, err := calculatorSvc.EvaluateExpression(context.Background(), &calculatorpb.EvaluateExpressionRequest{Expression: formula, Values: shifted})
+		assert.Nil(t, err)
+		return res.Value
+	}
+	for i, name := range res.Variables {
+		h := 1e-6 * math.Max(1, math.Abs(values[name]))
+		expected := (value(name, values[name]+h) - value(name, values[name]-h)) / (2 * h)
+		assert.InDelta(t, expected, res.Gradient[i], 1e-5*(1+math.Abs(expected)), name)
+	}
+}
+
+func Test_EvaluateExpressionErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.EvaluateExpressionRequest
+	}{
+		{"Empty", &calculatorpb.EvaluateExpressionRequest{}},
+		{"MissingValue", &calculatorpb.EvaluateExpressionRequest{Expression: "x + y", Values: map[string]float64{"x": 1}}},
+		{"MissingValueWithGradient", &calculatorpb.EvaluateExpressionRequest{Expression: "x + y", Values: map[string]float64{"x": 1}, Gradient: true}},
+		{"ConstantValue", &calculatorpb.EvaluateExpressionRequest{Expression: "pi", Values: map[string]float64{"pi": 3}}},
+		{"ConstantVariable", &calculatorpb.EvaluateExpressionRequest{Expression: "e^x", Values: map[string]float64{"x": 1}, Gradient: true, WithRespectTo: []string{"e"}}},
+		{"DuplicateVariable", &calculatorpb.EvaluateExpressionRequest{Expression: "x", Values: map[string]float64{"x": 1}, Gradient: true, WithRespectTo: []string{"x", "x"}}},
+		{"NoValueForVariable", &calculatorpb.EvaluateExpressionRequest{Expression: "x", Values: map[string]float64{"x": 1}, Gradient: true, WithRespectTo: []string{"y"}}},
+		{"WithoutGradient", &calculatorpb.EvaluateExpressionRequest{Expression: "x", Values: map[string]float64{"x": 1}, WithRespectTo: []string{"x"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.EvaluateExpression(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
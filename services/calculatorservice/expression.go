@@ -0,0 +1,483 @@
+package calculatorservice
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxExpressionLength = 4096
+	maxExpressionDepth  = 100
+)
+
+// precedences of the nodes of an expression, for printing with the least
+// parentheses
+const (
+	precedenceSum = iota + 1
+	precedenceProduct
+	precedenceNeg
+	precedencePower
+	precedenceAtom
+)
+
+// expr is a node of the syntax tree of an expression
+type expr interface {
+	String() string
+	precedence() int
+}
+
+type numberNode struct {
+	value float64
+}
+
+// variableNode is a variable or one of the constants pi and e
+type variableNode struct {
+	name string
+}
+
+type negNode struct {
+	x expr
+}
+
+// binaryNode is one of + - * / ^
+type binaryNode struct {
+	op          byte
+	left, right expr
+}
+
+type callNode struct {
+	fn  string
+	arg expr
+}
+
+// exprConstants are the variables that can not be given a value
+var exprConstants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// exprFunction is a function of one argument, slope is the value of its
+// derivative and derivative its derivative at u as an expression
+type exprFunction struct {
+	eval       func(float64) float64
+	slope      func(float64) float64
+	derivative func(u expr) expr
+}
+
+var exprFunctions map[string]exprFunction
+
+func init() {
+	one := &numberNode{1}
+	square := func(u expr) expr { return &binaryNode{'^', u, &numberNode{2}} }
+	exprFunctions = map[string]exprFunction{
+		"sin": {math.Sin, math.Cos, func(u expr) expr { return &callNode{"cos", u} }},
+		"cos": {math.Cos, func(x float64) float64 { return -math.Sin(x) },
+			func(u expr) expr { return &negNode{&callNode{"sin", u}} }},
+		"tan": {math.Tan, func(x float64) float64 { return 1 / (math.Cos(x) * math.Cos(x)) },
+			func(u expr) expr { return &binaryNode{'/', one, square(&callNode{"cos", u})} }},
+		"asin": {math.Asin, func(x float64) float64 { return 1 / math.Sqrt(1-x*x) },
+			func(u expr) expr { return &binaryNode{'/', one, &callNode{"sqrt", &binaryNode{'-', one, square(u)}}} }},
+		"acos": {math.Acos, func(x float64) float64 { return -1 / math.Sqrt(1-x*x) },
+			func(u expr) expr {
+				return &negNode{&binaryNode{'/', one, &callNode{"sqrt", &binaryNode{'-', one, square(u)}}}}
+			}},
+		"atan": {math.Atan, func(x float64) float64 { return 1 / (1 + x*x) },
+			func(u expr) expr { return &binaryNode{'/', one, &binaryNode{'+', one, square(u)}} }},
+		"sinh": {math.Sinh, math.Cosh, func(u expr) expr { return &callNode{"cosh", u} }},
+		"cosh": {math.Cosh, math.Sinh, func(u expr) expr { return &callNode{"sinh", u} }},
+		"tanh": {math.Tanh, func(x float64) float64 { return 1 / (math.Cosh(x) * math.Cosh(x)) },
+			func(u expr) expr { return &binaryNode{'/', one, square(&callNode{"cosh", u})} }},
+		"exp": {math.Exp, math.Exp, func(u expr) expr { return &callNode{"exp", u} }},
+		"ln": {math.Log, func(x float64) float64 { return 1 / x },
+			func(u expr) expr { return &binaryNode{'/', one, u} }},
+		"log": {math.Log10, func(x float64) float64 { return 1 / (x * math.Ln10) },
+			func(u expr) expr { return &binaryNode{'/', one, &binaryNode{'*', u, &callNode{"ln", &numberNode{10}}}} }},
+		"sqrt": {math.Sqrt, func(x float64) float64 { return 0.5 / math.Sqrt(x) },
+			func(u expr) expr {
+				return &binaryNode{'/', one, &binaryNode{'*', &numberNode{2}, &callNode{"sqrt", u}}}
+			}},
+		// the sign of u, everywhere but at 0
+		"abs": {math.Abs, func(x float64) float64 { return x / math.Abs(x) },
+			func(u expr) expr { return &binaryNode{'/', u, &callNode{"abs", u}} }},
+	}
+}
+
+func (n *numberNode) String() string {
+	return strconv.FormatFloat(n.value, 'g', -1, 64)
+}
+
+func (n *numberNode) precedence() int {
+	if n.value < 0 || math.Signbit(n.value) {
+		return precedenceNeg
+	}
+	return precedenceAtom
+}
+
+func (v *variableNode) String() string  { return v.name }
+func (v *variableNode) precedence() int { return precedenceAtom }
+
+func (n *negNode) String() string {
+	return "-" + parenthesize(n.x, n.x.precedence() < precedenceNeg)
+}
+
+func (n *negNode) precedence() int { return precedenceNeg }
+
+func (b *binaryNode) String() string {
+	p := b.precedence()
+	switch b.op {
+	case '^':
+		// right associative
+		return parenthesize(b.left, b.left.precedence() <= p) + "^" + parenthesize(b.right, b.right.precedence() < p)
+	case '+', '-':
+		return parenthesize(b.left, b.left.precedence() < p) + " " + string(b.op) + " " +
+			parenthesize(b.right, b.right.precedence() < p || (b.op == '-' && b.right.precedence() == p))
+	default:
+		return parenthesize(b.left, b.left.precedence() < p) + string(b.op) +
+			parenthesize(b.right, b.right.precedence() < p || (b.op == '/' && b.right.precedence() == p))
+	}
+}
+
+func (b *binaryNode) precedence() int {
+	switch b.op {
+	case '+', '-':
+		return precedenceSum
+	case '*', '/':
+		return precedenceProduct
+	default:
+		return precedencePower
+	}
+}
+
+func (c *callNode) String() string  { return c.fn + "(" + c.arg.String() + ")" }
+func (c *callNode) precedence() int { return precedenceAtom }
+
+func parenthesize(e expr, parens bool) string {
+	if parens {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// evalExpr computes e with the values of its variables
+func evalExpr(e expr, values map[string]float64) (float64, error) {
+	switch e := e.(type) {
+	case *numberNode:
+		return e.value, nil
+	case *variableNode:
+		if v, ok := exprConstants[e.name]; ok {
+			return v, nil
+		}
+		v, ok := values[e.name]
+		if !ok {
+			return 0, invalidArgumentf("variable %s has no value", e.name)
+		}
+		return v, nil
+	case *negNode:
+		x, err := evalExpr(e.x, values)
+		return -x, err
+	case *callNode:
+		x, err := evalExpr(e.arg, values)
+		return exprFunctions[e.fn].eval(x), err
+	case *binaryNode:
+		l, err := evalExpr(e.left, values)
+		if err != nil {
+			return 0, err
+		}
+		r, err := evalExpr(e.right, values)
+		if err != nil {
+			return 0, err
+		}
+		return applyBinary(e.op, l, r), nil
+	}
+	panic(fmt.Sprintf("unknown expression node %T", e))
+}
+
+func applyBinary(op byte, l, r float64) float64 {
+	switch op {
+	case '+':
+		return l + r
+	case '-':
+		return l - r
+	case '*':
+		return l * r
+	case '/':
+		return l / r
+	default:
+		return math.Pow(l, r)
+	}
+}
+
+// exprVariables adds the variables of e, but not the constants, to vars
+func exprVariables(e expr, vars map[string]bool) {
+	switch e := e.(type) {
+	case *variableNode:
+		if _, ok := exprConstants[e.name]; !ok {
+			vars[e.name] = true
+		}
+	case *negNode:
+		exprVariables(e.x, vars)
+	case *callNode:
+		exprVariables(e.arg, vars)
+	case *binaryNode:
+		exprVariables(e.left, vars)
+		exprVariables(e.right, vars)
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+// exprParser is a recursive descent parser of
+//
+//	sum     = product {("+" | "-") product}
+//	product = unary {("*" | "/") unary}
+//	unary   = "-" unary | "+" unary | power
+//	power   = atom ["^" unary]
+//	atom    = number [power] | ident | ident "(" sum ")" | "(" sum ")"
+//
+// where a power right after a number is multiplied by it, so that 2x^2 is
+// 2*x^2
+type exprParser struct {
+	input  string
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// parseExpression reads an infix expression into its syntax tree
+func parseExpression(input string) (expr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, invalidArgumentf("expression is not supplied")
+	}
+	if len(input) > maxExpressionLength {
+		return nil, invalidArgumentf("expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{input: input, tokens: tokens}
+	e, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEnd {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return e, nil
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			j := i
+			for j < len(input) && (input[j] >= '0' && input[j] <= '9' || input[j] == '.') {
+				j++
+			}
+			// an exponent only when digits follow, so that 2e is 2*e
+			if j < len(input) && (input[j] == 'e' || input[j] == 'E') {
+				k := j + 1
+				if k < len(input) && (input[k] == '+' || input[k] == '-') {
+					k++
+				}
+				if k < len(input) && input[k] >= '0' && input[k] <= '9' {
+					for k < len(input) && input[k] >= '0' && input[k] <= '9' {
+						k++
+					}
+					j = k
+				}
+			}
+			v, err := strconv.ParseFloat(input[i:j], 64)
+			if err != nil {
+				return nil, invalidArgumentf("expression %q has an invalid number %q at %d", input, input[i:j], i+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[i:j], value: v, pos: i})
+			i = j
+		case isIdentByte(input[i], false):
+			j := i
+			for j < len(input) && isIdentByte(input[j], true) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: input[i:j], pos: i})
+			i = j
+		case strings.IndexByte("+-*/^()", c) >= 0:
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		default:
+			return nil, invalidArgumentf("expression %q has an invalid character at %d", input, i+1)
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, text: "end of expression", pos: len(input)}), nil
+}
+
+// isIdentByte tells whether b is an ASCII letter or underscore, or a digit
+// when digits are allowed
+func isIdentByte(b byte, digits bool) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (digits && b >= '0' && b <= '9')
+}
+
+func (p *exprParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEnd {
+		p.pos++
+	}
+	return t
+}
+
+func (p *exprParser) isOperator(text string) bool {
+	t := p.peek()
+	return t.kind == tokenOperator && t.text == text
+}
+
+func (p *exprParser) errorf(t token, format string, args ...interface{}) error {
+	return invalidArgumentf("expression %q: %s at %d", p.input, fmt.Sprintf(format, args...), t.pos+1)
+}
+
+func (p *exprParser) enter() error {
+	p.depth++
+	if p.depth > maxExpressionDepth {
+		return p.errorf(p.peek(), "nesting deeper than %d", maxExpressionDepth)
+	}
+	return nil
+}
+
+func (p *exprParser) sum() (expr, error) {
+	left, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+") || p.isOperator("-") {
+		op := p.next().text[0]
+		right, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op, left, right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) product() (expr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*") || p.isOperator("/") {
+		op := p.next().text[0]
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op, left, right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) unary() (expr, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	if p.isOperator("-") || p.isOperator("+") {
+		minus := p.next().text == "-"
+		x, err := p.unary()
+		if err != nil || !minus {
+			return x, err
+		}
+		return &negNode{x}, nil
+	}
+	return p.power()
+}
+
+func (p *exprParser) power() (expr, error) {
+	base, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	p.next()
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryNode{'^', base, exponent}, nil
+}
+
+func (p *exprParser) atom() (expr, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokenNumber:
+		n := &numberNode{t.value}
+		// implicit multiplication binds like ^, so that 2x^2 is 2*(x^2)
+		if next := p.peek(); next.kind == tokenIdent || (next.kind == tokenOperator && next.text == "(") {
+			x, err := p.power()
+			if err != nil {
+				return nil, err
+			}
+			return &binaryNode{'*', n, x}, nil
+		}
+		return n, nil
+	case t.kind == tokenIdent:
+		if p.isOperator("(") {
+			if _, ok := exprFunctions[t.text]; !ok {
+				return nil, p.errorf(t, "unknown function %s", t.text)
+			}
+			p.next()
+			arg, err := p.parenthesized()
+			if err != nil {
+				return nil, err
+			}
+			return &callNode{t.text, arg}, nil
+		}
+		if _, ok := exprFunctions[t.text]; ok {
+			return nil, p.errorf(t, "function %s is missing its argument", t.text)
+		}
+		return &variableNode{t.text}, nil
+	case t.kind == tokenOperator && t.text == "(":
+		return p.parenthesized()
+	default:
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+}
+
+// parenthesized reads the rest of a parenthesis, after its (
+func (p *exprParser) parenthesized() (expr, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	e, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.kind != tokenOperator || t.text != ")" {
+		return nil, p.errorf(t, "expected ) instead of %q", t.text)
+	}
+	return e, nil
+}
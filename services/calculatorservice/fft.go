@@ -0,0 +1,158 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"math/cmplx"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// maxSignalSamples bounds the samples of the signal processing requests
+const maxSignalSamples = 1 << 20
+
+// FourierTransform computes the discrete Fourier transform of samples
+func (c *Calculator) FourierTransform(ctx context.Context, req *calculatorpb.FourierTransformRequest) (*calculatorpb.FourierTransformResponse, error) {
+	if err := finiteValues("samples", req.Samples); err != nil {
+		return nil, err
+	}
+	var x []complex128
+	if req.Complex {
+		if len(req.Samples)%2 != 0 {
+			return nil, invalidArgumentf("complex samples need pairs of values, not %d values", len(req.Samples))
+		}
+		x = make([]complex128, len(req.Samples)/2)
+		for i := range x {
+			x[i] = complex(req.Samples[2*i], req.Samples[2*i+1])
+		}
+	} else {
+		x = make([]complex128, len(req.Samples))
+		for i, v := range req.Samples {
+			x[i] = complex(v, 0)
+		}
+	}
+	if err := signalLength(len(x)); err != nil {
+		return nil, err
+	}
+	if req.Inverse && req.Window != calculatorpb.WINDOW_WINDOW_RECTANGULAR {
+		return nil, invalidArgumentf("a window applies to the samples of a forward transform")
+	}
+	w, err := window(req.Window, len(x))
+	if err != nil {
+		return nil, err
+	}
+	for i := range x {
+		x[i] *= complex(w[i], 0)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fft(x, req.Inverse)
+	res := &calculatorpb.FourierTransformResponse{Values: make([]float64, 2*len(x))}
+	scale := 1.0
+	if req.Inverse {
+		scale = 1 / float64(len(x))
+	}
+	for i, v := range x {
+		res.Values[2*i], res.Values[2*i+1] = real(v)*scale, imag(v)*scale
+	}
+	return res, nil
+}
+
+// signalLength checks the number of samples of a signal
+func signalLength(n int) error {
+	if n == 0 {
+		return invalidArgumentf("samples are not supplied")
+	}
+	if n > maxSignalSamples {
+		return invalidArgumentf("%d samples are more than the limit of %d", n, maxSignalSamples)
+	}
+	return nil
+}
+
+// fft transforms x in place, the inverse without the scale of 1/n. Lengths
+// of a power of 2 take the radix-2 algorithm, the others Bluestein's, which
+// makes the transform a convolution of a power of 2.
+func fft(x []complex128, inverse bool) {
+	n := len(x)
+	if n <= 1 {
+		return
+	}
+	if n&(n-1) == 0 {
+		radix2(x, inverse)
+		return
+	}
+	bluestein(x, inverse)
+}
+
+// radix2 is the iterative Cooley-Tukey transform of a power of 2
+func radix2(x []complex128, inverse bool) {
+	n := len(x)
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := range x {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	// the twiddles of the largest stage, each computed directly rather than
+	// by repeated multiplication, which loses precision
+	twiddles := make([]complex128, n/2)
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	for k := range twiddles {
+		sin, cos := math.Sincos(sign * 2 * math.Pi * float64(k) / float64(n))
+		twiddles[k] = complex(cos, sin)
+	}
+	for size := 2; size <= n; size *= 2 {
+		half, stride := size/2, n/size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				t := twiddles[k*stride] * x[start+k+half]
+				x[start+k+half] = x[start+k] - t
+				x[start+k] += t
+			}
+		}
+	}
+}
+
+// bluestein is the transform of any length by the identity
+// jk = (j² + k² - (k-j)²)/2, which turns it into the convolution of x with a
+// chirp
+func bluestein(x []complex128, inverse bool) {
+	n := len(x)
+	m := 1 << bits.Len(uint(2*n-2))
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	// the chirp exp(sign i pi k²/n), with k² reduced modulo 2n so that the
+	// angle stays small and exact
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		sin, cos := math.Sincos(sign * math.Pi * float64(k*k%(2*n)) / float64(n))
+		chirp[k] = complex(cos, sin)
+	}
+	a, b := make([]complex128, m), make([]complex128, m)
+	for k := range x {
+		a[k] = x[k] * chirp[k]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+	radix2(a, false)
+	radix2(b, false)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	radix2(a, true)
+	scale := complex(1/float64(m), 0)
+	for k := range x {
+		x[k] = a[k] * scale * chirp[k]
+	}
+}
@@ -0,0 +1,133 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FourierTransform(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.FourierTransformRequest
+		expected []float64
+	}{
+		{
+			name:     "PowerOfTwo",
+			request:  &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2, 3, 4}},
+			expected: []float64{10, 0, -2, 2, -2, 0, -2, -2},
+		},
+		{
+			name:     "Three",
+			request:  &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2, 3}},
+			expected: []float64{6, 0, -1.5, math.Sqrt(3) / 2, -1.5, -math.Sqrt(3) / 2},
+		},
+		{
+			name:     "One",
+			request:  &calculatorpb.FourierTransformRequest{Samples: []float64{1, -1}, Complex: true},
+			expected: []float64{1, -1},
+		},
+		{
+			name:     "Inverse",
+			request:  &calculatorpb.FourierTransformRequest{Samples: []float64{6, 0, -1.5, math.Sqrt(3) / 2, -1.5, -math.Sqrt(3) / 2}, Complex: true, Inverse: true},
+			expected: []float64{1, 0, 2, 0, 3, 0},
+		},
+		{
+			// the Hann window of 3 samples is 0, 1, 0
+			name:     "Window",
+			request:  &calculatorpb.FourierTransformRequest{Samples: []float64{5, 2, 7}, Window: calculatorpb.WINDOW_WINDOW_HANN},
+			expected: []float64{2, 0, -1, -math.Sqrt(3), -1, math.Sqrt(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.FourierTransform(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expected, res.Values, 1e-12)
+		})
+	}
+}
+
+func Test_FourierTransformLengths(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 5, 7, 12, 64, 97, 100, 1000} {
+		samples := make([]float64, 2*n)
+		for i := range samples {
+			samples[i] = r.NormFloat64()
+		}
+		res, err := calculatorSvc.FourierTransform(context.Background(), &calculatorpb.FourierTransformRequest{Samples: samples, Complex: true})
+		assert.Nil(t, err)
+
+		// the transform by its definition
+		for k := 0; k < n; k++ {
+			var sum complex128
+			for j := 0; j < n; j++ {
+				sum += complex(samples[2*j], samples[2*j+1]) * cmplx.Rect(1, -2*math.Pi*float64(j*k%n)/float64(n))
+			}
+			assert.InDelta(t, real(sum), res.Values[2*k], 1e-9, "n %d, k %d", n, k)
+			assert.InDelta(t, imag(sum), res.Values[2*k+1], 1e-9, "n %d, k %d", n, k)
+		}
+
+		// the inverse recovers the samples
+		inverse, err := calculatorSvc.FourierTransform(context.Background(), &calculatorpb.FourierTransformRequest{Samples: res.Values, Complex: true, Inverse: true})
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, samples, inverse.Values, 1e-12)
+	}
+}
+
+func Test_FourierTransformLarge(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// a million samples, not a power of 2, of a cosine of 1234 cycles
+	const n, cycles = 1000000, 1234
+	samples := make([]float64, n)
+	for i := range samples {
+		samples[i] = math.Cos(2 * math.Pi * cycles * float64(i) / n)
+	}
+	res, err := calculatorSvc.FourierTransform(context.Background(), &calculatorpb.FourierTransformRequest{Samples: samples})
+	assert.Nil(t, err)
+	assert.Len(t, res.Values, 2*n)
+	assert.InDelta(t, n/2, res.Values[2*cycles], 1e-6)
+	assert.InDelta(t, n/2, res.Values[2*(n-cycles)], 1e-6)
+	for _, k := range []int{0, 1, cycles - 1, cycles + 1, n / 2} {
+		assert.InDelta(t, 0, cmplx.Abs(complex(res.Values[2*k], res.Values[2*k+1])), 1e-6)
+	}
+}
+
+func Test_FourierTransformErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.FourierTransformRequest
+	}{
+		{"NoSamples", &calculatorpb.FourierTransformRequest{}},
+		{"TooManySamples", &calculatorpb.FourierTransformRequest{Samples: make([]float64, 1<<20+1)}},
+		{"OddComplex", &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2, 3}, Complex: true}},
+		{"NaN", &calculatorpb.FourierTransformRequest{Samples: []float64{1, math.NaN()}}},
+		{"InverseWindow", &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2}, Inverse: true, Window: calculatorpb.WINDOW_WINDOW_HANN}},
+		{"UnknownWindow", &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2}, Window: 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.FourierTransform(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := calculatorSvc.FourierTransform(ctx, &calculatorpb.FourierTransformRequest{Samples: []float64{1, 2}})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
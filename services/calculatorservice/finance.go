@@ -0,0 +1,306 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultRateGuess       = 0.1
+	maxNewtonIterations    = 50
+	maxBisectionIterations = 200
+	rateTolerance          = 1e-12
+	maxAmortizationPeriods = 100000
+	maxDepreciationLife    = 1000
+	isoDate                = "2006-01-02"
+)
+
+// rateBrackets are the rates tried in turn to bracket a root when Newton's method fails
+var rateBrackets = []float64{-0.999999, -0.99, -0.9, -0.5, -0.2, 0, 0.05, 0.1, 0.2, 0.5, 1, 2, 5, 10, 100, 1000}
+
+// TimeValue computes PMT, PV, FV, NPER or RATE with spreadsheet semantics
+func (c *Calculator) TimeValue(ctx context.Context, req *calculatorpb.TimeValueRequest) (*calculatorpb.TimeValueResponse, error) {
+	r, n, pmt, pv, fv := req.Rate, req.Nper, req.Pmt, req.Pv, req.Fv
+	t := paymentTiming(req.Timing)
+	if req.Function != calculatorpb.TIME_VALUE_TIME_VALUE_RATE && r <= -1 {
+		return nil, invalidArgumentf("rate %v must be larger than -1", r)
+	}
+
+	switch req.Function {
+	case calculatorpb.TIME_VALUE_TIME_VALUE_PMT:
+		if n == 0 {
+			return nil, invalidArgumentf("nper must not be 0")
+		}
+		return &calculatorpb.TimeValueResponse{Result: payment(r, n, pv, fv, t)}, nil
+	case calculatorpb.TIME_VALUE_TIME_VALUE_PV:
+		return &calculatorpb.TimeValueResponse{
+			Result: -(fv + pmt*(1+r*t)*annuityFactor(r, n)) / math.Pow(1+r, n),
+		}, nil
+	case calculatorpb.TIME_VALUE_TIME_VALUE_FV:
+		return &calculatorpb.TimeValueResponse{Result: futureValue(r, n, pmt, pv, t)}, nil
+	case calculatorpb.TIME_VALUE_TIME_VALUE_NPER:
+		if r == 0 {
+			if pmt == 0 {
+				return nil, invalidArgumentf("pmt must not be 0 when rate is 0")
+			}
+			return &calculatorpb.TimeValueResponse{Result: -(pv + fv) / pmt}, nil
+		}
+		ratio := (pmt*(1+r*t) - fv*r) / (pmt*(1+r*t) + pv*r)
+		if !(ratio > 0) {
+			return nil, invalidArgumentf("no number of periods takes pv %v to fv %v with pmt %v", pv, fv, pmt)
+		}
+		return &calculatorpb.TimeValueResponse{Result: math.Log(ratio) / math.Log1p(r)}, nil
+	case calculatorpb.TIME_VALUE_TIME_VALUE_RATE:
+		if n <= 0 {
+			return nil, invalidArgumentf("nper %v must be positive", n)
+		}
+		rate, convergence := solveRate(func(r float64) float64 {
+			return pv*math.Pow(1+r, n) + pmt*(1+r*t)*annuityFactor(r, n) + fv
+		}, req.Guess)
+		return &calculatorpb.TimeValueResponse{Result: rate, Convergence: convergence}, nil
+	default:
+		return nil, invalidArgumentf("time value function is not supplied")
+	}
+}
+
+// CashFlow computes the NPV or IRR of regular or dated cash flows
+func (c *Calculator) CashFlow(ctx context.Context, req *calculatorpb.CashFlowRequest) (*calculatorpb.CashFlowResponse, error) {
+	values := req.Values
+	if len(values) == 0 {
+		return nil, invalidArgumentf("at least one value is required")
+	}
+
+	// years are the times of the values, in periods for NPV and IRR and in
+	// years of 365 days since the first date for XNPV and XIRR
+	years := make([]float64, len(values))
+	switch req.Function {
+	case calculatorpb.CASH_FLOW_CASH_FLOW_NPV, calculatorpb.CASH_FLOW_CASH_FLOW_IRR:
+		for i := range years {
+			years[i] = float64(i)
+		}
+		if req.Function == calculatorpb.CASH_FLOW_CASH_FLOW_NPV {
+			// the spreadsheet NPV puts the first value at the end of the first period
+			for i := range years {
+				years[i]++
+			}
+		}
+	case calculatorpb.CASH_FLOW_CASH_FLOW_XNPV, calculatorpb.CASH_FLOW_CASH_FLOW_XIRR:
+		if len(req.Dates) != len(values) {
+			return nil, invalidArgumentf("expected %d dates, got %d", len(values), len(req.Dates))
+		}
+		var first time.Time
+		for i, s := range req.Dates {
+			d, err := time.Parse(isoDate, s)
+			if err != nil {
+				return nil, invalidArgumentf("%q is not an ISO 8601 date", s)
+			}
+			if i == 0 {
+				first = d
+			}
+			if d.Before(first) {
+				return nil, invalidArgumentf("date %s is before the first date %s", s, req.Dates[0])
+			}
+			years[i] = d.Sub(first).Hours() / 24 / 365
+		}
+	default:
+		return nil, invalidArgumentf("cash flow function is not supplied")
+	}
+	npv := func(r float64) float64 {
+		sum := 0.0
+		for i, v := range values {
+			sum += v / math.Pow(1+r, years[i])
+		}
+		return sum
+	}
+
+	switch req.Function {
+	case calculatorpb.CASH_FLOW_CASH_FLOW_NPV, calculatorpb.CASH_FLOW_CASH_FLOW_XNPV:
+		if req.Rate <= -1 {
+			return nil, invalidArgumentf("rate %v must be larger than -1", req.Rate)
+		}
+		return &calculatorpb.CashFlowResponse{Result: npv(req.Rate)}, nil
+	default:
+		positive, negative := false, false
+		for _, v := range values {
+			positive = positive || v > 0
+			negative = negative || v < 0
+		}
+		if !positive || !negative {
+			return nil, invalidArgumentf("the cash flows need at least one positive and one negative value")
+		}
+		rate, convergence := solveRate(npv, req.Guess)
+		return &calculatorpb.CashFlowResponse{Result: rate, Convergence: convergence}, nil
+	}
+}
+
+// Amortization streams the payments of a loan, split into interest and principal, one period at a time
+func (c *Calculator) Amortization(ctx context.Context, req *calculatorpb.AmortizationRequest, send func(*calculatorpb.AmortizationRow) error) error {
+	r, n := req.Rate, req.Nper
+	if n == 0 || n > maxAmortizationPeriods {
+		return invalidArgumentf("nper %d must be within [1, %d]", n, maxAmortizationPeriods)
+	}
+	if r <= -1 {
+		return invalidArgumentf("rate %v must be larger than -1", r)
+	}
+	t := paymentTiming(req.Timing)
+	pmt := payment(r, float64(n), req.Pv, req.Fv, t)
+
+	balance := req.Pv
+	for period := uint32(1); period <= n; period++ {
+		if period%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		// payments in advance pay no interest in the first period
+		interest := -balance * r
+		if t == 1 && period == 1 {
+			interest = 0
+		}
+		principal := pmt - interest
+		balance += principal
+		if err := send(&calculatorpb.AmortizationRow{
+			Period:    period,
+			Payment:   pmt,
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Depreciation computes straight-line and declining balance depreciation schedules
+func (c *Calculator) Depreciation(ctx context.Context, req *calculatorpb.DepreciationRequest) (*calculatorpb.DepreciationResponse, error) {
+	cost, salvage, life := req.Cost, req.Salvage, req.Life
+	if life == 0 || life > maxDepreciationLife {
+		return nil, invalidArgumentf("life %d must be within [1, %d]", life, maxDepreciationLife)
+	}
+	if cost <= 0 || salvage < 0 || salvage > cost {
+		return nil, invalidArgumentf("cost %v must be positive and salvage %v within [0, cost]", cost, salvage)
+	}
+
+	res := &calculatorpb.DepreciationResponse{}
+	accumulated := 0.0
+	add := func(depreciation float64) {
+		accumulated += depreciation
+		res.Rows = append(res.Rows, &calculatorpb.DepreciationRow{
+			Period:       uint32(len(res.Rows) + 1),
+			Depreciation: depreciation,
+			Accumulated:  accumulated,
+			BookValue:    cost - accumulated,
+		})
+	}
+
+	switch req.Method {
+	case calculatorpb.DEPRECIATION_DEPRECIATION_STRAIGHT_LINE:
+		for i := uint32(0); i < life; i++ {
+			add((cost - salvage) / float64(life))
+		}
+	case calculatorpb.DEPRECIATION_DEPRECIATION_DECLINING_BALANCE:
+		month := req.Month
+		if month == 0 {
+			month = 12
+		}
+		if month > 12 {
+			return nil, invalidArgumentf("month %d must be within [1, 12]", month)
+		}
+		rate := math.Round((1-math.Pow(salvage/cost, 1/float64(life)))*1000) / 1000
+		add(cost * rate * float64(month) / 12)
+		for i := uint32(1); i < life; i++ {
+			add((cost - accumulated) * rate)
+		}
+		if month < 12 {
+			add((cost - accumulated) * rate * float64(12-month) / 12)
+		}
+	case calculatorpb.DEPRECIATION_DEPRECIATION_DOUBLE_DECLINING_BALANCE:
+		factor := req.Factor
+		if factor == 0 {
+			factor = 2
+		}
+		if factor < 0 {
+			return nil, invalidArgumentf("factor %v must be positive", factor)
+		}
+		for i := uint32(0); i < life; i++ {
+			add(math.Max(0, math.Min((cost-accumulated)*factor/float64(life), cost-salvage-accumulated)))
+		}
+	default:
+		return nil, invalidArgumentf("depreciation method is not supplied")
+	}
+	return res, nil
+}
+
+func paymentTiming(timing calculatorpb.PAYMENT_TIMING) float64 {
+	if timing == calculatorpb.PAYMENT_TIMING_PAYMENT_TIMING_BEGIN {
+		return 1
+	}
+	return 0
+}
+
+// annuityFactor is ((1+r)^n - 1)/r, which tends to n as r goes to 0
+func annuityFactor(r, n float64) float64 {
+	if r == 0 {
+		return n
+	}
+	return math.Expm1(n*math.Log1p(r)) / r
+}
+
+func payment(r, n, pv, fv, t float64) float64 {
+	return -(fv + pv*math.Pow(1+r, n)) / ((1 + r*t) * annuityFactor(r, n))
+}
+
+func futureValue(r, n, pmt, pv, t float64) float64 {
+	return -(pv*math.Pow(1+r, n) + pmt*(1+r*t)*annuityFactor(r, n))
+}
+
+// solveRate finds a root of f above -1 with Newton's method from guess, and
+// falls back to bisection over a bracketing pair of rateBrackets when Newton's
+// method leaves the domain or doesn't converge
+func solveRate(f func(float64) float64, guess float64) (float64, *calculatorpb.Convergence) {
+	if guess == 0 {
+		guess = defaultRateGuess
+	}
+
+	r := guess
+	for i := 1; i <= maxNewtonIterations; i++ {
+		fr := f(r)
+		// a central difference is accurate enough for the derivative and
+		// saves spelling it out for every function
+		h := 1e-6 * (1 + math.Abs(r))
+		step := fr / ((f(r+h) - f(r-h)) / (2 * h))
+		r -= step
+		if math.IsNaN(r) || math.IsInf(r, 0) || r <= -1 {
+			break
+		}
+		if math.Abs(step) <= rateTolerance*(1+math.Abs(r)) {
+			return r, &calculatorpb.Convergence{Converged: true, Iterations: uint32(i), Residual: f(r), Method: "newton", Error: math.Abs(step)}
+		}
+	}
+
+	for i := 1; i < len(rateBrackets); i++ {
+		lo, hi := rateBrackets[i-1], rateBrackets[i]
+		flo, fhi := f(lo), f(hi)
+		if math.Signbit(flo) == math.Signbit(fhi) {
+			continue
+		}
+		iterations := 0
+		for ; iterations < maxBisectionIterations && hi-lo > rateTolerance*(1+math.Abs(lo)); iterations++ {
+			mid := lo + (hi-lo)/2
+			if fmid := f(mid); math.Signbit(fmid) == math.Signbit(flo) {
+				lo, flo = mid, fmid
+			} else {
+				hi = mid
+			}
+		}
+		r = lo + (hi-lo)/2
+		return r, &calculatorpb.Convergence{
+			Converged: true, Iterations: uint32(iterations), Residual: f(r), Method: "bisection", Error: (hi - lo) / 2,
+		}
+	}
+	return math.NaN(), &calculatorpb.Convergence{Iterations: maxNewtonIterations, Residual: math.NaN(), Method: "newton", Error: math.NaN()}
+}
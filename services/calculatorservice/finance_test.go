@@ -0,0 +1,179 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TimeValue(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.TimeValueRequest
+		expected float64
+	}{
+		{"PMT", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_PMT, Rate: 0.08 / 12, Nper: 10, Pv: 10000}, -1037.0320893591636},
+		{"PMTSavings", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_PMT, Rate: 0.06 / 12, Nper: 18 * 12, Fv: 50000}, -129.0811608679954},
+		{"PMTZeroRate", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_PMT, Nper: 12, Pv: 1200}, -100},
+		{"PV", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_PV, Rate: 0.08 / 12, Nper: 240, Pmt: 500}, -59777.14585118777},
+		{"FVInAdvance", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_FV, Rate: 0.06 / 12, Nper: 10, Pmt: -200, Pv: -500, Timing: calculatorpb.PAYMENT_TIMING_PAYMENT_TIMING_BEGIN}, 2581.4033740601362},
+		{"NPER", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_NPER, Rate: 0.01, Pmt: -100, Pv: -1000, Fv: 10000, Timing: calculatorpb.PAYMENT_TIMING_PAYMENT_TIMING_BEGIN}, 59.67386567429457},
+		{"RATE", &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_RATE, Nper: 48, Pmt: -200, Pv: 8000}, 0.007701472488201888},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.TimeValue(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.expected, res.Result, 1e-9*(1+math.Abs(tt.expected)))
+		})
+	}
+}
+
+func Test_CashFlow(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	xirrDates := []string{"2008-01-01", "2008-03-01", "2008-10-30", "2009-02-15", "2009-04-01"}
+	xirrValues := []float64{-10000, 2750, 4250, 3250, 2750}
+	tests := []struct {
+		name     string
+		request  *calculatorpb.CashFlowRequest
+		expected float64
+	}{
+		{"NPV", &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_NPV, Rate: 0.1, Values: []float64{-10000, 3000, 4200, 6800}}, 1188.4434123352216},
+		{"IRR", &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_IRR, Values: []float64{-70000, 12000, 15000, 18000, 21000, 26000}}, 0.0866309480365316},
+		{"NegativeIRR", &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_IRR, Values: []float64{-70000, 12000, 15000, 18000, 21000}}, -0.021244848273410947},
+		{"XNPV", &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_XNPV, Rate: 0.09, Values: xirrValues, Dates: xirrDates}, 2086.647602031535},
+		{"XIRR", &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_XIRR, Values: xirrValues, Dates: xirrDates}, 0.37336253351883136},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.CashFlow(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.expected, res.Result, 1e-9*(1+math.Abs(tt.expected)))
+			if res.Convergence != nil {
+				assert.True(t, res.Convergence.Converged)
+				assert.InDelta(t, 0, res.Convergence.Residual, 1e-6)
+			}
+		})
+	}
+}
+
+func Test_IRRFallsBackToBisection(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// Newton's method jumps below -1 from a guess far above the root
+	res, err := calculatorSvc.CashFlow(context.Background(), &calculatorpb.CashFlowRequest{
+		Function: calculatorpb.CASH_FLOW_CASH_FLOW_IRR,
+		Values:   []float64{-100, 10},
+		Guess:    5,
+	})
+	assert.Nil(t, err)
+	assert.InDelta(t, -0.9, res.Result, 1e-9)
+	assert.True(t, res.Convergence.Converged)
+	assert.Equal(t, "bisection", res.Convergence.Method)
+}
+
+func Test_Amortization(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	var rows []*calculatorpb.AmortizationRow
+	err := calculatorSvc.Amortization(context.Background(), &calculatorpb.AmortizationRequest{Rate: 0.05 / 12, Nper: 360, Pv: 200000},
+		func(row *calculatorpb.AmortizationRow) error {
+			rows = append(rows, row)
+			return nil
+		})
+	assert.Nil(t, err)
+	assert.Len(t, rows, 360)
+	assert.InDelta(t, -1073.6432460242797, rows[0].Payment, 1e-9)
+	assert.InDelta(t, -833.3333333333334, rows[0].Interest, 1e-9)
+	assert.InDelta(t, -240.30991269094636, rows[0].Principal, 1e-9)
+	assert.InDelta(t, 199276.0622159714, rows[2].Balance, 1e-7)
+	assert.InDelta(t, 0, rows[359].Balance, 1e-6)
+
+	principal := 0.0
+	for _, row := range rows {
+		assert.InDelta(t, row.Payment, row.Interest+row.Principal, 1e-9)
+		principal += row.Principal
+	}
+	assert.InDelta(t, -200000, principal, 1e-6)
+}
+
+func Test_AmortizationInAdvance(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	var rows []*calculatorpb.AmortizationRow
+	err := calculatorSvc.Amortization(context.Background(), &calculatorpb.AmortizationRequest{
+		Rate: 0.01, Nper: 12, Pv: 1000, Timing: calculatorpb.PAYMENT_TIMING_PAYMENT_TIMING_BEGIN,
+	}, func(row *calculatorpb.AmortizationRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, rows[0].Interest)
+	assert.InDelta(t, -87.9690977013284, rows[0].Principal, 1e-9)
+	assert.InDelta(t, 0, rows[11].Balance, 1e-9)
+}
+
+func Test_AmortizationStopsWhenSendFails(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	sent := 0
+	errClosed := errors.New("stream closed")
+	err := calculatorSvc.Amortization(context.Background(), &calculatorpb.AmortizationRequest{Rate: 0.01, Nper: 100, Pv: 1000},
+		func(row *calculatorpb.AmortizationRow) error {
+			sent++
+			if sent == 3 {
+				return errClosed
+			}
+			return nil
+		})
+	assert.Equal(t, errClosed, err)
+	assert.Equal(t, 3, sent)
+}
+
+func Test_Depreciation(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.DepreciationRequest
+		expected []float64
+	}{
+		{"StraightLine", &calculatorpb.DepreciationRequest{Method: calculatorpb.DEPRECIATION_DEPRECIATION_STRAIGHT_LINE, Cost: 30000, Salvage: 7500, Life: 3}, []float64{7500, 7500, 7500}},
+		{"DecliningBalance", &calculatorpb.DepreciationRequest{Method: calculatorpb.DEPRECIATION_DEPRECIATION_DECLINING_BALANCE, Cost: 1000000, Salvage: 100000, Life: 6, Month: 7},
+			[]float64{186083.33, 259639.42, 176814.44, 120410.64, 81999.64, 55841.76, 15845.10}},
+		{"DoubleDecliningBalance", &calculatorpb.DepreciationRequest{Method: calculatorpb.DEPRECIATION_DEPRECIATION_DOUBLE_DECLINING_BALANCE, Cost: 2400, Salvage: 300, Life: 10},
+			[]float64{480, 384, 307.2, 245.76, 196.61, 157.29, 125.83, 100.66, 80.53, 22.12}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Depreciation(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Len(t, res.Rows, len(tt.expected))
+			for i, row := range res.Rows {
+				assert.Equal(t, uint32(i+1), row.Period)
+				assert.InDelta(t, tt.expected[i], row.Depreciation, 0.005)
+				assert.InDelta(t, tt.request.Cost, row.Accumulated+row.BookValue, 1e-6)
+			}
+		})
+	}
+}
+
+func Test_FinanceErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	_, err := calculatorSvc.TimeValue(context.Background(), &calculatorpb.TimeValueRequest{Function: calculatorpb.TIME_VALUE_TIME_VALUE_NPER, Rate: 0.01, Pmt: -5, Pv: 1000})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.CashFlow(context.Background(), &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_IRR, Values: []float64{100, 200}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.CashFlow(context.Background(), &calculatorpb.CashFlowRequest{Function: calculatorpb.CASH_FLOW_CASH_FLOW_XIRR, Values: []float64{-100, 200}, Dates: []string{"2024-01-01"}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	_, err = calculatorSvc.Depreciation(context.Background(), &calculatorpb.DepreciationRequest{Method: calculatorpb.DEPRECIATION_DEPRECIATION_STRAIGHT_LINE, Cost: 100, Salvage: 200, Life: 5})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+	err = calculatorSvc.Amortization(context.Background(), &calculatorpb.AmortizationRequest{Rate: 0.01, Pv: 1000}, func(*calculatorpb.AmortizationRow) error { return nil })
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+}
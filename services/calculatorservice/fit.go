@@ -0,0 +1,303 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultFitDegree = 2
+	maxFitDegree     = 10
+	maxFitIterations = 100
+)
+
+// Fit fits a model to points by least squares. The exponential and power
+// models start from the line through the logarithms of the points, which
+// weighs the points unevenly, and are refined to the least squares of the
+// points themselves.
+func (c *Calculator) Fit(ctx context.Context, req *calculatorpb.FitRequest) (*calculatorpb.FitResponse, error) {
+	if len(req.X) != len(req.Y) {
+		return nil, invalidArgumentf("x and y have %d and %d values", len(req.X), len(req.Y))
+	}
+	for i := range req.X {
+		if math.IsNaN(req.X[i]) || math.IsInf(req.X[i], 0) || math.IsNaN(req.Y[i]) || math.IsInf(req.Y[i], 0) {
+			return nil, invalidArgumentf("point %d is not finite", i+1)
+		}
+	}
+	variable := req.Variable
+	if variable == "" {
+		variable = "x"
+	}
+	if e, err := parseExpression(variable); err != nil || e.String() != variable {
+		return nil, invalidArgumentf("%q is not a variable name", variable)
+	}
+	if _, ok := exprConstants[variable]; ok {
+		return nil, invalidArgumentf("%s is a constant and can not be the variable", variable)
+	}
+	x := &variableNode{variable}
+
+	var coefficients []float64
+	var model func(p []float64, x float64) float64
+	var e expr
+	switch req.Model {
+	case calculatorpb.FIT_MODEL_FIT_MODEL_LINEAR, calculatorpb.FIT_MODEL_FIT_MODEL_POLYNOMIAL:
+		degree := 1
+		if req.Model == calculatorpb.FIT_MODEL_FIT_MODEL_POLYNOMIAL {
+			degree = int(req.Degree)
+			if degree == 0 {
+				degree = defaultFitDegree
+			}
+			if degree > maxFitDegree {
+				return nil, invalidArgumentf("degree %d is above %d", degree, maxFitDegree)
+			}
+		}
+		var err error
+		if coefficients, err = polynomialFit(req.X, req.Y, degree); err != nil {
+			return nil, err
+		}
+		model = func(p []float64, x float64) float64 {
+			v := 0.0
+			for k := len(p) - 1; k >= 0; k-- {
+				v = v*x + p[k]
+			}
+			return v
+		}
+		e = polynomialExpr(coefficients, x)
+	case calculatorpb.FIT_MODEL_FIT_MODEL_EXPONENTIAL:
+		logY := make([]float64, len(req.Y))
+		for i, y := range req.Y {
+			if y <= 0 {
+				return nil, invalidArgumentf("the exponential model needs positive y")
+			}
+			logY[i] = math.Log(y)
+		}
+		line, err := polynomialFit(req.X, logY, 1)
+		if err != nil {
+			return nil, err
+		}
+		model = func(p []float64, x float64) float64 { return p[0] * math.Exp(p[1]*x) }
+		coefficients = refineFit(req.X, req.Y, []float64{math.Exp(line[0]), line[1]}, model,
+			func(p []float64, x float64) (float64, float64) {
+				v := math.Exp(p[1] * x)
+				return v, p[0] * x * v
+			})
+		e = &binaryNode{'*', &numberNode{coefficients[0]}, &callNode{"exp", &binaryNode{'*', &numberNode{coefficients[1]}, x}}}
+	case calculatorpb.FIT_MODEL_FIT_MODEL_POWER:
+		logX, logY := make([]float64, len(req.X)), make([]float64, len(req.Y))
+		for i := range req.X {
+			if req.X[i] <= 0 || req.Y[i] <= 0 {
+				return nil, invalidArgumentf("the power model needs positive x and y")
+			}
+			logX[i], logY[i] = math.Log(req.X[i]), math.Log(req.Y[i])
+		}
+		line, err := polynomialFit(logX, logY, 1)
+		if err != nil {
+			return nil, err
+		}
+		model = func(p []float64, x float64) float64 { return p[0] * math.Pow(x, p[1]) }
+		coefficients = refineFit(req.X, req.Y, []float64{math.Exp(line[0]), line[1]}, model,
+			func(p []float64, x float64) (float64, float64) {
+				v := math.Pow(x, p[1])
+				return v, p[0] * v * math.Log(x)
+			})
+		e = &binaryNode{'*', &numberNode{coefficients[0]}, &binaryNode{'^', x, &numberNode{coefficients[1]}}}
+	default:
+		return nil, invalidArgumentf("fit model is not supplied")
+	}
+
+	res := &calculatorpb.FitResponse{Coefficients: coefficients, Residuals: make([]float64, len(req.X))}
+	mean := sampleMoments(req.Y).mean
+	var residualSquares, totalSquares float64
+	for i := range req.X {
+		r := req.Y[i] - model(coefficients, req.X[i])
+		res.Residuals[i] = r
+		residualSquares += r * r
+		totalSquares += (req.Y[i] - mean) * (req.Y[i] - mean)
+	}
+	// y that doesn't vary is explained entirely by a model through it, to
+	// within the rounding of y, and not at all otherwise
+	switch {
+	case totalSquares > 0:
+		res.RSquared = 1 - residualSquares/totalSquares
+	case math.Sqrt(residualSquares) <= 8*machineEpsilon*math.Abs(mean)*float64(len(req.Y)):
+		res.RSquared = 1
+	}
+	res.Expression = simplify(e).String()
+	return res, nil
+}
+
+// polynomialFit are the coefficients c0, c1, ... of the polynomial of degree
+// that fits the points best, by the QR decomposition of their Vandermonde
+// matrix
+func polynomialFit(x, y []float64, degree int) ([]float64, error) {
+	if len(x) < degree+1 {
+		return nil, invalidArgumentf("%d points are too few for a polynomial of degree %d", len(x), degree)
+	}
+	a := make([][]float64, len(x))
+	for i := range a {
+		a[i] = make([]float64, degree+1)
+		v := 1.0
+		for j := range a[i] {
+			a[i][j] = v
+			v *= x[i]
+		}
+	}
+	b := append([]float64(nil), y...)
+	coefficients, ok := leastSquares(a, b)
+	if !ok {
+		return nil, invalidArgumentf("x has too few distinct values for a polynomial of degree %d", degree)
+	}
+	return coefficients, nil
+}
+
+// leastSquares solves a c = b for the c of the least squared residuals by
+// Householder reflections, overwriting a and b. It is not ok when the
+// columns of a are not independent.
+func leastSquares(a [][]float64, b []float64) ([]float64, bool) {
+	m, n := len(a), len(a[0])
+	// the columns are scaled to unit length, so that the rank is judged alike
+	// for all of them
+	scale := make([]float64, n)
+	for j := range scale {
+		for i := range a {
+			scale[j] = math.Hypot(scale[j], a[i][j])
+		}
+		if scale[j] == 0 {
+			return nil, false
+		}
+		for i := range a {
+			a[i][j] /= scale[j]
+		}
+	}
+
+	diagonal := make([]float64, n)
+	for k := 0; k < n; k++ {
+		norm := 0.0
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, a[i][k])
+		}
+		if norm <= float64(m)*machineEpsilon {
+			return nil, false
+		}
+		// the reflection of the column onto -sign(a[k][k]) norm e_k, its vector
+		// is left in the column
+		if a[k][k] > 0 {
+			norm = -norm
+		}
+		a[k][k] -= norm
+		diagonal[k] = norm
+		vv := 0.0
+		for i := k; i < m; i++ {
+			vv += a[i][k] * a[i][k]
+		}
+		reflect := func(column func(i int) *float64) {
+			s := 0.0
+			for i := k; i < m; i++ {
+				s += a[i][k] * *column(i)
+			}
+			f := 2 * s / vv
+			for i := k; i < m; i++ {
+				*column(i) -= f * a[i][k]
+			}
+		}
+		for j := k + 1; j < n; j++ {
+			reflect(func(i int) *float64 { return &a[i][j] })
+		}
+		reflect(func(i int) *float64 { return &b[i] })
+	}
+
+	c := make([]float64, n)
+	for k := n - 1; k >= 0; k-- {
+		s := b[k]
+		for j := k + 1; j < n; j++ {
+			s -= a[k][j] * c[j]
+		}
+		c[k] = s / diagonal[k]
+	}
+	for j := range c {
+		c[j] /= scale[j]
+	}
+	return c, true
+}
+
+// refineFit minimizes the squared residuals of a model of two parameters by
+// Levenberg-Marquardt from p, which is close to the minimum. gradient is the
+// derivative of the model by each parameter.
+func refineFit(x, y, p []float64, model func(p []float64, x float64) float64, gradient func(p []float64, x float64) (float64, float64)) []float64 {
+	cost := func(p []float64) float64 {
+		s := 0.0
+		for i := range x {
+			r := y[i] - model(p, x[i])
+			s += r * r
+		}
+		return s
+	}
+	current := cost(p)
+	lambda := 1e-3
+	for iteration := 0; iteration < maxFitIterations; iteration++ {
+		// the normal equations of the linearized model
+		var a00, a01, a11, g0, g1 float64
+		for i := range x {
+			d0, d1 := gradient(p, x[i])
+			r := y[i] - model(p, x[i])
+			a00, a01, a11 = a00+d0*d0, a01+d0*d1, a11+d1*d1
+			g0, g1 = g0+d0*r, g1+d1*r
+		}
+		for {
+			b00, b11 := a00*(1+lambda), a11*(1+lambda)
+			det := b00*b11 - a01*a01
+			step := []float64{(b11*g0 - a01*g1) / det, (b00*g1 - a01*g0) / det}
+			next := []float64{p[0] + step[0], p[1] + step[1]}
+			if c := cost(next); c < current {
+				done := math.Abs(step[0]) <= 1e-14*math.Abs(p[0]) && math.Abs(step[1]) <= 1e-14*math.Abs(p[1])
+				p, current, lambda = next, c, lambda/10
+				if done {
+					return p
+				}
+				break
+			}
+			// no step downhill is left, or the parameters are at their best
+			lambda *= 10
+			if lambda > 1e16 || math.IsNaN(det) || det == 0 {
+				return p
+			}
+		}
+	}
+	return p
+}
+
+// polynomialExpr is the polynomial of coefficients c0, c1, ... in x, highest
+// power first
+func polynomialExpr(coefficients []float64, x expr) expr {
+	var sum expr
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		c := coefficients[k]
+		if c == 0 {
+			continue
+		}
+		var term expr = &numberNode{math.Abs(c)}
+		if k > 0 {
+			var power expr = x
+			if k > 1 {
+				power = &binaryNode{'^', x, &numberNode{float64(k)}}
+			}
+			term = &binaryNode{'*', term, power}
+		}
+		switch {
+		case sum == nil && c < 0:
+			sum = &negNode{term}
+		case sum == nil:
+			sum = term
+		case c < 0:
+			sum = &binaryNode{'-', sum, term}
+		default:
+			sum = &binaryNode{'+', sum, term}
+		}
+	}
+	if sum == nil {
+		return &numberNode{0}
+	}
+	return sum
+}
@@ -0,0 +1,149 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Fit(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	x := []float64{1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		name     string
+		request  *calculatorpb.FitRequest
+		f        func(x float64) float64
+		expected []float64
+	}{
+		{
+			name:     "Linear",
+			request:  &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_LINEAR},
+			f:        func(x float64) float64 { return 2*x + 1 },
+			expected: []float64{1, 2},
+		},
+		{
+			name:     "Polynomial",
+			request:  &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_POLYNOMIAL, Degree: 3, Variable: "t"},
+			f:        func(x float64) float64 { return 0.5*x*x*x - x*x + 4 },
+			expected: []float64{4, 0, -1, 0.5},
+		},
+		{
+			name:     "Exponential",
+			request:  &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_EXPONENTIAL},
+			f:        func(x float64) float64 { return 3 * math.Exp(-0.5*x) },
+			expected: []float64{3, -0.5},
+		},
+		{
+			name:     "Power",
+			request:  &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_POWER},
+			f:        func(x float64) float64 { return 2 * math.Pow(x, 1.5) },
+			expected: []float64{2, 1.5},
+		},
+		{
+			name:     "Constant",
+			request:  &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_LINEAR},
+			f:        func(float64) float64 { return 7 },
+			expected: []float64{7, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.X = x
+			for _, v := range x {
+				tt.request.Y = append(tt.request.Y, tt.f(v))
+			}
+			res, err := calculatorSvc.Fit(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expected, res.Coefficients, 1e-9)
+			assert.InDelta(t, 1, res.RSquared, 1e-12)
+			assert.InDeltaSlice(t, make([]float64, len(x)), res.Residuals, 1e-9)
+
+			// the expression computes the model
+			variable := tt.request.Variable
+			if variable == "" {
+				variable = "x"
+			}
+			value, err := calculatorSvc.EvaluateExpression(context.Background(), &calculatorpb.EvaluateExpressionRequest{
+				Expression: res.Expression, Values: map[string]float64{variable: 2.5},
+			})
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.f(2.5), value.Value, 1e-9)
+		})
+	}
+}
+
+func Test_FitLeastSquares(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	x := []float64{1, 2, 3, 4, 5, 6}
+	y := []float64{2.1, 3.9, 6.2, 7.8, 10.1, 12.2}
+
+	// the slope is the covariance over the variance of x
+	res, err := calculatorSvc.Fit(context.Background(), &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_LINEAR, X: x, Y: y})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{-0.02, 2.02}, res.Coefficients, 1e-12)
+	assert.InDelta(t, 0.998210666107, res.RSquared, 1e-12)
+	sum := 0.0
+	for _, r := range res.Residuals {
+		sum += r
+	}
+	assert.InDelta(t, 0, sum, 1e-12)
+
+	// at the least squares of the points themselves, not of their logarithms,
+	// the residuals are orthogonal to the derivatives of the model
+	y = []float64{5, 2.5, 1.3, 0.6, 0.31, 0.15}
+	res, err = calculatorSvc.Fit(context.Background(), &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_EXPONENTIAL, X: x, Y: y})
+	assert.Nil(t, err)
+	a, b := res.Coefficients[0], res.Coefficients[1]
+	var ga, gb float64
+	for i := range x {
+		ga += res.Residuals[i] * math.Exp(b*x[i])
+		gb += res.Residuals[i] * a * x[i] * math.Exp(b*x[i])
+	}
+	assert.InDelta(t, 0, ga, 1e-10)
+	assert.InDelta(t, 0, gb, 1e-10)
+	assert.Greater(t, res.RSquared, 0.999)
+
+	// a polynomial through as many points as coefficients
+	res, err = calculatorSvc.Fit(context.Background(), &calculatorpb.FitRequest{
+		Model: calculatorpb.FIT_MODEL_FIT_MODEL_POLYNOMIAL, X: []float64{-1, 0, 1}, Y: []float64{2, 1, 2},
+	})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{1, 0, 1}, res.Coefficients, 1e-12)
+}
+
+func Test_FitErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	linear := calculatorpb.FIT_MODEL_FIT_MODEL_LINEAR
+	polynomial := calculatorpb.FIT_MODEL_FIT_MODEL_POLYNOMIAL
+	tests := []struct {
+		name    string
+		request *calculatorpb.FitRequest
+	}{
+		{"MissingModel", &calculatorpb.FitRequest{X: []float64{1, 2}, Y: []float64{1, 2}}},
+		{"LengthMismatch", &calculatorpb.FitRequest{Model: linear, X: []float64{1, 2}, Y: []float64{1}}},
+		{"TooFewPoints", &calculatorpb.FitRequest{Model: linear, X: []float64{1}, Y: []float64{1}}},
+		{"RepeatedX", &calculatorpb.FitRequest{Model: polynomial, X: []float64{1, 1, 2, 2}, Y: []float64{1, 2, 3, 4}}},
+		{"SingleX", &calculatorpb.FitRequest{Model: linear, X: []float64{3, 3, 3}, Y: []float64{1, 2, 3}}},
+		{"DegreeTooHigh", &calculatorpb.FitRequest{Model: polynomial, X: []float64{1, 2}, Y: []float64{1, 2}, Degree: 11}},
+		{"NonPositiveY", &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_EXPONENTIAL, X: []float64{1, 2}, Y: []float64{1, 0}}},
+		{"NonPositiveX", &calculatorpb.FitRequest{Model: calculatorpb.FIT_MODEL_FIT_MODEL_POWER, X: []float64{-1, 2}, Y: []float64{1, 2}}},
+		{"NaN", &calculatorpb.FitRequest{Model: linear, X: []float64{1, math.NaN()}, Y: []float64{1, 2}}},
+		{"ConstantVariable", &calculatorpb.FitRequest{Model: linear, X: []float64{1, 2}, Y: []float64{1, 2}, Variable: "pi"}},
+		{"InvalidVariable", &calculatorpb.FitRequest{Model: linear, X: []float64{1, 2}, Y: []float64{1, 2}, Variable: "x y"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Fit(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	// earthRadius is the mean radius of the Earth in meters
+	earthRadius = 6371008.8
+	// wgs84A and wgs84F are the semi-major axis in meters and the flattening
+	// of the WGS84 ellipsoid
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+
+	maxVincentyIterations = 200
+)
+
+// Geometry computes shapes, triangles, distances, polygons and geodesics
+func (c *Calculator) Geometry(ctx context.Context, req *calculatorpb.GeometryRequest) (*calculatorpb.GeometryResponse, error) {
+	switch req.Function {
+	case calculatorpb.GEOMETRY_GEOMETRY_SHAPE:
+		return shape(req.Shape, req.Dimensions)
+	case calculatorpb.GEOMETRY_GEOMETRY_TRIANGLE:
+		return solveTriangle(req.Triangle)
+	case calculatorpb.GEOMETRY_GEOMETRY_DISTANCE:
+		if req.From == nil || req.To == nil {
+			return nil, invalidArgumentf("from and to are not supplied")
+		}
+		if err := finiteValues("points", []float64{req.From.X, req.From.Y, req.From.Z, req.To.X, req.To.Y, req.To.Z}); err != nil {
+			return nil, err
+		}
+		dx, dy, dz := req.To.X-req.From.X, req.To.Y-req.From.Y, req.To.Z-req.From.Z
+		return &calculatorpb.GeometryResponse{Distance: math.Hypot(dx, math.Hypot(dy, dz))}, nil
+	case calculatorpb.GEOMETRY_GEOMETRY_POLYGON:
+		return polygon(req.Polygon)
+	case calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE:
+		if err := geoPoints(req.Origin, req.Destination); err != nil {
+			return nil, err
+		}
+		radius := req.Radius
+		switch {
+		case radius == 0:
+			radius = earthRadius
+		case !(radius > 0) || math.IsInf(radius, 0):
+			return nil, invalidArgumentf("radius %v is not a positive number", radius)
+		}
+		return haversine(req.Origin, req.Destination, radius), nil
+	case calculatorpb.GEOMETRY_GEOMETRY_VINCENTY:
+		if err := geoPoints(req.Origin, req.Destination); err != nil {
+			return nil, err
+		}
+		return vincenty(req.Origin, req.Destination)
+	}
+	return nil, invalidArgumentf("geometry function is not supplied")
+}
+
+// shape is the area and perimeter of a shape of dimensions
+func shape(kind calculatorpb.SHAPE, dimensions []float64) (*calculatorpb.GeometryResponse, error) {
+	expect := map[calculatorpb.SHAPE]int{
+		calculatorpb.SHAPE_SHAPE_CIRCLE:          1,
+		calculatorpb.SHAPE_SHAPE_SQUARE:          1,
+		calculatorpb.SHAPE_SHAPE_RECTANGLE:       2,
+		calculatorpb.SHAPE_SHAPE_ELLIPSE:         2,
+		calculatorpb.SHAPE_SHAPE_REGULAR_POLYGON: 2,
+		calculatorpb.SHAPE_SHAPE_TRAPEZOID:       4,
+	}
+	n, ok := expect[kind]
+	if !ok {
+		return nil, invalidArgumentf("shape is not supplied")
+	}
+	if len(dimensions) != n {
+		return nil, invalidArgumentf("%s takes %d dimensions, not %d", kind, n, len(dimensions))
+	}
+	for i, d := range dimensions {
+		if !(d > 0) || math.IsInf(d, 0) {
+			return nil, invalidArgumentf("dimension %d is %v, not a positive number", i+1, d)
+		}
+	}
+
+	var area, perimeter float64
+	switch kind {
+	case calculatorpb.SHAPE_SHAPE_CIRCLE:
+		r := dimensions[0]
+		area, perimeter = math.Pi*r*r, 2*math.Pi*r
+	case calculatorpb.SHAPE_SHAPE_SQUARE:
+		s := dimensions[0]
+		area, perimeter = s*s, 4*s
+	case calculatorpb.SHAPE_SHAPE_RECTANGLE:
+		w, h := dimensions[0], dimensions[1]
+		area, perimeter = w*h, 2*(w+h)
+	case calculatorpb.SHAPE_SHAPE_ELLIPSE:
+		a, b := dimensions[0], dimensions[1]
+		// Ramanujan's second approximation, exact for a circle and within
+		// 4e-5 of the perimeter for any ellipse
+		h := (a - b) * (a - b) / ((a + b) * (a + b))
+		area, perimeter = math.Pi*a*b, math.Pi*(a+b)*(1+3*h/(10+math.Sqrt(4-3*h)))
+	case calculatorpb.SHAPE_SHAPE_REGULAR_POLYGON:
+		n, s := dimensions[0], dimensions[1]
+		if n != math.Trunc(n) || n < 3 {
+			return nil, invalidArgumentf("a regular polygon has a whole number of at least 3 sides, not %v", n)
+		}
+		area, perimeter = n*s*s/(4*math.Tan(math.Pi/n)), n*s
+	case calculatorpb.SHAPE_SHAPE_TRAPEZOID:
+		a, b, c, d := dimensions[0], dimensions[1], dimensions[2], dimensions[3]
+		// the legs and the difference of the parallel sides make a triangle,
+		// whose height is the height of the trapezoid
+		e := math.Abs(a - b)
+		if e == 0 {
+			return nil, invalidArgumentf("the parallel sides are equal, which leaves the height open")
+		}
+		if !isTriangle(c, d, e) {
+			return nil, invalidArgumentf("legs %v and %v don't meet over the difference %v of the parallel sides", c, d, e)
+		}
+		area, perimeter = (a+b)*heron(c, d, e)/e, a+b+c+d
+	}
+	return &calculatorpb.GeometryResponse{Area: area, Perimeter: perimeter}, nil
+}
+
+// solveTriangle finds the sides and angles of a triangle from three of them
+func solveTriangle(t *calculatorpb.Triangle) (*calculatorpb.GeometryResponse, error) {
+	if t == nil {
+		return nil, invalidArgumentf("triangle is not supplied")
+	}
+	sides := []float64{t.A, t.B, t.C}
+	angles := []float64{t.Alpha, t.Beta, t.Gamma}
+	var knownSides, knownAngles []int
+	for i := range sides {
+		if sides[i] != 0 {
+			if !(sides[i] > 0) || math.IsInf(sides[i], 0) {
+				return nil, invalidArgumentf("side %v is not a positive number", sides[i])
+			}
+			knownSides = append(knownSides, i)
+		}
+		if angles[i] != 0 {
+			if !(angles[i] > 0 && angles[i] < 180) {
+				return nil, invalidArgumentf("angle %v is not between 0 and 180 degrees", angles[i])
+			}
+			knownAngles = append(knownAngles, i)
+		}
+	}
+	if len(knownSides)+len(knownAngles) != 3 {
+		return nil, invalidArgumentf("a triangle is solved from 3 of its sides and angles, not %d", len(knownSides)+len(knownAngles))
+	}
+
+	switch len(knownSides) {
+	case 3:
+	case 2:
+		// the angle is opposite the unknown side for SAS, otherwise the
+		// triangle is SSA, which may have two solutions
+		unknown := 3 - knownSides[0] - knownSides[1]
+		if knownAngles[0] != unknown {
+			return nil, invalidArgumentf("two sides and an angle not between them may make two triangles")
+		}
+		i, j := knownSides[0], knownSides[1]
+		sides[unknown] = math.Sqrt(sides[i]*sides[i] + sides[j]*sides[j] - 2*sides[i]*sides[j]*math.Cos(radians(angles[unknown])))
+	case 1:
+		i, j := knownAngles[0], knownAngles[1]
+		if angles[i]+angles[j] >= 180 {
+			return nil, invalidArgumentf("angles %v and %v add up to 180 degrees or more", angles[i], angles[j])
+		}
+		angles[3-i-j] = 180 - angles[i] - angles[j]
+		// the law of sines
+		k := sides[knownSides[0]] / math.Sin(radians(angles[knownSides[0]]))
+		for s := range sides {
+			sides[s] = k * math.Sin(radians(angles[s]))
+		}
+	default:
+		return nil, invalidArgumentf("three angles don't fix the size of a triangle")
+	}
+
+	a, b, c := sides[0], sides[1], sides[2]
+	if !isTriangle(a, b, c) {
+		return nil, invalidArgumentf("sides %v, %v and %v don't make a triangle", a, b, c)
+	}
+	// the law of cosines for the angles left
+	for i := range angles {
+		if angles[i] == 0 {
+			opposite, s1, s2 := sides[i], sides[(i+1)%3], sides[(i+2)%3]
+			angles[i] = degrees(math.Acos(math.Max(-1, math.Min(1, (s1*s1+s2*s2-opposite*opposite)/(2*s1*s2)))))
+		}
+	}
+	return &calculatorpb.GeometryResponse{
+		Area:      heron(a, b, c),
+		Perimeter: a + b + c,
+		Triangle:  &calculatorpb.Triangle{A: a, B: b, C: c, Alpha: angles[0], Beta: angles[1], Gamma: angles[2]},
+	}, nil
+}
+
+func isTriangle(a, b, c float64) bool {
+	return a < b+c && b < a+c && c < a+b
+}
+
+// heron is the area of a triangle of sides, by Heron's formula arranged to
+// be stable for needle-like triangles
+func heron(a, b, c float64) float64 {
+	s := []float64{a, b, c}
+	sort.Sort(sort.Reverse(sort.Float64Slice(s)))
+	a, b, c = s[0], s[1], s[2]
+	return math.Sqrt((a+(b+c))*(c-(a-b))*(c+(a-b))*(a+(b-c))) / 4
+}
+
+// polygon is the area, perimeter and centroid of a polygon
+func polygon(p *calculatorpb.Polygon) (*calculatorpb.GeometryResponse, error) {
+	if p == nil || len(p.Vertices) < 3 {
+		return nil, invalidArgumentf("a polygon has at least 3 vertices")
+	}
+	for i, v := range p.Vertices {
+		if v == nil {
+			return nil, invalidArgumentf("vertex %d is not supplied", i+1)
+		}
+		if err := finiteValues("vertices", []float64{v.X, v.Y}); err != nil {
+			return nil, err
+		}
+		if v.Z != 0 {
+			return nil, invalidArgumentf("vertex %d is not in the plane", i+1)
+		}
+	}
+
+	// the shoelace formula, about the first vertex, which keeps the cross
+	// products small for a polygon far from the origin
+	x0, y0 := p.Vertices[0].X, p.Vertices[0].Y
+	var area, cx, cy, perimeter float64
+	for i, v := range p.Vertices {
+		w := p.Vertices[(i+1)%len(p.Vertices)]
+		x1, y1, x2, y2 := v.X-x0, v.Y-y0, w.X-x0, w.Y-y0
+		cross := x1*y2 - x2*y1
+		area += cross
+		cx += (x1 + x2) * cross
+		cy += (y1 + y2) * cross
+		perimeter += math.Hypot(w.X-v.X, w.Y-v.Y)
+	}
+	area /= 2
+	if area == 0 {
+		return nil, invalidArgumentf("the polygon has no area")
+	}
+	return &calculatorpb.GeometryResponse{
+		Area:      math.Abs(area),
+		Perimeter: perimeter,
+		Centroid:  &calculatorpb.Point{X: x0 + cx/(6*area), Y: y0 + cy/(6*area)},
+	}, nil
+}
+
+// geoPoints checks the points of a geodesic
+func geoPoints(points ...*calculatorpb.GeoPoint) error {
+	for _, p := range points {
+		if p == nil {
+			return invalidArgumentf("origin and destination are not supplied")
+		}
+		if !(p.Latitude >= -90 && p.Latitude <= 90) {
+			return invalidArgumentf("latitude %v is not between -90 and 90 degrees", p.Latitude)
+		}
+		if !(p.Longitude >= -180 && p.Longitude <= 180) {
+			return invalidArgumentf("longitude %v is not between -180 and 180 degrees", p.Longitude)
+		}
+	}
+	return nil
+}
+
+// haversine is the great circle between two points on a sphere of radius
+func haversine(origin, destination *calculatorpb.GeoPoint, radius float64) *calculatorpb.GeometryResponse {
+	phi1, phi2 := radians(origin.Latitude), radians(destination.Latitude)
+	dPhi, dLambda := phi2-phi1, radians(destination.Longitude-origin.Longitude)
+	h := math.Pow(math.Sin(dPhi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dLambda/2), 2)
+	if h == 0 {
+		// the points coincide
+		return &calculatorpb.GeometryResponse{}
+	}
+	// the final bearing is the reverse of the initial bearing back from the
+	// destination
+	initial := func(phi1, phi2, dLambda float64) float64 {
+		return math.Atan2(math.Sin(dLambda)*math.Cos(phi2), math.Cos(phi1)*math.Sin(phi2)-math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda))
+	}
+	return &calculatorpb.GeometryResponse{
+		Distance:       2 * radius * math.Atan2(math.Sqrt(h), math.Sqrt(1-h)),
+		InitialBearing: bearing(initial(phi1, phi2, dLambda)),
+		FinalBearing:   bearing(initial(phi2, phi1, -dLambda) + math.Pi),
+	}
+}
+
+// vincenty is the geodesic between two points on the WGS84 ellipsoid by
+// Vincenty's inverse formula, which iterates the longitude on the auxiliary
+// sphere
+func vincenty(origin, destination *calculatorpb.GeoPoint) (*calculatorpb.GeometryResponse, error) {
+	const b = (1 - wgs84F) * wgs84A
+	// the reduced latitudes
+	u1, u2 := math.Atan((1-wgs84F)*math.Tan(radians(origin.Latitude))), math.Atan((1-wgs84F)*math.Tan(radians(destination.Latitude)))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+	l := math.Remainder(radians(destination.Longitude-origin.Longitude), 2*math.Pi)
+
+	lambda := l
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	iterations := 0
+	for {
+		iterations++
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			// the points coincide
+			return &calculatorpb.GeometryResponse{Iterations: uint32(iterations)}, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		// a geodesic along the equator has cosSqAlpha 0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		c := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = l + (1-c)*wgs84F*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < 1e-12 {
+			break
+		}
+		if math.Abs(lambda) > math.Pi || iterations == maxVincentyIterations {
+			return nil, fmt.Errorf("%w: Vincenty's formula for nearly antipodal points", ErrNotConverged)
+		}
+	}
+
+	uSq := cosSqAlpha * (wgs84A*wgs84A - b*b) / (b * b)
+	a := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	bb := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := bb * sinSigma * (cos2SigmaM + bb/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		bb/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return &calculatorpb.GeometryResponse{
+		Distance:       b * a * (sigma - deltaSigma),
+		InitialBearing: bearing(math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)),
+		FinalBearing:   bearing(math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)),
+		Iterations:     uint32(iterations),
+	}, nil
+}
+
+// bearing is an angle in radians as degrees in [0, 360)
+func bearing(theta float64) float64 {
+	d := math.Mod(degrees(theta), 360)
+	if d < 0 {
+		d += 360
+	}
+	if d == 360 {
+		return 0
+	}
+	return d
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
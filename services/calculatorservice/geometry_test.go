@@ -0,0 +1,222 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GeometryShape(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		shape      calculatorpb.SHAPE
+		dimensions []float64
+		area       float64
+		perimeter  float64
+	}{
+		{"Circle", calculatorpb.SHAPE_SHAPE_CIRCLE, []float64{2}, 4 * math.Pi, 4 * math.Pi},
+		{"Square", calculatorpb.SHAPE_SHAPE_SQUARE, []float64{3}, 9, 12},
+		{"Rectangle", calculatorpb.SHAPE_SHAPE_RECTANGLE, []float64{2, 3}, 6, 10},
+		{"CircularEllipse", calculatorpb.SHAPE_SHAPE_ELLIPSE, []float64{3, 3}, 9 * math.Pi, 6 * math.Pi},
+		{"Ellipse", calculatorpb.SHAPE_SHAPE_ELLIPSE, []float64{10, 5}, 50 * math.Pi, 48.44224110},
+		{"Hexagon", calculatorpb.SHAPE_SHAPE_REGULAR_POLYGON, []float64{6, 2}, 6 * math.Sqrt(3), 12},
+		{"Trapezoid", calculatorpb.SHAPE_SHAPE_TRAPEZOID, []float64{4, 10, 5, 5}, 28, 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+				Function: calculatorpb.GEOMETRY_GEOMETRY_SHAPE, Shape: tt.shape, Dimensions: tt.dimensions,
+			})
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.area, res.Area, 1e-9)
+			assert.InDelta(t, tt.perimeter, res.Perimeter, 1e-6)
+		})
+	}
+}
+
+func Test_GeometryTriangle(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// the right triangles of sides 3, 4, 5 and 1, √3, 2
+	right := &calculatorpb.Triangle{A: 3, B: 4, C: 5, Alpha: degrees(math.Atan2(3, 4)), Beta: degrees(math.Atan2(4, 3)), Gamma: 90}
+	half := &calculatorpb.Triangle{A: 1, B: math.Sqrt(3), C: 2, Alpha: 30, Beta: 60, Gamma: 90}
+	tests := []struct {
+		name     string
+		triangle *calculatorpb.Triangle
+		expected *calculatorpb.Triangle
+		area     float64
+	}{
+		{"SSS", &calculatorpb.Triangle{A: 3, B: 4, C: 5}, right, 6},
+		{"SAS", &calculatorpb.Triangle{A: 3, B: 4, Gamma: 90}, right, 6},
+		{"ASA", &calculatorpb.Triangle{Alpha: 30, Beta: 60, C: 2}, half, math.Sqrt(3) / 2},
+		{"AAS", &calculatorpb.Triangle{Alpha: 30, Gamma: 90, A: 1}, half, math.Sqrt(3) / 2},
+		{"Equilateral", &calculatorpb.Triangle{A: 2, B: 2, C: 2}, &calculatorpb.Triangle{A: 2, B: 2, C: 2, Alpha: 60, Beta: 60, Gamma: 60}, math.Sqrt(3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+				Function: calculatorpb.GEOMETRY_GEOMETRY_TRIANGLE, Triangle: tt.triangle,
+			})
+			assert.Nil(t, err)
+			e, r := tt.expected, res.Triangle
+			assert.InDeltaSlice(t, []float64{e.A, e.B, e.C, e.Alpha, e.Beta, e.Gamma}, []float64{r.A, r.B, r.C, r.Alpha, r.Beta, r.Gamma}, 1e-9)
+			assert.InDelta(t, tt.area, res.Area, 1e-12)
+			assert.InDelta(t, e.A+e.B+e.C, res.Perimeter, 1e-12)
+		})
+	}
+}
+
+func Test_GeometryPoints(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	distance := func(from, to *calculatorpb.Point) float64 {
+		res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_DISTANCE, From: from, To: to})
+		assert.Nil(t, err)
+		return res.Distance
+	}
+	assert.InDelta(t, 5, distance(&calculatorpb.Point{X: 1, Y: 2}, &calculatorpb.Point{X: 4, Y: 6}), 1e-15)
+	assert.InDelta(t, 7, distance(&calculatorpb.Point{X: 1, Y: 2, Z: 3}, &calculatorpb.Point{X: 3, Y: 5, Z: 9}), 1e-15)
+
+	tests := []struct {
+		name      string
+		vertices  []*calculatorpb.Point
+		area      float64
+		perimeter float64
+		centroid  *calculatorpb.Point
+	}{
+		{
+			name:     "Square",
+			vertices: []*calculatorpb.Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}},
+			area:     4, perimeter: 8, centroid: &calculatorpb.Point{X: 1, Y: 1},
+		},
+		{
+			name:     "ClockwiseL",
+			vertices: []*calculatorpb.Point{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 1, Y: 2}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 0}},
+			area:     3, perimeter: 8, centroid: &calculatorpb.Point{X: 2.5 / 3, Y: 2.5 / 3},
+		},
+		{
+			name:     "FarFromOrigin",
+			vertices: []*calculatorpb.Point{{X: 1e8, Y: 1e8}, {X: 1e8 + 3, Y: 1e8}, {X: 1e8, Y: 1e8 + 4}},
+			area:     6, perimeter: 12, centroid: &calculatorpb.Point{X: 1e8 + 1, Y: 1e8 + 4.0/3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+				Function: calculatorpb.GEOMETRY_GEOMETRY_POLYGON, Polygon: &calculatorpb.Polygon{Vertices: tt.vertices},
+			})
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.area, res.Area, 1e-12)
+			assert.InDelta(t, tt.perimeter, res.Perimeter, 1e-12)
+			assert.InDelta(t, tt.centroid.X, res.Centroid.X, 1e-12)
+			assert.InDelta(t, tt.centroid.Y, res.Centroid.Y, 1e-12)
+		})
+	}
+}
+
+func Test_GeometryGeodesic(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	dms := func(d, m, s float64) float64 { return d + m/60 + s/3600 }
+	flindersPeak := &calculatorpb.GeoPoint{Latitude: -dms(37, 57, 3.72030), Longitude: dms(144, 25, 29.52440)}
+	buninyong := &calculatorpb.GeoPoint{Latitude: -dms(37, 39, 10.15610), Longitude: dms(143, 55, 35.38390)}
+	tests := []struct {
+		name        string
+		function    calculatorpb.GEOMETRY
+		origin      *calculatorpb.GeoPoint
+		destination *calculatorpb.GeoPoint
+		radius      float64
+		distance    float64
+		initial     float64
+		final       float64
+	}{
+		{"HaversineEquator", calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, &calculatorpb.GeoPoint{}, &calculatorpb.GeoPoint{Longitude: 90}, 0, 6371008.8 * math.Pi / 2, 90, 90},
+		{"HaversineWest", calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, &calculatorpb.GeoPoint{}, &calculatorpb.GeoPoint{Longitude: -90}, 1, math.Pi / 2, 270, 270},
+		{"HaversineMeridian", calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, &calculatorpb.GeoPoint{Latitude: -45}, &calculatorpb.GeoPoint{Latitude: 45}, 1, math.Pi / 2, 0, 0},
+		{"HaversineSame", calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, flindersPeak, flindersPeak, 0, 0, 0, 0},
+		{"VincentyEquator", calculatorpb.GEOMETRY_GEOMETRY_VINCENTY, &calculatorpb.GeoPoint{}, &calculatorpb.GeoPoint{Longitude: 1}, 0, 111319.490793, 90, 90},
+		{"VincentyMeridian", calculatorpb.GEOMETRY_GEOMETRY_VINCENTY, &calculatorpb.GeoPoint{}, &calculatorpb.GeoPoint{Latitude: 90}, 0, 10001965.729, 0, 0},
+		{"VincentySame", calculatorpb.GEOMETRY_GEOMETRY_VINCENTY, flindersPeak, flindersPeak, 0, 0, 0, 0},
+		// Vincenty's own example
+		{"VincentyFlindersPeak", calculatorpb.GEOMETRY_GEOMETRY_VINCENTY, flindersPeak, buninyong, 0, 54972.271, dms(306, 52, 5.37), dms(307, 10, 25.07)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+				Function: tt.function, Origin: tt.origin, Destination: tt.destination, Radius: tt.radius,
+			})
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.distance, res.Distance, 1e-3)
+			assert.InDelta(t, tt.initial, res.InitialBearing, 1e-5)
+			assert.InDelta(t, tt.final, res.FinalBearing, 1e-5)
+		})
+	}
+
+	// the sphere is within half a percent of the ellipsoid
+	res, err := calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+		Function: calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, Origin: flindersPeak, Destination: buninyong,
+	})
+	assert.Nil(t, err)
+	assert.InDelta(t, 54972.271, res.Distance, 54972.271*0.005)
+	assert.InDelta(t, dms(306, 52, 5.37), res.InitialBearing, 0.5)
+
+	// Vincenty's iteration fails for nearly antipodal points, which is not the
+	// caller's fault
+	antipode := &calculatorpb.GeoPoint{Latitude: 0.5, Longitude: 179.7}
+	_, err = calculatorSvc.Geometry(context.Background(), &calculatorpb.GeometryRequest{
+		Function: calculatorpb.GEOMETRY_GEOMETRY_VINCENTY, Origin: &calculatorpb.GeoPoint{}, Destination: antipode,
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrNotConverged), "unexpected error %v", err)
+	assert.False(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+}
+
+func Test_GeometryErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	shape := calculatorpb.GEOMETRY_GEOMETRY_SHAPE
+	triangle := calculatorpb.GEOMETRY_GEOMETRY_TRIANGLE
+	tests := []struct {
+		name    string
+		request *calculatorpb.GeometryRequest
+	}{
+		{"MissingFunction", &calculatorpb.GeometryRequest{}},
+		{"MissingShape", &calculatorpb.GeometryRequest{Function: shape, Dimensions: []float64{1}}},
+		{"DimensionCount", &calculatorpb.GeometryRequest{Function: shape, Shape: calculatorpb.SHAPE_SHAPE_RECTANGLE, Dimensions: []float64{1}}},
+		{"NegativeDimension", &calculatorpb.GeometryRequest{Function: shape, Shape: calculatorpb.SHAPE_SHAPE_CIRCLE, Dimensions: []float64{-1}}},
+		{"FractionalSides", &calculatorpb.GeometryRequest{Function: shape, Shape: calculatorpb.SHAPE_SHAPE_REGULAR_POLYGON, Dimensions: []float64{4.5, 1}}},
+		{"ParallelogramTrapezoid", &calculatorpb.GeometryRequest{Function: shape, Shape: calculatorpb.SHAPE_SHAPE_TRAPEZOID, Dimensions: []float64{4, 4, 1, 1}}},
+		{"OpenTrapezoid", &calculatorpb.GeometryRequest{Function: shape, Shape: calculatorpb.SHAPE_SHAPE_TRAPEZOID, Dimensions: []float64{10, 4, 1, 1}}},
+		{"MissingTriangle", &calculatorpb.GeometryRequest{Function: triangle}},
+		{"SSA", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{A: 3, B: 4, Alpha: 30}}},
+		{"AAA", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{Alpha: 60, Beta: 60, Gamma: 60}}},
+		{"FourValues", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{A: 3, B: 4, C: 5, Gamma: 90}}},
+		{"NoTriangle", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{A: 1, B: 2, C: 5}}},
+		{"StraightAngle", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{A: 1, B: 2, Gamma: 180}}},
+		{"AnglesTooLarge", &calculatorpb.GeometryRequest{Function: triangle, Triangle: &calculatorpb.Triangle{Alpha: 100, Beta: 80, C: 1}}},
+		{"MissingPoint", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_DISTANCE, From: &calculatorpb.Point{}}},
+		{"TwoVertices", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_POLYGON, Polygon: &calculatorpb.Polygon{Vertices: []*calculatorpb.Point{{}, {X: 1}}}}},
+		{"CollinearPolygon", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_POLYGON, Polygon: &calculatorpb.Polygon{Vertices: []*calculatorpb.Point{{}, {X: 1}, {X: 2}}}}},
+		{"PolygonInSpace", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_POLYGON, Polygon: &calculatorpb.Polygon{Vertices: []*calculatorpb.Point{{}, {X: 1}, {Y: 1, Z: 1}}}}},
+		{"Latitude", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, Origin: &calculatorpb.GeoPoint{Latitude: 91}, Destination: &calculatorpb.GeoPoint{}}},
+		{"Radius", &calculatorpb.GeometryRequest{Function: calculatorpb.GEOMETRY_GEOMETRY_HAVERSINE, Origin: &calculatorpb.GeoPoint{}, Destination: &calculatorpb.GeoPoint{}, Radius: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Geometry(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
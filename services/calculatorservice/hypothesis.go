@@ -0,0 +1,365 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const defaultConfidence = 0.95
+
+// TTest runs a one-sample, pooled two-sample or Welch t-test
+func (c *Calculator) TTest(ctx context.Context, req *calculatorpb.TTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	confidence, err := confidenceLevel(req.Confidence)
+	if err != nil {
+		return nil, err
+	}
+	if err := finiteValues("sample_1", req.Sample_1); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("sample_2", req.Sample_2); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("mu", []float64{req.Mu}); err != nil {
+		return nil, err
+	}
+
+	var estimate, se, df float64
+	switch req.Test {
+	case calculatorpb.TTEST_TTEST_ONE_SAMPLE:
+		if len(req.Sample_1) < 2 {
+			return nil, invalidArgumentf("a one-sample t-test needs at least two values")
+		}
+		m := sampleMoments(req.Sample_1)
+		estimate = m.mean
+		se = math.Sqrt(m.variance() / m.n)
+		df = m.n - 1
+	case calculatorpb.TTEST_TTEST_TWO_SAMPLE, calculatorpb.TTEST_TTEST_WELCH:
+		if len(req.Sample_1) < 2 || len(req.Sample_2) < 2 {
+			return nil, invalidArgumentf("a two-sample t-test needs at least two values in each sample")
+		}
+		m1, m2 := sampleMoments(req.Sample_1), sampleMoments(req.Sample_2)
+		estimate = m1.mean - m2.mean
+		if req.Test == calculatorpb.TTEST_TTEST_TWO_SAMPLE {
+			df = m1.n + m2.n - 2
+			pooled := ((m1.n-1)*m1.variance() + (m2.n-1)*m2.variance()) / df
+			se = math.Sqrt(pooled * (1/m1.n + 1/m2.n))
+		} else {
+			v1, v2 := m1.variance()/m1.n, m2.variance()/m2.n
+			se = math.Sqrt(v1 + v2)
+			df = (v1 + v2) * (v1 + v2) / (v1*v1/(m1.n-1) + v2*v2/(m2.n-1))
+		}
+	default:
+		return nil, invalidArgumentf("t-test kind is not supplied")
+	}
+	if se == 0 {
+		return nil, invalidArgumentf("the samples have no variance")
+	}
+
+	statistic := (estimate - req.Mu) / se
+	cdf := func(t float64) float64 { return studentTCDF(t, df) }
+	quantile := func(p float64) float64 { return studentTQuantile(p, df) }
+	lower, upper := confidenceBounds(estimate, se, confidence, req.Alternative, quantile)
+	return &calculatorpb.HypothesisTestResponse{
+		Statistic:        statistic,
+		DegreesOfFreedom: df,
+		PValue:           pValue(statistic, req.Alternative, cdf),
+		Estimate:         estimate,
+		ConfidenceInterval: &calculatorpb.ConfidenceInterval{
+			Lower:      lower,
+			Upper:      upper,
+			Confidence: confidence,
+		},
+	}, nil
+}
+
+// ChiSquareTest runs Pearson's chi-square goodness-of-fit or independence test.
+// Its estimate is the effect size, Cohen's w or Cramér's V, of the
+// noncentrality of the statistic, with the interval from inverting the
+// noncentral chi-square distribution.
+func (c *Calculator) ChiSquareTest(ctx context.Context, req *calculatorpb.ChiSquareTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	confidence, err := confidenceLevel(req.Confidence)
+	if err != nil {
+		return nil, err
+	}
+	// the noncentrality is n*k times the square of the effect size
+	var statistic, df, n, k float64
+	switch req.Test {
+	case calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_GOODNESS_OF_FIT:
+		observed, expected := req.Observed, req.Expected
+		if len(observed) < 2 {
+			return nil, invalidArgumentf("a goodness-of-fit test needs at least two categories")
+		}
+		if len(expected) == 0 {
+			expected = make([]float64, len(observed))
+			for i := range expected {
+				expected[i] = 1
+			}
+		}
+		if len(expected) != len(observed) {
+			return nil, invalidArgumentf("got %d expected values for %d observed categories", len(expected), len(observed))
+		}
+		observedTotal, err := countsTotal(observed)
+		if err != nil {
+			return nil, err
+		}
+		expectedTotal, err := countsTotal(expected)
+		if err != nil {
+			return nil, err
+		}
+		for i, o := range observed {
+			e := expected[i] * observedTotal / expectedTotal
+			if e == 0 {
+				return nil, invalidArgumentf("expected count of category %d is zero", i)
+			}
+			statistic += (o - e) * (o - e) / e
+		}
+		df = float64(len(observed) - 1)
+		n, k = observedTotal, 1
+	case calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE:
+		rows := len(req.Table)
+		if rows < 2 {
+			return nil, invalidArgumentf("an independence test needs at least two rows")
+		}
+		cols := len(req.Table[0].GetValues())
+		if cols < 2 {
+			return nil, invalidArgumentf("an independence test needs at least two columns")
+		}
+		rowTotals := make([]float64, rows)
+		colTotals := make([]float64, cols)
+		for i, row := range req.Table {
+			if len(row.GetValues()) != cols {
+				return nil, invalidArgumentf("row %d has %d columns, expected %d", i, len(row.GetValues()), cols)
+			}
+			if err := checkCounts(row.Values); err != nil {
+				return nil, err
+			}
+			for j, v := range row.Values {
+				rowTotals[i] += v
+				colTotals[j] += v
+			}
+		}
+		total, err := countsTotal(rowTotals)
+		if err != nil {
+			return nil, err
+		}
+		// a row or column without observations is a category that takes no
+		// part in the test
+		rows, cols = nonZero(rowTotals), nonZero(colTotals)
+		if rows < 2 || cols < 2 {
+			return nil, invalidArgumentf("an independence test needs at least two rows and two columns with counts")
+		}
+		for i, row := range req.Table {
+			for j, o := range row.Values {
+				if e := rowTotals[i] * colTotals[j] / total; e != 0 {
+					statistic += (o - e) * (o - e) / e
+				}
+			}
+		}
+		df = float64((rows - 1) * (cols - 1))
+		n, k = total, math.Min(float64(rows), float64(cols))-1
+	default:
+		return nil, invalidArgumentf("chi-square test kind is not supplied")
+	}
+
+	// the noncentralities for which the statistic is within the middle
+	// confidence of its distribution, where the survival function rises from 0
+	survival := func(lambda float64) float64 { return 1 - noncentralChiSquareCDF(statistic, df, lambda) }
+	noncentrality := func(p float64) float64 {
+		if survival(0) >= p {
+			return 0
+		}
+		return invertCDF(survival, p, 0, math.Inf(1))
+	}
+	lower, upper := noncentrality((1-confidence)/2), noncentrality(1-(1-confidence)/2)
+	effect := func(lambda float64) float64 { return math.Sqrt(lambda / (n * k)) }
+	return &calculatorpb.HypothesisTestResponse{
+		Statistic:        statistic,
+		DegreesOfFreedom: df,
+		PValue:           chiSquareSurvival(statistic, df),
+		Estimate:         effect(math.Max(0, statistic-df)),
+		ConfidenceInterval: &calculatorpb.ConfidenceInterval{
+			Lower:      effect(lower),
+			Upper:      effect(upper),
+			Confidence: confidence,
+		},
+	}, nil
+}
+
+// Correlation computes the Pearson or Spearman correlation coefficient and tests it against zero
+func (c *Calculator) Correlation(ctx context.Context, req *calculatorpb.CorrelationRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	confidence, err := confidenceLevel(req.Confidence)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.X) != len(req.Y) {
+		return nil, invalidArgumentf("x has %d values but y has %d", len(req.X), len(req.Y))
+	}
+	if len(req.X) < 4 {
+		return nil, invalidArgumentf("a correlation test needs at least four pairs")
+	}
+	if err := finiteValues("x", req.X); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("y", req.Y); err != nil {
+		return nil, err
+	}
+
+	x, y := req.X, req.Y
+	// the standard error of Fisher's z, with the Fieller correction for ranks
+	zVariance := 1.0
+	switch req.Method {
+	case calculatorpb.CORRELATION_CORRELATION_PEARSON:
+	case calculatorpb.CORRELATION_CORRELATION_SPEARMAN:
+		x, y = ranks(x), ranks(y)
+		zVariance = 1.06
+	default:
+		return nil, invalidArgumentf("correlation method is not supplied")
+	}
+
+	r, err := pearson(x, y)
+	if err != nil {
+		return nil, err
+	}
+	n := float64(len(x))
+	df := n - 2
+	statistic := math.Inf(1)
+	if r < 0 {
+		statistic = math.Inf(-1)
+	}
+	if math.Abs(r) < 1 {
+		statistic = r * math.Sqrt(df/(1-r*r))
+	}
+
+	se := math.Sqrt(zVariance / (n - 3))
+	lower, upper := confidenceBounds(math.Atanh(r), se, confidence, req.Alternative, normalQuantile)
+	return &calculatorpb.HypothesisTestResponse{
+		Statistic:        statistic,
+		DegreesOfFreedom: df,
+		PValue:           pValue(statistic, req.Alternative, func(t float64) float64 { return studentTCDF(t, df) }),
+		Estimate:         r,
+		ConfidenceInterval: &calculatorpb.ConfidenceInterval{
+			Lower:      math.Tanh(lower),
+			Upper:      math.Tanh(upper),
+			Confidence: confidence,
+		},
+	}, nil
+}
+
+func confidenceLevel(confidence float64) (float64, error) {
+	if confidence == 0 {
+		return defaultConfidence, nil
+	}
+	if !(confidence > 0 && confidence < 1) {
+		return 0, invalidArgumentf("confidence %v must be within (0, 1)", confidence)
+	}
+	return confidence, nil
+}
+
+// confidenceBounds returns the interval of a symmetric estimate, quantile is the
+// inverse CDF of its standardised sampling distribution
+func confidenceBounds(estimate, se, confidence float64, alternative calculatorpb.ALTERNATIVE, quantile func(float64) float64) (lower, upper float64) {
+	switch alternative {
+	case calculatorpb.ALTERNATIVE_ALTERNATIVE_LESS:
+		return math.Inf(-1), estimate + quantile(confidence)*se
+	case calculatorpb.ALTERNATIVE_ALTERNATIVE_GREATER:
+		return estimate - quantile(confidence)*se, math.Inf(1)
+	default:
+		margin := quantile(1-(1-confidence)/2) * se
+		return estimate - margin, estimate + margin
+	}
+}
+
+// pValue of a statistic with a symmetric null distribution given by cdf
+func pValue(statistic float64, alternative calculatorpb.ALTERNATIVE, cdf func(float64) float64) float64 {
+	switch alternative {
+	case calculatorpb.ALTERNATIVE_ALTERNATIVE_LESS:
+		return cdf(statistic)
+	case calculatorpb.ALTERNATIVE_ALTERNATIVE_GREATER:
+		return cdf(-statistic)
+	default:
+		return math.Min(1, 2*cdf(-math.Abs(statistic)))
+	}
+}
+
+func sampleMoments(values []float64) moments {
+	var m moments
+	for _, v := range values {
+		m.add(v)
+	}
+	return m
+}
+
+func countsTotal(counts []float64) (float64, error) {
+	if err := checkCounts(counts); err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, v := range counts {
+		total += v
+	}
+	if total == 0 {
+		return 0, invalidArgumentf("counts must not all be zero")
+	}
+	return total, nil
+}
+
+func checkCounts(counts []float64) error {
+	for _, v := range counts {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return invalidArgumentf("count %v must be a finite, non negative number", v)
+		}
+	}
+	return nil
+}
+
+// nonZero counts the values that are not zero
+func nonZero(values []float64) int {
+	n := 0
+	for _, v := range values {
+		if v != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func pearson(x, y []float64) (float64, error) {
+	mx, my := sampleMoments(x), sampleMoments(y)
+	var sxy, sxx, syy float64
+	for i := range x {
+		dx, dy := x[i]-mx.mean, y[i]-my.mean
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0, invalidArgumentf("correlation is undefined for a constant sample")
+	}
+	return sxy / math.Sqrt(sxx*syy), nil
+}
+
+// ranks returns the 1-based ranks of values, ties get the average of their ranks
+func ranks(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+
+	result := make([]float64, len(values))
+	for i := 0; i < len(order); {
+		j := i
+		for j+1 < len(order) && values[order[j+1]] == values[order[i]] {
+			j++
+		}
+		rank := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			result[order[k]] = rank
+		}
+		i = j + 1
+	}
+	return result
+}
@@ -0,0 +1,230 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+// the sleep data set from R, extra hours of sleep for two drugs
+var (
+	sleepGroup1 = []float64{0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0}
+	sleepGroup2 = []float64{1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4}
+)
+
+func Test_TTest(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.TTestRequest
+		expected *calculatorpb.HypothesisTestResponse
+	}{
+		{
+			name:    "OneSample",
+			request: &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_ONE_SAMPLE, Sample_1: sleepGroup1},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: 1.3257, DegreesOfFreedom: 9, PValue: 0.2176, Estimate: 0.75,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: -0.5298, Upper: 2.0298},
+			},
+		},
+		{
+			name:    "TwoSamplePooled",
+			request: &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_TWO_SAMPLE, Sample_1: sleepGroup1, Sample_2: sleepGroup2},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: -1.8608, DegreesOfFreedom: 18, PValue: 0.07919, Estimate: -1.58,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: -3.3639, Upper: 0.2039},
+			},
+		},
+		{
+			name:    "Welch",
+			request: &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_WELCH, Sample_1: sleepGroup1, Sample_2: sleepGroup2},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: -1.8608, DegreesOfFreedom: 17.776, PValue: 0.07939, Estimate: -1.58,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: -3.3655, Upper: 0.2055},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.TTest(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assertHypothesisTest(t, tt.expected, res)
+		})
+	}
+}
+
+func Test_ChiSquareTest(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.ChiSquareTestRequest
+		expected *calculatorpb.HypothesisTestResponse
+	}{
+		{
+			name: "GoodnessOfFitUniform",
+			request: &calculatorpb.ChiSquareTestRequest{
+				Test:     calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_GOODNESS_OF_FIT,
+				Observed: []float64{16, 18, 16, 14, 12, 12},
+			},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: 2, DegreesOfFreedom: 5, PValue: 0.84915, Estimate: 0,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: 0, Upper: 0.2336},
+			},
+		},
+		{
+			name: "Independence",
+			request: &calculatorpb.ChiSquareTestRequest{
+				Test: calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE,
+				Table: []*calculatorpb.DoubleRow{
+					{Values: []float64{762, 327, 468}},
+					{Values: []float64{484, 239, 477}},
+				},
+			},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: 30.0701, DegreesOfFreedom: 2, PValue: 2.954e-07, Estimate: 0.1009,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: 0.0649, Upper: 0.1403},
+			},
+		},
+		{
+			// a category without observations takes no part in the test
+			name: "IndependenceEmptyRowAndColumn",
+			request: &calculatorpb.ChiSquareTestRequest{
+				Test: calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE,
+				Table: []*calculatorpb.DoubleRow{
+					{Values: []float64{762, 0, 327, 468}},
+					{Values: []float64{0, 0, 0, 0}},
+					{Values: []float64{484, 0, 239, 477}},
+				},
+			},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: 30.0701, DegreesOfFreedom: 2, PValue: 2.954e-07, Estimate: 0.1009,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: 0.0649, Upper: 0.1403},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.ChiSquareTest(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assertHypothesisTest(t, tt.expected, res)
+		})
+	}
+
+	// a thousand times the counts give a narrow interval around the effect
+	res, err := calculatorSvc.ChiSquareTest(context.Background(), &calculatorpb.ChiSquareTestRequest{
+		Test: calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE,
+		Table: []*calculatorpb.DoubleRow{
+			{Values: []float64{762000, 327000, 468000}},
+			{Values: []float64{484000, 239000, 477000}},
+		},
+		Confidence: 0.99,
+	})
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.1044, res.Estimate, 1e-4)
+	assert.InDelta(t, res.Estimate, res.ConfidenceInterval.Lower, 0.002)
+	assert.InDelta(t, res.Estimate, res.ConfidenceInterval.Upper, 0.002)
+	assert.Less(t, res.ConfidenceInterval.Lower, res.Estimate)
+	assert.Greater(t, res.ConfidenceInterval.Upper, res.Estimate)
+	assert.Equal(t, 0.99, res.ConfidenceInterval.Confidence)
+}
+
+func Test_Correlation(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.CorrelationRequest
+		expected *calculatorpb.HypothesisTestResponse
+	}{
+		{
+			name:    "Pearson",
+			request: &calculatorpb.CorrelationRequest{Method: calculatorpb.CORRELATION_CORRELATION_PEARSON, X: sleepGroup1, Y: sleepGroup2},
+			expected: &calculatorpb.HypothesisTestResponse{
+				Statistic: 3.7090, DegreesOfFreedom: 8, PValue: 0.005965, Estimate: 0.7952,
+				ConfidenceInterval: &calculatorpb.ConfidenceInterval{Lower: 0.3315, Upper: 0.9494},
+			},
+		},
+		{
+			name: "SpearmanMonotone",
+			request: &calculatorpb.CorrelationRequest{
+				Method: calculatorpb.CORRELATION_CORRELATION_SPEARMAN,
+				X:      []float64{1, 2, 3, 4, 5, 6},
+				Y:      []float64{1, 4, 9, 16, 25, 36},
+			},
+			expected: &calculatorpb.HypothesisTestResponse{DegreesOfFreedom: 4, Estimate: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Correlation(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.expected.Estimate, res.Estimate, 1e-4)
+			assert.InDelta(t, tt.expected.DegreesOfFreedom, res.DegreesOfFreedom, 1e-9)
+			if tt.expected.ConfidenceInterval != nil {
+				assertHypothesisTest(t, tt.expected, res)
+			}
+		})
+	}
+}
+
+func Test_HypothesisTestErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	ctx := context.Background()
+
+	_, err := calculatorSvc.TTest(ctx, &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_ONE_SAMPLE, Sample_1: []float64{1}})
+	assert.NotNil(t, err)
+	_, err = calculatorSvc.TTest(ctx, &calculatorpb.TTestRequest{Sample_1: sleepGroup1})
+	assert.NotNil(t, err)
+	_, err = calculatorSvc.ChiSquareTest(ctx, &calculatorpb.ChiSquareTestRequest{
+		Test:     calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_GOODNESS_OF_FIT,
+		Observed: []float64{1, -2},
+	})
+	assert.NotNil(t, err)
+	// a single row with counts is left once the empty one is dropped
+	_, err = calculatorSvc.ChiSquareTest(ctx, &calculatorpb.ChiSquareTestRequest{
+		Test:  calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_INDEPENDENCE,
+		Table: []*calculatorpb.DoubleRow{{Values: []float64{1, 2}}, {Values: []float64{0, 0}}},
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.Correlation(ctx, &calculatorpb.CorrelationRequest{Method: calculatorpb.CORRELATION_CORRELATION_PEARSON, X: []float64{1, 2}, Y: []float64{1}})
+	assert.NotNil(t, err)
+
+	// non-finite confidences and values would give NaN statistics
+	_, err = calculatorSvc.ChiSquareTest(ctx, &calculatorpb.ChiSquareTestRequest{
+		Test: calculatorpb.CHI_SQUARE_TEST_CHI_SQUARE_TEST_GOODNESS_OF_FIT, Observed: []float64{1, 2}, Confidence: math.NaN(),
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.TTest(ctx, &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_ONE_SAMPLE, Sample_1: sleepGroup1, Confidence: math.NaN()})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.TTest(ctx, &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_WELCH, Sample_1: sleepGroup1, Sample_2: []float64{1, math.NaN(), 3}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.TTest(ctx, &calculatorpb.TTestRequest{Test: calculatorpb.TTEST_TTEST_ONE_SAMPLE, Sample_1: []float64{1, 2, math.Inf(1)}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.Correlation(ctx, &calculatorpb.CorrelationRequest{Method: calculatorpb.CORRELATION_CORRELATION_SPEARMAN,
+		X: []float64{1, 2, 3, 4}, Y: []float64{1, math.NaN(), 3, 4}})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+	_, err = calculatorSvc.Correlation(ctx, &calculatorpb.CorrelationRequest{Method: calculatorpb.CORRELATION_CORRELATION_PEARSON,
+		X: []float64{1, 2, 3, 4}, Y: []float64{1, 3, 2, 4}, Confidence: math.NaN()})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+}
+
+func assertHypothesisTest(t *testing.T, expected, actual *calculatorpb.HypothesisTestResponse) {
+	t.Helper()
+	assert.InDelta(t, expected.Statistic, actual.Statistic, 1e-3)
+	assert.InDelta(t, expected.DegreesOfFreedom, actual.DegreesOfFreedom, 1e-3)
+	assert.InEpsilon(t, expected.PValue, actual.PValue, 1e-3)
+	assert.InDelta(t, expected.Estimate, actual.Estimate, 1e-4)
+	if expected.ConfidenceInterval != nil {
+		assert.InDelta(t, expected.ConfidenceInterval.Lower, actual.ConfidenceInterval.Lower, 1e-3)
+		assert.InDelta(t, expected.ConfidenceInterval.Upper, actual.ConfidenceInterval.Upper, 1e-3)
+	}
+}
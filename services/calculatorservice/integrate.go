@@ -0,0 +1,373 @@
+package calculatorservice
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultEvaluationBudget = 1000000
+	defaultNumericTolerance = 1e-10
+	maxSimpsonDepth         = 50
+	// minSimpsonDepth splits every interval a few times first, so that samples
+	// of an oscillating integrand that agree by chance don't end the recursion
+	minSimpsonDepth     = 4
+	evaluationsPerCheck = 1024
+	// a tail next to an infinite bound whose contribution shrinks by less
+	// than tailShrink when bisected, tailStalls times in a row, is taken to
+	// diverge, or to converge too slowly to be reached in float64
+	tailShrink = 0.9
+	tailStalls = 8
+)
+
+// gaussKronrodNodes are the nodes of the 15-point Kronrod rule on [-1, 1]
+// from the outside in, the odd ones are the nodes of the 7-point Gauss rule
+var (
+	gaussKronrodNodes = [8]float64{
+		0.991455371120812639206854697526329, 0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926, 0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013, 0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245, 0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970, 0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518, 0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550, 0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649, 0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082, 0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975, 0.417959183673469387755102040816327,
+	}
+)
+
+// budgetedFunction is an expression as a function of one variable, which
+// counts its evaluations against a budget and stops when the request is done
+type budgetedFunction struct {
+	ctx      context.Context
+	e        expr
+	variable string
+	values   map[string]float64
+	budget   uint64
+	count    uint64
+}
+
+// expressionFunction parses an expression in variable, default
+// defaultVariable, with values for its other variables and a budget of
+// maxEvaluations
+func (c *Calculator) expressionFunction(ctx context.Context, expression, variable, defaultVariable string, values map[string]float64, maxEvaluations uint64) (*budgetedFunction, error) {
+	e, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if variable == "" {
+		variable = defaultVariable
+	}
+	if _, ok := exprConstants[variable]; ok {
+		return nil, invalidArgumentf("%s is a constant and can not be the variable", variable)
+	}
+	budget, err := c.budget(maxEvaluations)
+	if err != nil {
+		return nil, err
+	}
+	f := &budgetedFunction{ctx: ctx, e: e, variable: variable, values: map[string]float64{}, budget: budget}
+	for name, v := range values {
+		if _, ok := exprConstants[name]; ok {
+			return nil, invalidArgumentf("%s is a constant and can not be given a value", name)
+		}
+		if name == variable {
+			return nil, invalidArgumentf("the variable %s can not be given a value", name)
+		}
+		f.values[name] = v
+	}
+	vars := map[string]bool{}
+	exprVariables(e, vars)
+	for name := range vars {
+		if _, ok := f.values[name]; !ok && name != variable {
+			return nil, invalidArgumentf("variable %s has no value", name)
+		}
+	}
+	return f, nil
+}
+
+// budget is the evaluations a request may take, maxEvaluations, default and
+// at most the evaluation budget of the service
+func (c *Calculator) budget(maxEvaluations uint64) (uint64, error) {
+	if maxEvaluations > c.evaluationBudget {
+		return 0, invalidArgumentf("max_evaluations %d is above the limit of %d", maxEvaluations, c.evaluationBudget)
+	}
+	if maxEvaluations == 0 {
+		return c.evaluationBudget, nil
+	}
+	return maxEvaluations, nil
+}
+
+// eval computes the expression at x, which must give a finite value
+func (f *budgetedFunction) eval(x float64) (float64, error) {
+	if f.count == f.budget {
+		return 0, fmt.Errorf("%w: more than %d evaluations", ErrBudgetExceeded, f.budget)
+	}
+	if f.count%evaluationsPerCheck == 0 {
+		if err := f.ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+	f.count++
+	f.values[f.variable] = x
+	v, _ := evalExpr(f.e, f.values)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, invalidArgumentf("%s is %v at %s = %v", f.e, v, f.variable, x)
+	}
+	return v, nil
+}
+
+// Integrate computes a definite integral by adaptive quadrature
+func (c *Calculator) Integrate(ctx context.Context, req *calculatorpb.IntegrateRequest) (*calculatorpb.IntegrateResponse, error) {
+	f, err := c.expressionFunction(ctx, req.Expression, req.Variable, "x", req.Values, req.MaxEvaluations)
+	if err != nil {
+		return nil, err
+	}
+	tolerance := req.Tolerance
+	if tolerance == 0 {
+		tolerance = defaultNumericTolerance
+	}
+	if !(tolerance > 0) || math.IsInf(tolerance, 0) {
+		return nil, invalidArgumentf("tolerance %v must be positive", req.Tolerance)
+	}
+	if req.Method != calculatorpb.QUADRATURE_QUADRATURE_SIMPSON && req.Method != calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD {
+		return nil, invalidArgumentf("quadrature method is not supplied")
+	}
+	a, b := req.Lower, req.Upper
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return nil, invalidArgumentf("bounds must be numbers")
+	}
+	if a == b {
+		return &calculatorpb.IntegrateResponse{Converged: true}, nil
+	}
+	sign := 1.0
+	if a > b {
+		a, b, sign = b, a, -1
+	}
+
+	infiniteLower, infiniteUpper := math.IsInf(a, -1), math.IsInf(b, 1)
+	// an infinite range maps to a finite one by x = t/(1-t^2) over -1 to 1,
+	// x = a + t/(1-t) over 0 to 1 or x = b - (1-t)/t over 0 to 1
+	integrand := f.eval
+	switch {
+	case infiniteLower && infiniteUpper:
+		integrand, a, b = changeOfVariable(f.eval, func(t float64) (float64, float64) {
+			s := 1 - t*t
+			return t / s, (1 + t*t) / (s * s)
+		}), -1, 1
+	case infiniteUpper:
+		lower := a
+		integrand, a, b = changeOfVariable(f.eval, func(t float64) (float64, float64) {
+			return lower + t/(1-t), 1 / ((1 - t) * (1 - t))
+		}), 0, 1
+	case infiniteLower:
+		upper := b
+		integrand, a, b = changeOfVariable(f.eval, func(t float64) (float64, float64) {
+			return upper - (1-t)/t, 1 / (t * t)
+		}), 0, 1
+	}
+
+	var value, estimate float64
+	var converged bool
+	if req.Method == calculatorpb.QUADRATURE_QUADRATURE_SIMPSON {
+		value, estimate, converged, err = adaptiveSimpson(integrand, a, b, tolerance)
+	} else {
+		value, estimate, converged, err = adaptiveGaussKronrod(integrand, a, b, tolerance, infiniteLower, infiniteUpper)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &calculatorpb.IntegrateResponse{
+		Value:       sign * value,
+		Error:       estimate,
+		Converged:   converged,
+		Evaluations: f.count,
+	}, nil
+}
+
+// changeOfVariable is the integrand f(x(t))*dx/dt. Where x is infinite, at
+// the bounds of t, it is taken a step of machine epsilon towards 0.5, which
+// is inside every range of t.
+func changeOfVariable(f func(float64) (float64, error), change func(t float64) (float64, float64)) func(float64) (float64, error) {
+	return func(t float64) (float64, error) {
+		x, dx := change(t)
+		if math.IsInf(x, 0) || math.IsInf(dx, 0) || math.IsNaN(x) {
+			x, dx = change(t + (0.5-t)*machineEpsilon)
+		}
+		v, err := f(x)
+		return v * dx, err
+	}
+}
+
+// adaptiveSimpson integrates f over a to b, splitting the intervals whose
+// Simpson's rule differs from that of their halves by more than their share
+// of the tolerance
+func adaptiveSimpson(f func(float64) (float64, error), a, b, tolerance float64) (float64, float64, bool, error) {
+	m := a + (b-a)/2
+	var fa, fm, fb float64
+	for _, p := range []struct {
+		x   float64
+		dst *float64
+	}{{a, &fa}, {m, &fm}, {b, &fb}} {
+		v, err := f(p.x)
+		if errors.Is(err, ErrInvalidArgument) && p.x != m {
+			// an integrable singularity at a bound is sampled a step of
+			// machine epsilon inside the range instead, as the open
+			// Gauss-Kronrod rule never reaches it
+			v, err = f(p.x + (m-p.x)*machineEpsilon)
+		}
+		if err != nil {
+			return 0, 0, false, err
+		}
+		*p.dst = v
+	}
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	s := &simpson{f: f, converged: true}
+	value, err := s.integrate(a, b, fa, fm, fb, whole, tolerance*math.Max(1, math.Abs(whole)), maxSimpsonDepth)
+	return value, s.err, s.converged, err
+}
+
+type simpson struct {
+	f         func(float64) (float64, error)
+	err       float64
+	converged bool
+}
+
+func (s *simpson) integrate(a, b, fa, fm, fb, whole, tolerance float64, depth int) (float64, error) {
+	m := a + (b-a)/2
+	lm, rm := a+(m-a)/2, m+(b-m)/2
+	flm, err := s.f(lm)
+	if err != nil {
+		return 0, err
+	}
+	frm, err := s.f(rm)
+	if err != nil {
+		return 0, err
+	}
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	delta := left + right - whole
+	// the halves can not split below adjacent floats
+	done := math.Abs(delta) <= 15*tolerance && depth <= maxSimpsonDepth-minSimpsonDepth
+	if !done && (depth == 0 || lm <= a || rm >= b) {
+		done, s.converged = true, false
+	}
+	if done {
+		// Richardson extrapolation of the two estimates
+		s.err += math.Abs(delta) / 15
+		return left + right + delta/15, nil
+	}
+	l, err := s.integrate(a, m, fa, flm, fm, left, tolerance/2, depth-1)
+	if err != nil {
+		return 0, err
+	}
+	r, err := s.integrate(m, b, fm, frm, fb, right, tolerance/2, depth-1)
+	return l + r, err
+}
+
+// quadratureInterval is a part of the range with its Gauss-Kronrod estimate,
+// and for a tail the times in a row its contribution didn't shrink when bisected
+type quadratureInterval struct {
+	a, b, value, err float64
+	stalls           int
+}
+
+// intervalHeap orders intervals by decreasing error
+type intervalHeap []quadratureInterval
+
+func (h intervalHeap) Len() int            { return len(h) }
+func (h intervalHeap) Less(i, j int) bool  { return h[i].err > h[j].err }
+func (h intervalHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *intervalHeap) Push(x interface{}) { *h = append(*h, x.(quadratureInterval)) }
+func (h *intervalHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// adaptiveGaussKronrod integrates f over a to b, bisecting the interval with
+// the largest error until the total error is within tolerance. An infinite
+// bound mapped to a or b makes the interval next to it a tail, which must
+// keep shrinking as it is bisected: else the integral diverges, and the
+// relative tolerance would accept whatever the bisection reached when it ran
+// out of floats.
+func adaptiveGaussKronrod(f func(float64) (float64, error), a, b, tolerance float64, infiniteA, infiniteB bool) (float64, float64, bool, error) {
+	first, err := gaussKronrod(f, a, b)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	intervals := &intervalHeap{first}
+	value, total := first.value, first.err
+	for total > tolerance*math.Max(1, math.Abs(value)) {
+		worst := heap.Pop(intervals).(quadratureInterval)
+		m := worst.a + (worst.b-worst.a)/2
+		if m <= worst.a || m >= worst.b {
+			heap.Push(intervals, worst)
+			return value, total, false, nil
+		}
+		left, err := gaussKronrod(f, worst.a, m)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		right, err := gaussKronrod(f, m, worst.b)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if (infiniteA && left.a == a && stalled(&left, worst)) || (infiniteB && right.b == b && stalled(&right, worst)) {
+			// what lies beyond the tail is at least what it held
+			return value + left.value + right.value - worst.value, total + math.Abs(worst.value), false, nil
+		}
+		heap.Push(intervals, left)
+		heap.Push(intervals, right)
+		value += left.value + right.value - worst.value
+		total += left.err + right.err - worst.err
+	}
+	return value, total, true, nil
+}
+
+// stalled counts whether the tail bisected from parent shrank by less than
+// tailShrink, and reports when that happened tailStalls times in a row
+func stalled(tail *quadratureInterval, parent quadratureInterval) bool {
+	if math.Abs(tail.value) <= tailShrink*math.Abs(parent.value) {
+		return false
+	}
+	tail.stalls = parent.stalls + 1
+	return tail.stalls == tailStalls
+}
+
+// gaussKronrod is the 15-point Kronrod rule over a to b, with the difference
+// from the embedded 7-point Gauss rule as its error
+func gaussKronrod(f func(float64) (float64, error), a, b float64) (quadratureInterval, error) {
+	center, half := a+(b-a)/2, (b-a)/2
+	var kronrod, gauss float64
+	for i, node := range gaussKronrodNodes {
+		points := []float64{center - half*node, center + half*node}
+		if node == 0 {
+			points = points[:1]
+		}
+		for _, x := range points {
+			// the nodes are inside the interval, but may round onto its
+			// bounds where it is a few floats wide
+			x = math.Max(math.Nextafter(a, b), math.Min(math.Nextafter(b, a), x))
+			v, err := f(x)
+			if err != nil {
+				return quadratureInterval{}, err
+			}
+			kronrod += kronrodWeights[i] * v
+			if i%2 == 1 {
+				gauss += gaussWeights[i/2] * v
+			}
+		}
+	}
+	return quadratureInterval{a: a, b: b, value: kronrod * half, err: math.Abs(kronrod-gauss) * half}, nil
+}
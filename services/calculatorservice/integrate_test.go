@@ -0,0 +1,174 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Integrate(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		expression string
+		values     map[string]float64
+		lower      float64
+		upper      float64
+		expected   float64
+		singular   bool
+	}{
+		{"Sine", "sin(x)", nil, 0, math.Pi, 2, false},
+		{"Polynomial", "3x^2 + 1", nil, -1, 2, 12, false},
+		{"ReversedBounds", "x^2", nil, 3, 0, -9, false},
+		{"EmptyRange", "x^2", nil, 1, 1, 0, false},
+		{"Kink", "abs(x - 1/3)", nil, 0, 1, 5.0 / 18, false},
+		{"Parameter", "exp(-k*x)", map[string]float64{"k": 2}, 0, 1, (1 - math.Exp(-2)) / 2, false},
+		{"Gaussian", "exp(-x^2)", nil, math.Inf(-1), math.Inf(1), math.Sqrt(math.Pi), false},
+		{"UpperInfinite", "1/(1 + x^2)", nil, 0, math.Inf(1), math.Pi / 2, false},
+		{"LowerInfinite", "exp(x)", nil, math.Inf(-1), 0, 1, false},
+		{"SlowTail", "x*exp(-x/4)", nil, 0, math.Inf(1), 16, false},
+		{"PeakInTail", "exp(-(x - 10)^2)", nil, 0, math.Inf(1), math.Sqrt(math.Pi) * (1 + math.Erf(10)) / 2, false},
+		{"SingularAtBound", "1/sqrt(x)", nil, 0, 1, 2, true},
+		{"LogarithmicSingularity", "ln(x)", nil, 0, 1, -1, true},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []calculatorpb.QUADRATURE{calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD} {
+			t.Run(tt.name+"/"+method.String(), func(t *testing.T) {
+				res, err := calculatorSvc.Integrate(context.Background(), &calculatorpb.IntegrateRequest{
+					Method:     method,
+					Expression: tt.expression,
+					Values:     tt.values,
+					Lower:      tt.lower,
+					Upper:      tt.upper,
+				})
+				assert.Nil(t, err)
+				if tt.singular && method == calculatorpb.QUADRATURE_QUADRATURE_SIMPSON {
+					// Simpson's rule converges slowly at a singularity, but its
+					// error estimate holds
+					assert.LessOrEqual(t, math.Abs(res.Value-tt.expected), res.Error)
+					return
+				}
+				assert.True(t, res.Converged)
+				assert.InDelta(t, tt.expected, res.Value, 1e-9)
+				assert.LessOrEqual(t, res.Error, 1e-9)
+			})
+		}
+	}
+}
+
+func Test_IntegrateSingularAtUpperBound(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	for _, method := range []calculatorpb.QUADRATURE{calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD} {
+		t.Run(method.String(), func(t *testing.T) {
+			res, err := calculatorSvc.Integrate(context.Background(), &calculatorpb.IntegrateRequest{
+				Method: method, Expression: "1/sqrt(1 - x)", Lower: 0, Upper: 1,
+			})
+			assert.Nil(t, err)
+			// the floats next to 1 are too far apart to resolve the
+			// singularity as closely as next to 0
+			assert.InDelta(t, 2, res.Value, math.Max(res.Error, 1e-7))
+		})
+	}
+}
+
+func Test_IntegrateTolerance(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	integrate := func(method calculatorpb.QUADRATURE, tolerance float64) *calculatorpb.IntegrateResponse {
+		res, err := calculatorSvc.Integrate(context.Background(), &calculatorpb.IntegrateRequest{
+			Method: method, Expression: "exp(-x)*cos(5x)", Lower: 0, Upper: 10, Tolerance: tolerance,
+		})
+		assert.Nil(t, err)
+		return res
+	}
+	expected := (1 - math.Exp(-10)*(math.Cos(50)-5*math.Sin(50))) / 26
+	for _, method := range []calculatorpb.QUADRATURE{calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD} {
+		t.Run(method.String(), func(t *testing.T) {
+			precise, loose := integrate(method, 1e-12), integrate(method, 1e-4)
+			assert.InDelta(t, expected, precise.Value, 1e-11)
+			assert.InDelta(t, expected, loose.Value, 1e-4)
+			assert.Less(t, loose.Evaluations, precise.Evaluations)
+		})
+	}
+}
+
+func Test_IntegrateBudget(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithEvaluationBudget(1000))
+	request := &calculatorpb.IntegrateRequest{
+		Method: calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD, Expression: "sin(1/x)", Lower: 1e-6, Upper: 1,
+	}
+	_, err := calculatorSvc.Integrate(context.Background(), request)
+	assert.True(t, errors.Is(err, calculatorservice.ErrBudgetExceeded), "unexpected error %v", err)
+
+	request.MaxEvaluations = 2000
+	_, err = calculatorSvc.Integrate(context.Background(), request)
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+
+	request.MaxEvaluations = 10
+	_, err = calculatorSvc.Integrate(context.Background(), request)
+	assert.True(t, errors.Is(err, calculatorservice.ErrBudgetExceeded), "unexpected error %v", err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = calculatorSvc.Integrate(ctx, &calculatorpb.IntegrateRequest{
+		Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "x", Lower: 0, Upper: 1,
+	})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
+
+func Test_IntegrateErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.IntegrateRequest
+	}{
+		{"MissingMethod", &calculatorpb.IntegrateRequest{Expression: "x", Upper: 1}},
+		{"MissingValue", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "a*x", Upper: 1}},
+		{"ValueOfVariable", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "x", Upper: 1, Values: map[string]float64{"x": 1}}},
+		{"ConstantVariable", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "e", Variable: "e", Upper: 1}},
+		{"NaNBound", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "x", Upper: math.NaN()}},
+		{"NegativeTolerance", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "x", Upper: 1, Tolerance: -1}},
+		{"PoleInside", &calculatorpb.IntegrateRequest{Method: calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, Expression: "1/x", Lower: -1, Upper: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Integrate(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
+
+func Test_IntegrateDivergent(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		expression string
+		lower      float64
+		upper      float64
+	}{
+		{"Harmonic", "1/x", 1, math.Inf(1)},
+		{"Constant", "1", 0, math.Inf(1)},
+		{"ConstantBothInfinite", "1", math.Inf(-1), math.Inf(1)},
+		{"LowerInfinite", "1/(1 - x)", math.Inf(-1), 0},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []calculatorpb.QUADRATURE{calculatorpb.QUADRATURE_QUADRATURE_SIMPSON, calculatorpb.QUADRATURE_QUADRATURE_GAUSS_KRONROD} {
+			t.Run(tt.name+"/"+method.String(), func(t *testing.T) {
+				res, err := calculatorSvc.Integrate(context.Background(), &calculatorpb.IntegrateRequest{
+					Method: method, Expression: tt.expression, Lower: tt.lower, Upper: tt.upper,
+				})
+				assert.Nil(t, err)
+				assert.False(t, res.Converged)
+			})
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// maxPolynomialPoints bounds the points of polynomial interpolation, whose
+// polynomial of a high degree swings wildly between the points anyway
+const maxPolynomialPoints = 100
+
+// Interpolate computes values between tabulated points
+func (c *Calculator) Interpolate(ctx context.Context, req *calculatorpb.InterpolateRequest) (*calculatorpb.InterpolateResponse, error) {
+	xs, ys, err := sortedPoints(req.X, req.Y)
+	if err != nil {
+		return nil, err
+	}
+	if len(xs) < 2 {
+		return nil, invalidArgumentf("interpolation needs at least 2 points")
+	}
+	var f func(x float64) float64
+	switch req.Method {
+	case calculatorpb.INTERPOLATION_INTERPOLATION_LINEAR:
+		f = func(x float64) float64 {
+			i := interval(xs, x)
+			return ys[i] + (x-xs[i])*(ys[i+1]-ys[i])/(xs[i+1]-xs[i])
+		}
+	case calculatorpb.INTERPOLATION_INTERPOLATION_POLYNOMIAL:
+		if len(xs) > maxPolynomialPoints {
+			return nil, invalidArgumentf("polynomial interpolation takes at most %d points", maxPolynomialPoints)
+		}
+		f = barycentricInterpolation(xs, ys)
+	case calculatorpb.INTERPOLATION_INTERPOLATION_SPLINE:
+		f = hermiteInterpolation(xs, ys, splineSlopes(xs, ys))
+	case calculatorpb.INTERPOLATION_INTERPOLATION_PCHIP:
+		f = hermiteInterpolation(xs, ys, pchipSlopes(xs, ys))
+	default:
+		return nil, invalidArgumentf("interpolation method is not supplied")
+	}
+
+	res := &calculatorpb.InterpolateResponse{Values: make([]float64, len(req.At))}
+	for i, x := range req.At {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, invalidArgumentf("%v is not a finite x", x)
+		}
+		if !req.Extrapolate && (x < xs[0] || x > xs[len(xs)-1]) {
+			return nil, invalidArgumentf("%v is outside the points [%v, %v]", x, xs[0], xs[len(xs)-1])
+		}
+		res.Values[i] = f(x)
+	}
+	return res, nil
+}
+
+// sortedPoints are the points of x and y in the order of x, which must not
+// repeat
+func sortedPoints(x, y []float64) ([]float64, []float64, error) {
+	if len(x) != len(y) {
+		return nil, nil, invalidArgumentf("x and y have %d and %d values", len(x), len(y))
+	}
+	order := make([]int, len(x))
+	for i := range order {
+		if math.IsNaN(x[i]) || math.IsInf(x[i], 0) || math.IsNaN(y[i]) || math.IsInf(y[i], 0) {
+			return nil, nil, invalidArgumentf("point %d is not finite", i+1)
+		}
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return x[order[i]] < x[order[j]] })
+	xs, ys := make([]float64, len(x)), make([]float64, len(y))
+	for i, j := range order {
+		xs[i], ys[i] = x[j], y[j]
+		if i > 0 && xs[i] == xs[i-1] {
+			return nil, nil, invalidArgumentf("x %v is given twice", xs[i])
+		}
+	}
+	return xs, ys, nil
+}
+
+// interval is the i of the interval [xs[i], xs[i+1]] that holds x, the first
+// or the last for x outside
+func interval(xs []float64, x float64) int {
+	i := sort.SearchFloat64s(xs, x) - 1
+	if i < 0 {
+		return 0
+	}
+	if i > len(xs)-2 {
+		return len(xs) - 2
+	}
+	return i
+}
+
+// barycentricInterpolation is the polynomial through the points in the
+// barycentric form, which is stable where the Lagrange form is not
+func barycentricInterpolation(xs, ys []float64) func(float64) float64 {
+	// the differences are scaled by 4 over the range, so that the products
+	// of the weights stay within range, the scale cancels out
+	scale := 4 / (xs[len(xs)-1] - xs[0])
+	weights := make([]float64, len(xs))
+	for j := range xs {
+		w := 1.0
+		for k := range xs {
+			if k != j {
+				w *= (xs[j] - xs[k]) * scale
+			}
+		}
+		weights[j] = 1 / w
+	}
+	return func(x float64) float64 {
+		var numerator, denominator float64
+		for j := range xs {
+			if x == xs[j] {
+				return ys[j]
+			}
+			w := weights[j] / (x - xs[j])
+			numerator += w * ys[j]
+			denominator += w
+		}
+		return numerator / denominator
+	}
+}
+
+// hermiteInterpolation is the cubic on each interval with the values and the
+// slopes of its ends
+func hermiteInterpolation(xs, ys, slopes []float64) func(float64) float64 {
+	return func(x float64) float64 {
+		i := interval(xs, x)
+		h := xs[i+1] - xs[i]
+		t := (x - xs[i]) / h
+		// the Hermite basis, arranged to be exact where the points are level
+		return ys[i] + t*t*(3-2*t)*(ys[i+1]-ys[i]) + t*(1-t)*(1-t)*h*slopes[i] + t*t*(t-1)*h*slopes[i+1]
+	}
+}
+
+// secants are the widths and slopes of the intervals between the points
+func secants(xs, ys []float64) (h, delta []float64) {
+	h, delta = make([]float64, len(xs)-1), make([]float64, len(xs)-1)
+	for i := range h {
+		h[i] = xs[i+1] - xs[i]
+		delta[i] = (ys[i+1] - ys[i]) / h[i]
+	}
+	return h, delta
+}
+
+// splineSlopes are the slopes of the natural cubic spline, whose second
+// derivative is continuous and 0 at the ends, from its tridiagonal system
+func splineSlopes(xs, ys []float64) []float64 {
+	n := len(xs)
+	h, delta := secants(xs, ys)
+	// sub, diag and super diagonals and the right hand side
+	sub, diag, super, rhs := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	diag[0], super[0], rhs[0] = 2, 1, 3*delta[0]
+	for i := 1; i < n-1; i++ {
+		sub[i], diag[i], super[i] = h[i], 2*(h[i-1]+h[i]), h[i-1]
+		rhs[i] = 3 * (h[i]*delta[i-1] + h[i-1]*delta[i])
+	}
+	sub[n-1], diag[n-1], rhs[n-1] = 1, 2, 3*delta[n-2]
+
+	// the Thomas algorithm, the system is diagonally dominant
+	for i := 1; i < n; i++ {
+		m := sub[i] / diag[i-1]
+		diag[i] -= m * super[i-1]
+		rhs[i] -= m * rhs[i-1]
+	}
+	slopes := make([]float64, n)
+	slopes[n-1] = rhs[n-1] / diag[n-1]
+	for i := n - 2; i >= 0; i-- {
+		slopes[i] = (rhs[i] - super[i]*slopes[i+1]) / diag[i]
+	}
+	return slopes
+}
+
+// pchipSlopes are the slopes of Fritsch and Carlson, a weighted harmonic mean
+// of the secants on either side and 0 where the points turn, so that the
+// interpolation doesn't overshoot them
+func pchipSlopes(xs, ys []float64) []float64 {
+	n := len(xs)
+	h, delta := secants(xs, ys)
+	slopes := make([]float64, n)
+	if n == 2 {
+		slopes[0], slopes[1] = delta[0], delta[0]
+		return slopes
+	}
+	for i := 1; i < n-1; i++ {
+		if delta[i-1]*delta[i] <= 0 {
+			continue
+		}
+		w1, w2 := 2*h[i]+h[i-1], h[i]+2*h[i-1]
+		slopes[i] = (w1 + w2) / (w1/delta[i-1] + w2/delta[i])
+	}
+	slopes[0] = pchipEndSlope(h[0], h[1], delta[0], delta[1])
+	slopes[n-1] = pchipEndSlope(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	return slopes
+}
+
+// pchipEndSlope is the slope at an end by the three point formula, limited
+// to keep the shape of the points
+func pchipEndSlope(h0, h1, delta0, delta1 float64) float64 {
+	d := ((2*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+	switch {
+	case sign(d) != sign(delta0):
+		return 0
+	case sign(delta0) != sign(delta1) && math.Abs(d) > 3*math.Abs(delta0):
+		return 3 * delta0
+	}
+	return d
+}
+
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
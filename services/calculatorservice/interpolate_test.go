@@ -0,0 +1,166 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Interpolate(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	cubic := func(x float64) float64 { return x*x*x - 2*x }
+	tests := []struct {
+		name     string
+		request  *calculatorpb.InterpolateRequest
+		expected []float64
+	}{
+		{
+			name: "Linear",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_LINEAR,
+				X:      []float64{3, 0, 1}, Y: []float64{3, 0, 2},
+				At: []float64{0.5, 2, 3, 0},
+			},
+			expected: []float64{1, 2.5, 3, 0},
+		},
+		{
+			name: "LinearExtrapolated",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_LINEAR,
+				X:      []float64{0, 1, 3}, Y: []float64{0, 2, 3},
+				At: []float64{-1, 5}, Extrapolate: true,
+			},
+			expected: []float64{-2, 4},
+		},
+		{
+			name: "Polynomial",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_POLYNOMIAL,
+				X:      []float64{-2, -1, 0, 1, 2}, Y: []float64{cubic(-2), cubic(-1), cubic(0), cubic(1), cubic(2)},
+				At: []float64{-1.5, 0.3, 1, 3}, Extrapolate: true,
+			},
+			expected: []float64{cubic(-1.5), cubic(0.3), cubic(1), cubic(3)},
+		},
+		{
+			// the slopes of the natural spline are 1.5, 0 and -1.5
+			name: "Spline",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_SPLINE,
+				X:      []float64{0, 1, 2}, Y: []float64{0, 1, 0},
+				At: []float64{0.5, 1, 1.5},
+			},
+			expected: []float64{0.6875, 1, 0.6875},
+		},
+		{
+			name: "SplineOfLine",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_SPLINE,
+				X:      []float64{0, 1, 2.5, 4}, Y: []float64{1, 3, 6, 9},
+				At: []float64{0.25, 2, 3.9},
+			},
+			expected: []float64{1.5, 5, 8.8},
+		},
+		{
+			name: "PCHIP",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_PCHIP,
+				X:      []float64{0, 1, 2, 3}, Y: []float64{0, 1, 4, 9},
+				At: []float64{0, 1, 2, 3},
+			},
+			expected: []float64{0, 1, 4, 9},
+		},
+		{
+			name: "PCHIPTwoPoints",
+			request: &calculatorpb.InterpolateRequest{
+				Method: calculatorpb.INTERPOLATION_INTERPOLATION_PCHIP,
+				X:      []float64{0, 2}, Y: []float64{1, 2},
+				At: []float64{0.5},
+			},
+			expected: []float64{1.25},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Interpolate(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expected, res.Values, 1e-12)
+		})
+	}
+}
+
+func Test_InterpolateShape(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	request := &calculatorpb.InterpolateRequest{X: []float64{0, 1, 2, 3, 4}, Y: []float64{0, 0, 1, 1, 1}}
+	for x := 0.0; x <= 4; x += 0.01 {
+		request.At = append(request.At, x)
+	}
+
+	// the spline overshoots the step, PCHIP keeps to it
+	request.Method = calculatorpb.INTERPOLATION_INTERPOLATION_SPLINE
+	res, err := calculatorSvc.Interpolate(context.Background(), request)
+	assert.Nil(t, err)
+	overshoots := false
+	for _, v := range res.Values {
+		overshoots = overshoots || v < 0 || v > 1
+	}
+	assert.True(t, overshoots)
+
+	request.Method = calculatorpb.INTERPOLATION_INTERPOLATION_PCHIP
+	res, err = calculatorSvc.Interpolate(context.Background(), request)
+	assert.Nil(t, err)
+	for i, v := range res.Values {
+		assert.True(t, v >= 0 && v <= 1, "%v at %v", v, request.At[i])
+		if i > 0 {
+			assert.GreaterOrEqual(t, v, res.Values[i-1])
+		}
+	}
+
+	// the spline of a smooth function is close to it between the points
+	request = &calculatorpb.InterpolateRequest{Method: calculatorpb.INTERPOLATION_INTERPOLATION_SPLINE, At: []float64{0.3, 1.7, 2.9}}
+	for i := 0; i <= 20; i++ {
+		x := math.Pi * float64(i) / 20
+		request.X, request.Y = append(request.X, x), append(request.Y, math.Sin(x))
+	}
+	res, err = calculatorSvc.Interpolate(context.Background(), request)
+	assert.Nil(t, err)
+	for i, x := range request.At {
+		assert.InDelta(t, math.Sin(x), res.Values[i], 1e-4)
+	}
+}
+
+func Test_InterpolateErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	linear := calculatorpb.INTERPOLATION_INTERPOLATION_LINEAR
+	many := make([]float64, 101)
+	for i := range many {
+		many[i] = float64(i)
+	}
+	tests := []struct {
+		name    string
+		request *calculatorpb.InterpolateRequest
+	}{
+		{"MissingMethod", &calculatorpb.InterpolateRequest{X: []float64{0, 1}, Y: []float64{0, 1}, At: []float64{0.5}}},
+		{"LengthMismatch", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0, 1}, Y: []float64{0}}},
+		{"OnePoint", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0}, Y: []float64{0}}},
+		{"RepeatedX", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0, 1, 0}, Y: []float64{0, 1, 2}}},
+		{"NaNPoint", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0, 1}, Y: []float64{0, math.NaN()}}},
+		{"NaNAt", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0, 1}, Y: []float64{0, 1}, At: []float64{math.NaN()}, Extrapolate: true}},
+		{"Outside", &calculatorpb.InterpolateRequest{Method: linear, X: []float64{0, 1}, Y: []float64{0, 1}, At: []float64{1.5}}},
+		{"TooManyForPolynomial", &calculatorpb.InterpolateRequest{Method: calculatorpb.INTERPOLATION_INTERPOLATION_POLYNOMIAL, X: many, Y: many}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Interpolate(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
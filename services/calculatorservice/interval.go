@@ -0,0 +1,602 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	// maxIntervalParts is the most disjoint parts of a value, more are
+	// replaced by their hull
+	maxIntervalParts = 64
+	// functionUlps widen the values of the functions of the math package,
+	// which are not correctly rounded but within an ulp or two of the exact
+	// values
+	functionUlps = 4
+	// maxPeriodicArgument bounds the arguments of sin, cos and tan whose
+	// extrema and poles are looked for, their range is whole beyond it
+	maxPeriodicArgument = 1 << 30
+	// periodSlack is the fraction of a period by which extrema and poles are
+	// also looked for outside an interval, against the rounding of their
+	// positions
+	periodSlack = 1e-6
+	// tinyProduct is the magnitude under which the rounding error of a
+	// product, quotient or square root may underflow, and can not be
+	// computed exactly with a fused multiply-add
+	tinyProduct = 0x1p-960
+)
+
+// realInterval is the closed interval [lo, hi] of the extended reals, lo is
+// never +Inf nor hi -Inf
+type realInterval struct {
+	lo, hi float64
+}
+
+var entireInterval = realInterval{math.Inf(-1), math.Inf(1)}
+
+// intervalFunctions are the images of an interval by the functions of
+// expressions, as disjoint parts
+var intervalFunctions = map[string]func(realInterval) []realInterval{
+	"sin":  func(x realInterval) []realInterval { return periodic(x, math.Sin, math.Pi/2) },
+	"cos":  func(x realInterval) []realInterval { return periodic(x, math.Cos, 0) },
+	"tan":  tanInterval,
+	"asin": increasing(math.Asin, realInterval{-1, 1}, entireInterval),
+	"acos": decreasing(math.Acos, realInterval{-1, 1}, realInterval{0, math.Inf(1)}),
+	"atan": increasing(math.Atan, entireInterval, entireInterval),
+	"sinh": increasing(math.Sinh, entireInterval, entireInterval),
+	"cosh": coshInterval,
+	"tanh": increasing(math.Tanh, entireInterval, realInterval{-1, 1}),
+	"exp":  increasing(math.Exp, entireInterval, realInterval{0, math.Inf(1)}),
+	"ln":   increasing(math.Log, realInterval{0, math.Inf(1)}, entireInterval),
+	"log":  increasing(math.Log10, realInterval{0, math.Inf(1)}, entireInterval),
+	"sqrt": sqrtInterval,
+	"abs":  absInterval,
+}
+
+// IntervalCalculator computes an interval enclosure of an operation of two
+// intervals, or of an expression of variables within intervals
+func (c *Calculator) IntervalCalculator(ctx context.Context, req *calculatorpb.IntervalCalculateRequest) (*calculatorpb.IntervalCalculateResponse, error) {
+	var res []realInterval
+	if req.Expression != "" {
+		if req.Operator != calculatorpb.OPERATOR_DEFAULT_OPERATOR || req.Operand_1 != nil || req.Operand_2 != nil {
+			return nil, invalidArgumentf("operator and operands can not be given with an expression")
+		}
+		e, err := parseExpression(req.Expression)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string][]realInterval, len(req.Values))
+		for name, v := range req.Values {
+			if _, ok := exprConstants[name]; ok {
+				return nil, invalidArgumentf("%s is a constant and can not be given a value", name)
+			}
+			x, err := intervalOf(name, v)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = []realInterval{x}
+		}
+		res, err = evalInterval(e, values, inexactLiterals(req.Expression))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if len(req.Values) > 0 {
+			return nil, invalidArgumentf("values require an expression")
+		}
+		var op byte
+		switch req.Operator {
+		case calculatorpb.OPERATOR_OPERATOR_ADD:
+			op = '+'
+		case calculatorpb.OPERATOR_OPERATOR_SUBTRACT:
+			op = '-'
+		case calculatorpb.OPERATOR_OPERATOR_MULTIPLY:
+			op = '*'
+		case calculatorpb.OPERATOR_OPERATOR_DIVIDE:
+			op = '/'
+		default:
+			return nil, invalidArgumentf("operator or expression is not supplied")
+		}
+		x, err := intervalOf("operand_1", req.Operand_1)
+		if err != nil {
+			return nil, err
+		}
+		y, err := intervalOf("operand_2", req.Operand_2)
+		if err != nil {
+			return nil, err
+		}
+		res = applyIntervals(op, []realInterval{x}, []realInterval{y})
+	}
+	if len(res) == 0 {
+		return nil, invalidArgumentf("result is empty, no values of the operands are in the domain of the operations")
+	}
+
+	intervals := make([]*calculatorpb.Interval, len(res))
+	for i, x := range res {
+		// adding 0 turns the negative zeros of negations into zeros
+		intervals[i] = &calculatorpb.Interval{Lo: x.lo + 0, Hi: x.hi + 0}
+	}
+	return &calculatorpb.IntervalCalculateResponse{
+		Intervals: intervals,
+		Hull:      &calculatorpb.Interval{Lo: intervals[0].Lo, Hi: intervals[len(res)-1].Hi},
+	}, nil
+}
+
+func intervalOf(name string, v *calculatorpb.Interval) (realInterval, error) {
+	if v == nil {
+		return realInterval{}, invalidArgumentf("%s is not supplied", name)
+	}
+	if !(v.Lo <= v.Hi) || math.IsInf(v.Lo, 1) || math.IsInf(v.Hi, -1) {
+		return realInterval{}, invalidArgumentf("%s [%g, %g] is not an interval", name, v.Lo, v.Hi)
+	}
+	return realInterval{v.Lo, v.Hi}, nil
+}
+
+// inexactLiterals are the values of the numbers of an expression that are not
+// exactly doubles, such as 0.1
+func inexactLiterals(input string) map[float64]bool {
+	// the expression has been parsed, so it tokenizes
+	tokens, _ := tokenize(input)
+	inexact := map[float64]bool{}
+	for _, t := range tokens {
+		if t.kind != tokenNumber {
+			continue
+		}
+		if t.value == 0 {
+			// a number too small for a double, unless all its digits are 0
+			mantissa := t.text
+			if i := strings.IndexAny(mantissa, "eE"); i >= 0 {
+				mantissa = mantissa[:i]
+			}
+			if strings.Trim(mantissa, "0.") != "" {
+				inexact[0] = true
+			}
+			continue
+		}
+		exact, ok := new(big.Rat).SetString(t.text)
+		if !ok || exact.Cmp(new(big.Rat).SetFloat64(t.value)) != 0 {
+			inexact[t.value] = true
+		}
+	}
+	return inexact
+}
+
+// evalInterval computes the disjoint parts of the image of the intervals of
+// values by e. Numbers with a value in inexact, and the constants, are taken
+// as the interval between the doubles around them.
+func evalInterval(e expr, values map[string][]realInterval, inexact map[float64]bool) ([]realInterval, error) {
+	switch e := e.(type) {
+	case *numberNode:
+		if inexact[e.value] {
+			return []realInterval{around(e.value)}, nil
+		}
+		return []realInterval{{e.value, e.value}}, nil
+	case *variableNode:
+		if v, ok := exprConstants[e.name]; ok {
+			return []realInterval{around(v)}, nil
+		}
+		v, ok := values[e.name]
+		if !ok {
+			return nil, invalidArgumentf("variable %s has no value", e.name)
+		}
+		return v, nil
+	case *negNode:
+		x, err := evalInterval(e.x, values, inexact)
+		res := make([]realInterval, len(x))
+		for i, p := range x {
+			res[len(x)-1-i] = realInterval{-p.hi, -p.lo}
+		}
+		return res, err
+	case *callNode:
+		x, err := evalInterval(e.arg, values, inexact)
+		if err != nil {
+			return nil, err
+		}
+		var res []realInterval
+		for _, p := range x {
+			res = append(res, intervalFunctions[e.fn](p)...)
+		}
+		return normalizeIntervals(res), nil
+	case *binaryNode:
+		l, err := evalInterval(e.left, values, inexact)
+		if err != nil {
+			return nil, err
+		}
+		r, err := evalInterval(e.right, values, inexact)
+		if err != nil {
+			return nil, err
+		}
+		return applyIntervals(e.op, l, r), nil
+	}
+	panic(fmt.Sprintf("unknown expression node %T", e))
+}
+
+// applyIntervals applies a binary operator to every pair of parts of l and r
+func applyIntervals(op byte, l, r []realInterval) []realInterval {
+	var res []realInterval
+	if n, ok := integerExponent(r); ok && op == '^' {
+		// the parts of the base are not split by the sign of their values,
+		// so that x^2 of x in [-1, 2] is [0, 4] and not [-2, 4]
+		for _, x := range l {
+			res = append(res, powInteger(x, n)...)
+		}
+		return normalizeIntervals(res)
+	}
+	for _, x := range l {
+		for _, y := range r {
+			switch op {
+			case '+':
+				res = append(res, addInterval(x, y))
+			case '-':
+				res = append(res, addInterval(x, realInterval{-y.hi, -y.lo}))
+			case '*':
+				res = append(res, multiplyInterval(x, y))
+			case '/':
+				res = append(res, divideInterval(x, y)...)
+			default:
+				res = append(res, powInterval(x, y)...)
+			}
+		}
+	}
+	return normalizeIntervals(res)
+}
+
+// normalizeIntervals sorts parts and merges those that overlap
+func normalizeIntervals(parts []realInterval) []realInterval {
+	if len(parts) < 2 {
+		return parts
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].lo < parts[j].lo })
+	res := parts[:1]
+	for _, p := range parts[1:] {
+		last := &res[len(res)-1]
+		if p.lo <= last.hi {
+			last.hi = math.Max(last.hi, p.hi)
+			continue
+		}
+		res = append(res, p)
+	}
+	if len(res) > maxIntervalParts {
+		return []realInterval{{res[0].lo, res[len(res)-1].hi}}
+	}
+	return res
+}
+
+func addInterval(x, y realInterval) realInterval {
+	lo, _ := sumBounds(x.lo, y.lo)
+	_, hi := sumBounds(x.hi, y.hi)
+	return realInterval{lo, hi}
+}
+
+func multiplyInterval(x, y realInterval) realInterval {
+	res := realInterval{math.Inf(1), math.Inf(-1)}
+	for _, a := range [2]float64{x.lo, x.hi} {
+		for _, b := range [2]float64{y.lo, y.hi} {
+			lo, hi := productBounds(a, b)
+			res.lo, res.hi = math.Min(res.lo, lo), math.Max(res.hi, hi)
+		}
+	}
+	return res
+}
+
+// divideInterval is x/y, in two parts when y holds zero inside, as the
+// quotients by its negative and positive values
+func divideInterval(x, y realInterval) []realInterval {
+	inf := math.Inf(1)
+	switch {
+	case y.lo > 0:
+		return []realInterval{positiveQuotient(x, y)}
+	case y.hi < 0:
+		q := positiveQuotient(x, realInterval{-y.hi, -y.lo})
+		return []realInterval{{-q.hi, -q.lo}}
+	case y.lo == 0 && y.hi == 0:
+		return nil
+	case x.lo <= 0 && x.hi >= 0:
+		return []realInterval{entireInterval}
+	}
+
+	// y holds zero and x does not, the quotients by the values of y near zero
+	// are unbounded
+	var neg, pos []realInterval
+	if x.hi < 0 {
+		if y.lo < 0 {
+			lo, _ := quotientBounds(x.hi, y.lo)
+			pos = []realInterval{{lo, inf}}
+		}
+		if y.hi > 0 {
+			_, hi := quotientBounds(x.hi, y.hi)
+			neg = []realInterval{{-inf, hi}}
+		}
+	} else {
+		if y.lo < 0 {
+			_, hi := quotientBounds(x.lo, y.lo)
+			neg = []realInterval{{-inf, hi}}
+		}
+		if y.hi > 0 {
+			lo, _ := quotientBounds(x.lo, y.hi)
+			pos = []realInterval{{lo, inf}}
+		}
+	}
+	return append(neg, pos...)
+}
+
+// positiveQuotient is x/y of a positive y
+func positiveQuotient(x, y realInterval) realInterval {
+	var res realInterval
+	if x.lo >= 0 {
+		res.lo, _ = quotientBounds(x.lo, y.hi)
+	} else {
+		res.lo, _ = quotientBounds(x.lo, y.lo)
+	}
+	if x.hi >= 0 {
+		_, res.hi = quotientBounds(x.hi, y.lo)
+	} else {
+		_, res.hi = quotientBounds(x.hi, y.hi)
+	}
+	return res
+}
+
+// integerExponent is the value of a single integer exponent
+func integerExponent(r []realInterval) (int64, bool) {
+	if len(r) != 1 || r[0].lo != r[0].hi || r[0].lo != math.Trunc(r[0].lo) || math.Abs(r[0].lo) > 1<<53 {
+		return 0, false
+	}
+	return int64(r[0].lo), true
+}
+
+// powInteger is x^n, of any sign of x
+func powInteger(x realInterval, n int64) []realInterval {
+	if n == 0 {
+		return []realInterval{{1, 1}}
+	}
+	m := n
+	if m < 0 {
+		m = -m
+	}
+	var p realInterval
+	switch {
+	case x.lo >= 0:
+		p.lo, _ = powBounds(x.lo, m)
+		_, p.hi = powBounds(x.hi, m)
+	case m%2 == 1:
+		// odd powers are increasing
+		_, hi := powBounds(-x.lo, m)
+		p.lo = -hi
+		if x.hi >= 0 {
+			_, p.hi = powBounds(x.hi, m)
+		} else {
+			lo, _ := powBounds(-x.hi, m)
+			p.hi = -lo
+		}
+	case x.hi <= 0:
+		p.lo, _ = powBounds(-x.hi, m)
+		_, p.hi = powBounds(-x.lo, m)
+	default:
+		_, p.hi = powBounds(math.Max(-x.lo, x.hi), m)
+	}
+	if n < 0 {
+		return divideInterval(realInterval{1, 1}, p)
+	}
+	return []realInterval{p}
+}
+
+// powBounds encloses a^n of a non-negative a, by squaring
+func powBounds(a float64, n int64) (lo, hi float64) {
+	lo, hi = 1, 1
+	baseLo, baseHi := a, a
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			lo, _ = productBounds(lo, baseLo)
+			_, hi = productBounds(hi, baseHi)
+			// an underflow may round a lower bound below zero, which would
+			// be wrong in further products
+			lo = math.Max(lo, 0)
+		}
+		if n > 1 {
+			baseLo, _ = productBounds(baseLo, baseLo)
+			_, baseHi = productBounds(baseHi, baseHi)
+			baseLo = math.Max(baseLo, 0)
+		}
+	}
+	return lo, hi
+}
+
+// powInterval is x^y = exp(y ln x) of the non-negative part of x
+func powInterval(x, y realInterval) []realInterval {
+	var res []realInterval
+	for _, l := range intervalFunctions["ln"](x) {
+		res = append(res, intervalFunctions["exp"](multiplyInterval(y, l))...)
+	}
+	return res
+}
+
+func sqrtInterval(x realInterval) []realInterval {
+	x, ok := restrict(x, realInterval{0, math.Inf(1)})
+	if !ok {
+		return nil
+	}
+	lo, _ := sqrtBounds(x.lo)
+	_, hi := sqrtBounds(x.hi)
+	return []realInterval{{math.Max(lo, 0), hi}}
+}
+
+func absInterval(x realInterval) []realInterval {
+	switch {
+	case x.lo >= 0:
+		return []realInterval{x}
+	case x.hi <= 0:
+		return []realInterval{{-x.hi, -x.lo}}
+	}
+	return []realInterval{{0, math.Max(-x.lo, x.hi)}}
+}
+
+func coshInterval(x realInterval) []realInterval {
+	a, b := math.Cosh(x.lo), math.Cosh(x.hi)
+	switch {
+	case x.lo >= 0:
+		return image(a, b, realInterval{1, math.Inf(1)})
+	case x.hi <= 0:
+		return image(b, a, realInterval{1, math.Inf(1)})
+	}
+	return image(1, math.Max(a, b), realInterval{1, math.Inf(1)})
+}
+
+// periodic is the image of x by sin or cos, f, of maxima at peak + 2kπ and
+// minima at peak + π + 2kπ
+func periodic(x realInterval, f func(float64) float64, peak float64) []realInterval {
+	if x.hi-x.lo >= 2*math.Pi || math.Max(-x.lo, x.hi) > maxPeriodicArgument {
+		return []realInterval{{-1, 1}}
+	}
+	a, b := f(x.lo), f(x.hi)
+	lo, hi := math.Min(a, b), math.Max(a, b)
+	if mayContain(x, peak, 2*math.Pi) {
+		hi = 1
+	}
+	if mayContain(x, peak+math.Pi, 2*math.Pi) {
+		lo = -1
+	}
+	return image(lo, hi, realInterval{-1, 1})
+}
+
+// tanInterval is in two unbounded parts when x holds a pole of tan
+func tanInterval(x realInterval) []realInterval {
+	if x.hi-x.lo >= math.Pi || math.Max(-x.lo, x.hi) > maxPeriodicArgument {
+		return []realInterval{entireInterval}
+	}
+	res := image(math.Tan(x.lo), math.Tan(x.hi), entireInterval)[0]
+	if mayContain(x, math.Pi/2, math.Pi) {
+		return normalizeIntervals([]realInterval{{math.Inf(-1), res.hi}, {res.lo, math.Inf(1)}})
+	}
+	return []realInterval{res}
+}
+
+// mayContain tells whether x may hold c + k period for an integer k, it errs
+// towards true
+func mayContain(x realInterval, c, period float64) bool {
+	return math.Floor((x.hi-c)/period+periodSlack) >= math.Ceil((x.lo-c)/period-periodSlack)
+}
+
+// increasing is the image by an increasing function f of the part of an
+// interval within domain, f has its values in rng
+func increasing(f func(float64) float64, domain, rng realInterval) func(realInterval) []realInterval {
+	return func(x realInterval) []realInterval {
+		x, ok := restrict(x, domain)
+		if !ok {
+			return nil
+		}
+		return image(f(x.lo), f(x.hi), rng)
+	}
+}
+
+// decreasing is the image by a decreasing function f of the part of an
+// interval within domain, f has its values in rng
+func decreasing(f func(float64) float64, domain, rng realInterval) func(realInterval) []realInterval {
+	return func(x realInterval) []realInterval {
+		x, ok := restrict(x, domain)
+		if !ok {
+			return nil
+		}
+		return image(f(x.hi), f(x.lo), rng)
+	}
+}
+
+// image is [lo, hi] of values of a function of the math package widened
+// by its error, within its range rng
+func image(lo, hi float64, rng realInterval) []realInterval {
+	for i := 0; i < functionUlps; i++ {
+		lo, hi = math.Nextafter(lo, math.Inf(-1)), math.Nextafter(hi, math.Inf(1))
+	}
+	res, _ := restrict(realInterval{lo, hi}, rng)
+	return []realInterval{res}
+}
+
+// restrict is the intersection of x and domain, if it is not empty
+func restrict(x, domain realInterval) (realInterval, bool) {
+	x.lo, x.hi = math.Max(x.lo, domain.lo), math.Min(x.hi, domain.hi)
+	return x, x.lo <= x.hi
+}
+
+// around is the interval between the doubles around v
+func around(v float64) realInterval {
+	return realInterval{math.Nextafter(v, math.Inf(-1)), math.Nextafter(v, math.Inf(1))}
+}
+
+// sumBounds encloses a+b, of its rounding error by Knuth's two-sum
+func sumBounds(a, b float64) (lo, hi float64) {
+	s := a + b
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return s, s
+	}
+	t := s - a
+	return roundingBounds(s, (a-(s-t))+(b-t))
+}
+
+// productBounds encloses a*b, of its rounding error by a fused multiply-add.
+// 0 times an infinity is 0, a limit of the products of the values of
+// unbounded intervals.
+func productBounds(a, b float64) (lo, hi float64) {
+	if a == 0 || b == 0 {
+		return 0, 0
+	}
+	p := a * b
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return p, p
+	}
+	if math.Abs(p) < tinyProduct {
+		return around(p).lo, around(p).hi
+	}
+	return roundingBounds(p, math.FMA(a, b, -p))
+}
+
+// quotientBounds encloses a/b of a non-zero b, of the exact remainder
+// a - q*b of the rounded quotient q
+func quotientBounds(a, b float64) (lo, hi float64) {
+	q := a / b
+	if a == 0 || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return q, q
+	}
+	if math.Abs(q) < tinyProduct || math.Abs(a) < tinyProduct {
+		return around(q).lo, around(q).hi
+	}
+	r := math.FMA(-q, b, a)
+	if b < 0 {
+		r = -r
+	}
+	return roundingBounds(q, r)
+}
+
+// sqrtBounds encloses the square root of a non-negative a, of the exact
+// remainder a - r*r of the rounded root r
+func sqrtBounds(a float64) (lo, hi float64) {
+	r := math.Sqrt(a)
+	if a == 0 || math.IsInf(a, 1) {
+		return r, r
+	}
+	if a < tinyProduct {
+		return around(r).lo, around(r).hi
+	}
+	return roundingBounds(r, -math.FMA(r, r, -a))
+}
+
+// roundingBounds encloses the exact x+err of a result x rounded to nearest,
+// of which only the sign of err matters. An infinite x is an overflow of
+// finite operands.
+func roundingBounds(x, err float64) (lo, hi float64) {
+	switch {
+	case math.IsInf(x, 1):
+		return math.MaxFloat64, x
+	case math.IsInf(x, -1):
+		return x, -math.MaxFloat64
+	case err < 0:
+		return math.Nextafter(x, math.Inf(-1)), x
+	case err > 0:
+		return x, math.Nextafter(x, math.Inf(1))
+	}
+	return x, x
+}
@@ -0,0 +1,299 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IntervalCalculatorOperators(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	inf := math.Inf(1)
+	tests := []struct {
+		name     string
+		operator calculatorpb.OPERATOR
+		x, y     *calculatorpb.Interval
+		expected []*calculatorpb.Interval
+	}{
+		{"Add", calculatorpb.OPERATOR_OPERATOR_ADD, &calculatorpb.Interval{Lo: 1, Hi: 2}, &calculatorpb.Interval{Lo: 3, Hi: 4},
+			[]*calculatorpb.Interval{{Lo: 4, Hi: 6}}},
+		{"Subtract", calculatorpb.OPERATOR_OPERATOR_SUBTRACT, &calculatorpb.Interval{Lo: 1, Hi: 2}, &calculatorpb.Interval{Lo: 3, Hi: 4},
+			[]*calculatorpb.Interval{{Lo: -3, Hi: -1}}},
+		{"Multiply", calculatorpb.OPERATOR_OPERATOR_MULTIPLY, &calculatorpb.Interval{Lo: -2, Hi: 3}, &calculatorpb.Interval{Lo: -1, Hi: 4},
+			[]*calculatorpb.Interval{{Lo: -8, Hi: 12}}},
+		{"Divide", calculatorpb.OPERATOR_OPERATOR_DIVIDE, &calculatorpb.Interval{Lo: 1, Hi: 2}, &calculatorpb.Interval{Lo: -4, Hi: -2},
+			[]*calculatorpb.Interval{{Lo: -1, Hi: -0.25}}},
+		{"RoundedOutwards", calculatorpb.OPERATOR_OPERATOR_ADD, &calculatorpb.Interval{Lo: 0.1, Hi: 0.1}, &calculatorpb.Interval{Lo: 0.2, Hi: 0.2},
+			[]*calculatorpb.Interval{{Lo: 0.3, Hi: 0.30000000000000004}}},
+		{"Overflow", calculatorpb.OPERATOR_OPERATOR_MULTIPLY, &calculatorpb.Interval{Lo: 1e200, Hi: 1e200}, &calculatorpb.Interval{Lo: 1e200, Hi: 1e200},
+			[]*calculatorpb.Interval{{Lo: math.MaxFloat64, Hi: inf}}},
+		{"Unbounded", calculatorpb.OPERATOR_OPERATOR_MULTIPLY, &calculatorpb.Interval{Lo: 0, Hi: 1}, &calculatorpb.Interval{Lo: 1, Hi: inf},
+			[]*calculatorpb.Interval{{Lo: 0, Hi: inf}}},
+		{"DivideByZeroInside", calculatorpb.OPERATOR_OPERATOR_DIVIDE, &calculatorpb.Interval{Lo: 1, Hi: 1}, &calculatorpb.Interval{Lo: -1, Hi: 2},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: -1}, {Lo: 0.5, Hi: inf}}},
+		{"DivideByZeroBound", calculatorpb.OPERATOR_OPERATOR_DIVIDE, &calculatorpb.Interval{Lo: -2, Hi: -1}, &calculatorpb.Interval{Lo: 0, Hi: 4},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: -0.25}}},
+		{"ZeroByZero", calculatorpb.OPERATOR_OPERATOR_DIVIDE, &calculatorpb.Interval{Lo: -1, Hi: 1}, &calculatorpb.Interval{Lo: -1, Hi: 1},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: inf}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+				Operator: tt.operator, Operand_1: tt.x, Operand_2: tt.y,
+			})
+			assert.Nil(t, err)
+			assert.Len(t, res.Intervals, len(tt.expected))
+			for i := range tt.expected {
+				assert.Equal(t, tt.expected[i].Lo, res.Intervals[i].Lo)
+				assert.Equal(t, tt.expected[i].Hi, res.Intervals[i].Hi)
+			}
+			assert.Equal(t, tt.expected[0].Lo, res.Hull.Lo)
+			assert.Equal(t, tt.expected[len(tt.expected)-1].Hi, res.Hull.Hi)
+		})
+	}
+}
+
+// Test_IntervalCalculatorEnclosure checks with rationals that the results of
+// random operands hold the exact results of the bounds of the operands
+func Test_IntervalCalculatorEnclosure(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	rnd := rand.New(rand.NewSource(50))
+	value := func() float64 {
+		return (rnd.Float64()*2 - 1) * math.Pow(10, float64(rnd.Intn(40)-20))
+	}
+	operators := []calculatorpb.OPERATOR{
+		calculatorpb.OPERATOR_OPERATOR_ADD, calculatorpb.OPERATOR_OPERATOR_SUBTRACT,
+		calculatorpb.OPERATOR_OPERATOR_MULTIPLY, calculatorpb.OPERATOR_OPERATOR_DIVIDE,
+	}
+	for i := 0; i < 2000; i++ {
+		a, b, c, d := value(), value(), value(), value()
+		x := &calculatorpb.Interval{Lo: math.Min(a, b), Hi: math.Max(a, b)}
+		y := &calculatorpb.Interval{Lo: math.Min(c, d), Hi: math.Max(c, d)}
+		if i%4 == 0 {
+			x.Hi = x.Lo
+		}
+		operator := operators[i%len(operators)]
+		res, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+			Operator: operator, Operand_1: x, Operand_2: y,
+		})
+		if !assert.Nil(t, err) {
+			continue
+		}
+		for _, u := range []float64{x.Lo, x.Hi} {
+			for _, v := range []float64{y.Lo, y.Hi} {
+				l, r := new(big.Rat).SetFloat64(u), new(big.Rat).SetFloat64(v)
+				exact := new(big.Rat)
+				switch operator {
+				case calculatorpb.OPERATOR_OPERATOR_ADD:
+					exact.Add(l, r)
+				case calculatorpb.OPERATOR_OPERATOR_SUBTRACT:
+					exact.Sub(l, r)
+				case calculatorpb.OPERATOR_OPERATOR_MULTIPLY:
+					exact.Mul(l, r)
+				default:
+					exact.Quo(l, r)
+				}
+				assert.True(t, intervalsHold(res.Intervals, exact), "%v %v %v is %v, not within %v", x, operator, y, exact.FloatString(30), res.Intervals)
+			}
+		}
+	}
+}
+
+func intervalsHold(intervals []*calculatorpb.Interval, exact *big.Rat) bool {
+	for _, x := range intervals {
+		if (math.IsInf(x.Lo, -1) || new(big.Rat).SetFloat64(x.Lo).Cmp(exact) <= 0) &&
+			(math.IsInf(x.Hi, 1) || new(big.Rat).SetFloat64(x.Hi).Cmp(exact) >= 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_IntervalCalculatorExpression(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	inf := math.Inf(1)
+	tests := []struct {
+		name       string
+		expression string
+		values     map[string]*calculatorpb.Interval
+		expected   []*calculatorpb.Interval
+	}{
+		{"Square", "x^2", map[string]*calculatorpb.Interval{"x": {Lo: -2, Hi: 3}},
+			[]*calculatorpb.Interval{{Lo: 0, Hi: 9}}},
+		{"Dependency", "x*x", map[string]*calculatorpb.Interval{"x": {Lo: -2, Hi: 3}},
+			[]*calculatorpb.Interval{{Lo: -6, Hi: 9}}},
+		{"Difference", "x - x", map[string]*calculatorpb.Interval{"x": {Lo: 1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: -1, Hi: 1}}},
+		{"OddPower", "x^3", map[string]*calculatorpb.Interval{"x": {Lo: -2, Hi: 1}},
+			[]*calculatorpb.Interval{{Lo: -8, Hi: 1}}},
+		{"NegativePower", "x^-2", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: 0.25, Hi: inf}}},
+		{"Reciprocal", "1/x", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: -1}, {Lo: 0.5, Hi: inf}}},
+		{"ExtendedSum", "1/x + 2", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: 1}, {Lo: 2.5, Hi: inf}}},
+		{"ExtendedJoined", "1/x + y", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}, "y": {Lo: 0, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: inf}}},
+		{"Sqrt", "sqrt(x)", map[string]*calculatorpb.Interval{"x": {Lo: -4, Hi: 9}},
+			[]*calculatorpb.Interval{{Lo: 0, Hi: 3}}},
+		{"Abs", "abs(x - 1)", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: 0, Hi: 2}}},
+		{"ExactLiteral", "x*0.5", map[string]*calculatorpb.Interval{"x": {Lo: 1, Hi: 3}},
+			[]*calculatorpb.Interval{{Lo: 0.5, Hi: 1.5}}},
+		{"Sin", "sin(x)", map[string]*calculatorpb.Interval{"x": {Lo: 1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: math.Sin(1), Hi: 1}}},
+		{"SinPeriod", "sin(x)", map[string]*calculatorpb.Interval{"x": {Lo: 0, Hi: 7}},
+			[]*calculatorpb.Interval{{Lo: -1, Hi: 1}}},
+		{"Cos", "cos(x)", map[string]*calculatorpb.Interval{"x": {Lo: 3, Hi: 4}},
+			[]*calculatorpb.Interval{{Lo: -1, Hi: math.Cos(4)}}},
+		{"TanPole", "tan(x)", map[string]*calculatorpb.Interval{"x": {Lo: 1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: math.Tan(2)}, {Lo: math.Tan(1), Hi: inf}}},
+		{"Cosh", "cosh(x)", map[string]*calculatorpb.Interval{"x": {Lo: -1, Hi: 2}},
+			[]*calculatorpb.Interval{{Lo: 1, Hi: math.Cosh(2)}}},
+		{"Ln", "ln(x)", map[string]*calculatorpb.Interval{"x": {Lo: 0, Hi: math.E}},
+			[]*calculatorpb.Interval{{Lo: -inf, Hi: 1}}},
+		{"Power", "2^x", map[string]*calculatorpb.Interval{"x": {Lo: 1, Hi: 3}},
+			[]*calculatorpb.Interval{{Lo: 2, Hi: 8}}},
+		{"Pi", "pi", nil, []*calculatorpb.Interval{{Lo: math.Pi, Hi: math.Pi}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+				Expression: tt.expression, Values: tt.values,
+			})
+			assert.Nil(t, err)
+			assert.Len(t, res.Intervals, len(tt.expected))
+			for i := range tt.expected {
+				assertBound(t, tt.expected[i].Lo, res.Intervals[i].Lo)
+				assertBound(t, tt.expected[i].Hi, res.Intervals[i].Hi)
+				assert.LessOrEqual(t, res.Intervals[i].Lo, tt.expected[i].Lo)
+				assert.GreaterOrEqual(t, res.Intervals[i].Hi, tt.expected[i].Hi)
+			}
+		})
+	}
+}
+
+// assertBound checks a bound of a result, which is widened by a few ulps of
+// the functions and constants unless it is zero or infinite
+func assertBound(t *testing.T, expected, actual float64) {
+	if expected == 0 || math.IsInf(expected, 0) {
+		assert.Equal(t, expected, actual)
+		return
+	}
+	assert.InEpsilon(t, expected, actual, 1e-14)
+}
+
+func Test_IntervalCalculatorLiterals(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		expression string
+		exact      string
+		width      float64
+	}{
+		// 0.1 is not a double, 0.5 and 1e3 are
+		{"Inexact", "0.1*3", "3/10", 3e-16},
+		{"Exact", "0.5*3 + 1e3", "2003/2", 0},
+		{"Underflow", "1e-400*1e300", "1e-100", 1e-22},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+				Expression: tt.expression,
+			})
+			assert.Nil(t, err)
+			exact, _ := new(big.Rat).SetString(tt.exact)
+			assert.True(t, intervalsHold(res.Intervals, exact), "%s is not within %v", tt.exact, res.Intervals)
+			assert.LessOrEqual(t, res.Hull.Hi-res.Hull.Lo, tt.width)
+		})
+	}
+}
+
+func Test_IntervalCalculatorFunctions(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	functions := map[string]func(float64) float64{
+		"sin": math.Sin, "cos": math.Cos, "tan": math.Tan, "asin": math.Asin, "acos": math.Acos,
+		"atan": math.Atan, "sinh": math.Sinh, "cosh": math.Cosh, "tanh": math.Tanh, "exp": math.Exp,
+		"ln": math.Log, "log": math.Log10, "sqrt": math.Sqrt, "abs": math.Abs,
+	}
+	rnd := rand.New(rand.NewSource(50))
+	for name, f := range functions {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 200; i++ {
+				a, b := rnd.Float64()*8-4, rnd.Float64()*8-4
+				x := &calculatorpb.Interval{Lo: math.Min(a, b), Hi: math.Max(a, b)}
+				res, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+					Expression: name + "(x)", Values: map[string]*calculatorpb.Interval{"x": x},
+				})
+				if err != nil {
+					// only out of the domain
+					assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+					continue
+				}
+				for j := 0; j <= 16; j++ {
+					v := f(math.Min(x.Lo+(x.Hi-x.Lo)*float64(j)/16, x.Hi))
+					if math.IsNaN(v) {
+						continue
+					}
+					held := false
+					for _, part := range res.Intervals {
+						held = held || (part.Lo <= v && v <= part.Hi)
+					}
+					assert.True(t, held, "%s(%v) is not within %v", name, x, res.Intervals)
+				}
+			}
+		})
+	}
+}
+
+func Test_IntervalCalculatorErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	one := &calculatorpb.Interval{Lo: 1, Hi: 1}
+	tests := []struct {
+		name string
+		req  *calculatorpb.IntervalCalculateRequest
+	}{
+		{"NoOperator", &calculatorpb.IntervalCalculateRequest{Operand_1: one, Operand_2: one}},
+		{"NoOperand", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Operand_1: one}},
+		{"Reversed", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Operand_1: one,
+			Operand_2: &calculatorpb.Interval{Lo: 2, Hi: 1}}},
+		{"NaN", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Operand_1: one,
+			Operand_2: &calculatorpb.Interval{Lo: math.NaN(), Hi: 1}}},
+		{"Infinite", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Operand_1: one,
+			Operand_2: &calculatorpb.Interval{Lo: math.Inf(1), Hi: math.Inf(1)}}},
+		{"DivideByZero", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_DIVIDE, Operand_1: one,
+			Operand_2: &calculatorpb.Interval{}}},
+		{"OperatorAndExpression", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Expression: "x"}},
+		{"ValuesWithoutExpression", &calculatorpb.IntervalCalculateRequest{Operator: calculatorpb.OPERATOR_OPERATOR_ADD,
+			Operand_1: one, Operand_2: one, Values: map[string]*calculatorpb.Interval{"x": one}}},
+		{"NoValue", &calculatorpb.IntervalCalculateRequest{Expression: "x + y", Values: map[string]*calculatorpb.Interval{"x": one}}},
+		{"Constant", &calculatorpb.IntervalCalculateRequest{Expression: "pi", Values: map[string]*calculatorpb.Interval{"pi": one}}},
+		{"Syntax", &calculatorpb.IntervalCalculateRequest{Expression: "x +"}},
+		{"OutOfDomain", &calculatorpb.IntervalCalculateRequest{Expression: "ln(x)",
+			Values: map[string]*calculatorpb.Interval{"x": {Lo: -2, Hi: -1}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.IntervalCalculator(context.Background(), tt.req)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	_, err := calculatorSvc.IntervalCalculator(context.Background(), &calculatorpb.IntervalCalculateRequest{
+		Operator: calculatorpb.OPERATOR_OPERATOR_ADD, Operand_1: one, Operand_2: &calculatorpb.Interval{Lo: 2, Hi: 1},
+	})
+	assert.EqualError(t, err, "invalid argument: operand_2 [2, 1] is not an interval")
+}
@@ -0,0 +1,645 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// maxLogicVariables bounds the variables of truth tables and minimization,
+// whose rows double with each
+const maxLogicVariables = 16
+
+// logicExpr is a node of the syntax tree of a boolean expression
+type logicExpr interface {
+	eval(values []bool) bool
+}
+
+type logicConstant struct {
+	value bool
+}
+
+// logicVariable is a variable with its index in the values
+type logicVariable struct {
+	name  string
+	index int
+}
+
+type logicNot struct {
+	x logicExpr
+}
+
+// logicBinary is one of the binary operators by its word
+type logicBinary struct {
+	op          string
+	left, right logicExpr
+}
+
+func (c *logicConstant) eval([]bool) bool        { return c.value }
+func (v *logicVariable) eval(values []bool) bool { return values[v.index] }
+func (n *logicNot) eval(values []bool) bool      { return !n.x.eval(values) }
+
+func (b *logicBinary) eval(values []bool) bool {
+	l, r := b.left.eval(values), b.right.eval(values)
+	switch b.op {
+	case "and":
+		return l && r
+	case "nand":
+		return !(l && r)
+	case "or":
+		return l || r
+	case "nor":
+		return !(l || r)
+	case "xor":
+		return l != r
+	case "implies":
+		return !l || r
+	default:
+		// iff and xnor
+		return l == r
+	}
+}
+
+// logicOperators are the operators by their symbols and words
+var logicOperators = map[string]string{
+	"!": "not", "~": "not", "not": "not",
+	"&": "and", "&&": "and", "and": "and", "nand": "nand",
+	"^": "xor", "xor": "xor",
+	"|": "or", "||": "or", "or": "or", "nor": "nor",
+	"->": "implies", "=>": "implies", "implies": "implies",
+	"<->": "iff", "<=>": "iff", "iff": "iff", "xnor": "xnor",
+}
+
+// logicLevels are the binary operators from the loosest binding
+var logicLevels = [][]string{{"iff", "xnor"}, {"implies"}, {"or", "nor"}, {"xor"}, {"and", "nand"}}
+
+// logicParser is a recursive descent parser of boolean expressions, a level
+// of binary operators for each of logicLevels over
+//
+//	negation = "not" negation | literal
+//	literal  = constant | ident | "(" expression ")"
+type logicParser struct {
+	exprParser
+}
+
+// parseLogic reads a boolean expression into its syntax tree with the names
+// of its variables
+func parseLogic(input string) (logicExpr, []*logicVariable, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil, invalidArgumentf("expression is not supplied")
+	}
+	if len(input) > maxExpressionLength {
+		return nil, nil, invalidArgumentf("expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := tokenizeLogic(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := &logicParser{exprParser{input: input, tokens: tokens}}
+	var variables []*logicVariable
+	e, err := p.binary(0, &variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t := p.peek(); t.kind != tokenEnd {
+		return nil, nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return e, variables, nil
+}
+
+func tokenizeLogic(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '0' || c == '1':
+			if i+1 < len(input) && isIdentByte(input[i+1], true) {
+				return nil, invalidArgumentf("expression %q has an invalid constant at %d", input, i+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[i : i+1], value: float64(c - '0'), pos: i})
+			i++
+		case isIdentByte(c, false):
+			j := i
+			for j < len(input) && isIdentByte(input[j], true) {
+				j++
+			}
+			word := strings.ToLower(input[i:j])
+			switch {
+			case word == "true" || word == "false":
+				value := 0.0
+				if word == "true" {
+					value = 1
+				}
+				tokens = append(tokens, token{kind: tokenNumber, text: input[i:j], value: value, pos: i})
+			case logicOperators[word] != "":
+				tokens = append(tokens, token{kind: tokenOperator, text: input[i:j], pos: i})
+			default:
+				tokens = append(tokens, token{kind: tokenIdent, text: input[i:j], pos: i})
+			}
+			i = j
+		case c == '(' || c == ')':
+			tokens = append(tokens, token{kind: tokenOperator, text: input[i : i+1], pos: i})
+			i++
+		default:
+			// the longest symbol
+			symbol := ""
+			for _, s := range []string{"<->", "<=>", "->", "=>", "&&", "||", "&", "|", "^", "!", "~"} {
+				if strings.HasPrefix(input[i:], s) {
+					symbol = s
+					break
+				}
+			}
+			if symbol == "" {
+				return nil, invalidArgumentf("expression %q has an invalid character at %d", input, i+1)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: symbol, pos: i})
+			i += len(symbol)
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, text: "end of expression", pos: len(input)}), nil
+}
+
+// operator is the operator of the next token, or "" for other tokens
+func (p *logicParser) operator() string {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return ""
+	}
+	return logicOperators[strings.ToLower(t.text)]
+}
+
+// binary reads the operators of a level, all left associative but
+// implication
+func (p *logicParser) binary(level int, variables *[]*logicVariable) (logicExpr, error) {
+	if level == len(logicLevels) {
+		return p.negation(variables)
+	}
+	left, err := p.binary(level+1, variables)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.operator()
+		found := false
+		for _, o := range logicLevels[level] {
+			found = found || o == op
+		}
+		if !found {
+			return left, nil
+		}
+		p.next()
+		next := level + 1
+		if op == "implies" {
+			next = level
+		}
+		right, err := p.binary(next, variables)
+		if err != nil {
+			return nil, err
+		}
+		left = &logicBinary{op, left, right}
+	}
+}
+
+func (p *logicParser) negation(variables *[]*logicVariable) (logicExpr, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	if p.operator() == "not" {
+		p.next()
+		x, err := p.negation(variables)
+		if err != nil {
+			return nil, err
+		}
+		return &logicNot{x}, nil
+	}
+
+	t := p.next()
+	switch {
+	case t.kind == tokenNumber:
+		return &logicConstant{t.value == 1}, nil
+	case t.kind == tokenIdent:
+		v := &logicVariable{name: t.text}
+		*variables = append(*variables, v)
+		return v, nil
+	case t.kind == tokenOperator && t.text == "(":
+		e, err := p.binary(0, variables)
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenOperator || t.text != ")" {
+			return nil, p.errorf(t, "expected ) instead of %q", t.text)
+		}
+		return e, nil
+	default:
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+}
+
+// Logic evaluates, tabulates and minimizes boolean expressions
+func (c *Calculator) Logic(ctx context.Context, req *calculatorpb.LogicRequest) (*calculatorpb.LogicResponse, error) {
+	var e logicExpr
+	var variables []string
+	if req.Expression != "" || req.Function != calculatorpb.LOGIC_LOGIC_MINIMIZE {
+		var occurrences []*logicVariable
+		var err error
+		if e, occurrences, err = parseLogic(req.Expression); err != nil {
+			return nil, err
+		}
+		if variables, err = logicVariables(req.Variables, occurrences); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.Function {
+	case calculatorpb.LOGIC_LOGIC_EVALUATE:
+		values := make([]bool, len(variables))
+		for i, name := range variables {
+			v, ok := req.Values[name]
+			if !ok {
+				return nil, invalidArgumentf("variable %s has no value", name)
+			}
+			values[i] = v
+		}
+		return &calculatorpb.LogicResponse{Value: e.eval(values), Variables: variables}, nil
+	case calculatorpb.LOGIC_LOGIC_TRUTH_TABLE:
+		if len(variables) > maxLogicVariables {
+			return nil, invalidArgumentf("a truth table of %d variables is more than the limit of %d", len(variables), maxLogicVariables)
+		}
+		res := &calculatorpb.LogicResponse{Variables: variables, Rows: make([]*calculatorpb.TruthTableRow, 1<<len(variables))}
+		err := truthTable(ctx, e, len(variables), func(row uint32, inputs []bool, output bool) {
+			res.Rows[row] = &calculatorpb.TruthTableRow{Inputs: append([]bool(nil), inputs...), Output: output}
+			if output {
+				res.Minterms = append(res.Minterms, row)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case calculatorpb.LOGIC_LOGIC_MINIMIZE:
+		return c.minimizeLogic(ctx, req, e, variables)
+	}
+	return nil, invalidArgumentf("logic function is not supplied")
+}
+
+// logicVariables are the variables in the order of the request, which must
+// include those of the expression, or else in alphabetical order. The
+// occurrences of the variables are given their index in that order.
+func logicVariables(order []string, occurrences []*logicVariable) ([]string, error) {
+	index := map[string]int{}
+	for i, name := range order {
+		e, _, err := parseLogic(name)
+		if v, ok := e.(*logicVariable); err != nil || !ok || v.name != name {
+			return nil, invalidArgumentf("%q is not a variable name", name)
+		}
+		if _, ok := index[name]; ok {
+			return nil, invalidArgumentf("variable %s is given twice", name)
+		}
+		index[name] = i
+	}
+	variables := order
+	if len(order) == 0 {
+		for _, v := range occurrences {
+			if _, ok := index[v.name]; !ok {
+				index[v.name] = 0
+				variables = append(variables, v.name)
+			}
+		}
+		sort.Strings(variables)
+		for i, name := range variables {
+			index[name] = i
+		}
+	}
+	for _, v := range occurrences {
+		i, ok := index[v.name]
+		if !ok {
+			return nil, invalidArgumentf("variable %s of the expression is not in the variables", v.name)
+		}
+		v.index = i
+	}
+	return variables, nil
+}
+
+// truthTable evaluates e on every row of n variables, the first variable the
+// most significant bit of the row number
+func truthTable(ctx context.Context, e logicExpr, n int, row func(row uint32, inputs []bool, output bool)) error {
+	inputs := make([]bool, n)
+	for r := uint32(0); r < 1<<n; r++ {
+		if r%evaluationsPerCheck == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		for i := range inputs {
+			inputs[i] = r>>(n-1-i)&1 == 1
+		}
+		row(r, inputs, e.eval(inputs))
+	}
+	return nil
+}
+
+// implicant is a product of variables, the bits of mask are the variables
+// absent from it and the other bits of value are the values of the present
+// ones
+type implicant struct {
+	value, mask uint32
+}
+
+// pattern is the implicant as 1, 0 and - for each of n variables
+func (imp implicant) pattern(n int) string {
+	var b strings.Builder
+	for i := n - 1; i >= 0; i-- {
+		switch bit := uint32(1) << i; {
+		case imp.mask&bit != 0:
+			b.WriteByte('-')
+		case imp.value&bit != 0:
+			b.WriteByte('1')
+		default:
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+// covers tells whether row is one of the rows of the implicant
+func (imp implicant) covers(row uint32) bool {
+	return row&^imp.mask == imp.value
+}
+
+// minimizeLogic is a minimal sum of products of the minterms of an
+// expression or of the request
+func (c *Calculator) minimizeLogic(ctx context.Context, req *calculatorpb.LogicRequest, e logicExpr, variables []string) (*calculatorpb.LogicResponse, error) {
+	var minterms []uint32
+	if e != nil {
+		if len(req.Minterms) > 0 {
+			return nil, invalidArgumentf("minterms are given by the expression")
+		}
+	} else {
+		if len(req.Variables) == 0 {
+			return nil, invalidArgumentf("the variables of minterms are not supplied")
+		}
+		var err error
+		if variables, err = logicVariables(req.Variables, nil); err != nil {
+			return nil, err
+		}
+	}
+	n := len(variables)
+	if n > maxLogicVariables {
+		return nil, invalidArgumentf("minimizing %d variables is more than the limit of %d", n, maxLogicVariables)
+	}
+	budget, err := c.budget(req.MaxEvaluations)
+	if err != nil {
+		return nil, err
+	}
+
+	dontCares := map[uint32]bool{}
+	for _, r := range req.DontCares {
+		if r >= 1<<n {
+			return nil, invalidArgumentf("don't care %d is not a row of %d variables", r, n)
+		}
+		dontCares[r] = true
+	}
+	if e != nil {
+		err := truthTable(ctx, e, n, func(row uint32, _ []bool, output bool) {
+			if output && !dontCares[row] {
+				minterms = append(minterms, row)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		seen := map[uint32]bool{}
+		for _, r := range req.Minterms {
+			if r >= 1<<n {
+				return nil, invalidArgumentf("minterm %d is not a row of %d variables", r, n)
+			}
+			if dontCares[r] {
+				return nil, invalidArgumentf("row %d is both a minterm and a don't care", r)
+			}
+			if !seen[r] {
+				seen[r] = true
+				minterms = append(minterms, r)
+			}
+		}
+		sort.Slice(minterms, func(i, j int) bool { return minterms[i] < minterms[j] })
+	}
+
+	res := &calculatorpb.LogicResponse{Variables: variables, Minterms: minterms, Expression: "0"}
+	if len(minterms) == 0 {
+		return res, nil
+	}
+	q := &quineMcCluskey{ctx: ctx, budget: budget}
+	rows := append([]uint32(nil), minterms...)
+	for r := range dontCares {
+		rows = append(rows, r)
+	}
+	primes, err := q.primeImplicants(n, rows)
+	if err != nil {
+		return nil, err
+	}
+	cover, err := q.cover(n, primes, minterms)
+	if err != nil {
+		return nil, err
+	}
+
+	byPattern := func(imps []implicant) []string {
+		patterns := make([]string, len(imps))
+		for i, imp := range imps {
+			patterns[i] = imp.pattern(n)
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(patterns)))
+		return patterns
+	}
+	res.PrimeImplicants, res.Implicants = byPattern(primes), byPattern(cover)
+	terms := make([]string, len(res.Implicants))
+	for i, pattern := range res.Implicants {
+		var literals []string
+		for j, b := range pattern {
+			switch b {
+			case '1':
+				literals = append(literals, variables[j])
+			case '0':
+				literals = append(literals, "!"+variables[j])
+			}
+		}
+		terms[i] = strings.Join(literals, " & ")
+		if len(literals) == 0 {
+			terms[i] = "1"
+		}
+	}
+	res.Expression = strings.Join(terms, " | ")
+	return res, nil
+}
+
+// quineMcCluskey finds the prime implicants of rows and a least cover of
+// minterms by them, counting the implicants and the steps of the search
+// against a budget
+type quineMcCluskey struct {
+	ctx    context.Context
+	budget uint64
+	count  uint64
+}
+
+func (q *quineMcCluskey) step() error {
+	if q.count == q.budget {
+		return fmt.Errorf("%w: minimizing takes more than %d implicants and steps", ErrBudgetExceeded, q.budget)
+	}
+	q.count++
+	if q.count%evaluationsPerCheck == 0 {
+		return q.ctx.Err()
+	}
+	return nil
+}
+
+// primeImplicants merges implicants that differ in a single variable, from
+// the rows up, the implicants that don't merge are prime
+func (q *quineMcCluskey) primeImplicants(n int, rows []uint32) ([]implicant, error) {
+	// the implicants of a size, with whether they merged
+	current := map[implicant]bool{}
+	for _, r := range rows {
+		current[implicant{value: r}] = false
+	}
+	var primes []implicant
+	for len(current) > 0 {
+		next := map[implicant]bool{}
+		for imp := range current {
+			for i := 0; i < n; i++ {
+				bit := uint32(1) << i
+				if imp.mask&bit != 0 || imp.value&bit != 0 {
+					continue
+				}
+				partner := implicant{imp.value | bit, imp.mask}
+				if _, ok := current[partner]; !ok {
+					continue
+				}
+				merged := implicant{imp.value, imp.mask | bit}
+				if _, ok := next[merged]; !ok {
+					if err := q.step(); err != nil {
+						return nil, err
+					}
+					next[merged] = false
+				}
+				current[imp], current[partner] = true, true
+			}
+		}
+		for imp, merged := range current {
+			if !merged {
+				primes = append(primes, imp)
+			}
+		}
+		current = next
+	}
+	return primes, nil
+}
+
+// cover is a least set of primes that covers the minterms, the fewest
+// implicants and then the fewest literals. The essential prime implicants,
+// the only ones of some minterm, are taken first and the rest is a branch
+// and bound search that covers the minterm of the fewest primes first.
+func (q *quineMcCluskey) cover(n int, primes []implicant, minterms []uint32) ([]implicant, error) {
+	literals := func(imp implicant) int {
+		return n - bits.OnesCount32(imp.mask)
+	}
+	// the larger implicants first, which tend to find a small cover early
+	sort.Slice(primes, func(i, j int) bool {
+		if li, lj := literals(primes[i]), literals(primes[j]); li != lj {
+			return li < lj
+		}
+		return primes[i].value < primes[j].value
+	})
+	index := make(map[uint32]int, len(minterms))
+	for m, row := range minterms {
+		index[row] = m
+	}
+	// the chart of the minterms of each prime and the primes of each minterm,
+	// from the rows of the primes, the submasks of their masks
+	coveredBy, covers := make([][]int, len(minterms)), make([][]int, len(primes))
+	for p, imp := range primes {
+		for sub := imp.mask; ; sub = (sub - 1) & imp.mask {
+			if err := q.step(); err != nil {
+				return nil, err
+			}
+			if m, ok := index[imp.value|sub]; ok {
+				coveredBy[m] = append(coveredBy[m], p)
+				covers[p] = append(covers[p], m)
+			}
+			if sub == 0 {
+				break
+			}
+		}
+	}
+
+	var chosen []int
+	chosenLiterals := 0
+	covered := make([]bool, len(minterms))
+	for m := range minterms {
+		if len(coveredBy[m]) == 1 && !covered[m] {
+			p := coveredBy[m][0]
+			chosen, chosenLiterals = append(chosen, p), chosenLiterals+literals(primes[p])
+			for _, m := range covers[p] {
+				covered[m] = true
+			}
+		}
+	}
+	var uncovered []int
+	for m := range minterms {
+		if !covered[m] {
+			uncovered = append(uncovered, m)
+		}
+	}
+
+	var best []int
+	bestLiterals := 0
+	var search func(uncovered []int, chosenLiterals int) error
+	search = func(uncovered []int, chosenLiterals int) error {
+		if len(uncovered) == 0 {
+			if best == nil || len(chosen) < len(best) || (len(chosen) == len(best) && chosenLiterals < bestLiterals) {
+				best, bestLiterals = append(best[:0], chosen...), chosenLiterals
+			}
+			return nil
+		}
+		if best != nil && len(chosen)+1 > len(best) {
+			return nil
+		}
+		if err := q.step(); err != nil {
+			return err
+		}
+		pick := uncovered[0]
+		for _, m := range uncovered {
+			if len(coveredBy[m]) < len(coveredBy[pick]) {
+				pick = m
+			}
+		}
+		for _, p := range coveredBy[pick] {
+			var rest []int
+			for _, m := range uncovered {
+				if !primes[p].covers(minterms[m]) {
+					rest = append(rest, m)
+				}
+			}
+			chosen = append(chosen, p)
+			err := search(rest, chosenLiterals+literals(primes[p]))
+			chosen = chosen[:len(chosen)-1]
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := search(uncovered, chosenLiterals); err != nil {
+		return nil, err
+	}
+	cover := make([]implicant, len(best))
+	for i, p := range best {
+		cover[i] = primes[p]
+	}
+	return cover, nil
+}
@@ -0,0 +1,240 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LogicEvaluate(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		expression string
+		values     map[string]bool
+		expected   bool
+	}{
+		{"a & b", map[string]bool{"a": true, "b": false}, false},
+		{"a AND b", map[string]bool{"a": true, "b": true}, true},
+		{"a nand b", map[string]bool{"a": true, "b": false}, true},
+		{"a || b", map[string]bool{"a": false, "b": false}, false},
+		{"a NOR b", map[string]bool{"a": false, "b": false}, true},
+		{"a ^ b", map[string]bool{"a": true, "b": true}, false},
+		{"a xor b", map[string]bool{"a": true, "b": false}, true},
+		{"a -> b", map[string]bool{"a": true, "b": false}, false},
+		{"a implies b", map[string]bool{"a": false, "b": false}, true},
+		{"a <-> b", map[string]bool{"a": false, "b": false}, true},
+		{"a xnor b", map[string]bool{"a": false, "b": true}, false},
+		{"!a & b", map[string]bool{"a": false, "b": true}, true},
+		{"not (a | b)", map[string]bool{"a": false, "b": true}, false},
+		{"~~a", map[string]bool{"a": true}, true},
+		// and binds tighter than xor, which binds tighter than or
+		{"a | b & c", map[string]bool{"a": false, "b": true, "c": false}, false},
+		{"a | b ^ c", map[string]bool{"a": true, "b": true, "c": true}, true},
+		{"a ^ b & c", map[string]bool{"a": true, "b": true, "c": false}, true},
+		// implication is right associative, (a -> b) -> c is false here
+		{"a -> b -> c", map[string]bool{"a": false, "b": false, "c": false}, true},
+		{"a -> b <=> !a | b", map[string]bool{"a": true, "b": false}, true},
+		{"true xor 1", nil, false},
+		{"FALSE | 0 => x", map[string]bool{"x": false}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			res, err := calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+				Function: calculatorpb.LOGIC_LOGIC_EVALUATE, Expression: tt.expression, Values: tt.values,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, res.Value)
+		})
+	}
+}
+
+func Test_LogicTruthTable(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: "b -> a",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, res.Variables)
+	assert.Equal(t, []*calculatorpb.TruthTableRow{
+		{Inputs: []bool{false, false}, Output: true},
+		{Inputs: []bool{false, true}, Output: false},
+		{Inputs: []bool{true, false}, Output: true},
+		{Inputs: []bool{true, true}, Output: true},
+	}, res.Rows)
+	assert.Equal(t, []uint32{0, 2, 3}, res.Minterms)
+
+	// in the order of the request, with a variable the expression lacks
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: "b -> a", Variables: []string{"b", "c", "a"},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Rows, 8)
+	assert.Equal(t, []uint32{0, 1, 2, 3, 5, 7}, res.Minterms)
+
+	// a constant has a single row
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: "1 & !0"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*calculatorpb.TruthTableRow{{Output: true}}, res.Rows)
+
+	// the parity of 16 variables
+	names := strings.Split("abcdefghijklmnop", "")
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: strings.Join(names, " ^ "),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Rows, 1<<16)
+	assert.Len(t, res.Minterms, 1<<15)
+	assert.Equal(t, uint32(1), res.Minterms[0])
+}
+
+func Test_LogicMinimize(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		request    *calculatorpb.LogicRequest
+		expression string
+		implicants []string
+	}{
+		{"Absorb", &calculatorpb.LogicRequest{Expression: "a & b | a & !b"}, "a", []string{"1-"}},
+		{"Tautology", &calculatorpb.LogicRequest{Expression: "a | !a"}, "1", []string{"-"}},
+		{"Contradiction", &calculatorpb.LogicRequest{Expression: "a & !a"}, "0", nil},
+		{"Xor", &calculatorpb.LogicRequest{Expression: "a ^ b"}, "a & !b | !a & b", []string{"10", "01"}},
+		{"Implication", &calculatorpb.LogicRequest{Expression: "a -> b"}, "!a | b", []string{"0-", "-1"}},
+		{"Consensus", &calculatorpb.LogicRequest{Expression: "a & b | !a & c | b & c"}, "a & b | !a & c", []string{"11-", "0-1"}},
+		{
+			name:       "DontCares",
+			request:    &calculatorpb.LogicRequest{Variables: []string{"a", "b", "c"}, Minterms: []uint32{1, 3}, DontCares: []uint32{5, 7}},
+			expression: "c", implicants: []string{"--1"},
+		},
+		{
+			name:       "ExpressionDontCares",
+			request:    &calculatorpb.LogicRequest{Expression: "a & b", DontCares: []uint32{1}},
+			expression: "b", implicants: []string{"-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.Function = calculatorpb.LOGIC_LOGIC_MINIMIZE
+			res, err := calculatorSvc.Logic(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expression, res.Expression)
+			assert.Equal(t, tt.implicants, res.Implicants)
+		})
+	}
+}
+
+func Test_LogicMinimizeCover(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// equivalent checks that the minimized expression is true on the minterms
+	// and false off them and the don't cares
+	equivalent := func(res *calculatorpb.LogicResponse, dontCares []uint32) {
+		table, err := calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+			Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: res.Expression, Variables: res.Variables,
+		})
+		assert.Nil(t, err)
+		want := map[uint32]bool{}
+		for _, m := range res.Minterms {
+			want[m] = true
+		}
+		for _, d := range dontCares {
+			want[d] = table.Rows[d].Output
+		}
+		for r, row := range table.Rows {
+			assert.Equal(t, want[uint32(r)], row.Output, "row %d", r)
+		}
+	}
+
+	// the example of Wikipedia, which has two covers of 3 implicants
+	res, err := calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_MINIMIZE, Variables: []string{"A", "B", "C", "D"},
+		Minterms: []uint32{4, 8, 10, 11, 12, 15}, DontCares: []uint32{9, 14},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10--", "1-1-", "1--0", "-100"}, res.PrimeImplicants)
+	assert.Len(t, res.Implicants, 3)
+	assert.Contains(t, res.Implicants, "-100")
+	assert.Contains(t, res.Implicants, "1-1-")
+	equivalent(res, []uint32{9, 14})
+
+	// a cyclic chart, without essential prime implicants, of 6 primes of
+	// which 3 cover
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_MINIMIZE, Variables: []string{"a", "b", "c"}, Minterms: []uint32{0, 1, 2, 5, 6, 7},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.PrimeImplicants, 6)
+	assert.Len(t, res.Implicants, 3)
+	equivalent(res, nil)
+
+	// parity has no smaller form than its minterms
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_MINIMIZE, Expression: "a xor b xor c xor d xor e xor f",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Implicants, 32)
+	equivalent(res, nil)
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: calculatorpb.LOGIC_LOGIC_MINIMIZE, Expression: strings.Join(strings.Split("abcdefghijklmnop", ""), " ^ "),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Implicants, 1<<15)
+
+	// a majority of 5
+	res, err = calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function:   calculatorpb.LOGIC_LOGIC_MINIMIZE,
+		Expression: "a&b&c | a&b&d | a&b&e | a&c&d | a&c&e | a&d&e | b&c&d | b&c&e | b&d&e | c&d&e | a&b&c&d&!e",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Implicants, 10)
+	equivalent(res, nil)
+}
+
+func Test_LogicErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	evaluate := calculatorpb.LOGIC_LOGIC_EVALUATE
+	minimize := calculatorpb.LOGIC_LOGIC_MINIMIZE
+	tests := []struct {
+		name    string
+		request *calculatorpb.LogicRequest
+	}{
+		{"MissingFunction", &calculatorpb.LogicRequest{Expression: "a"}},
+		{"MissingExpression", &calculatorpb.LogicRequest{Function: evaluate}},
+		{"MissingValue", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a & b", Values: map[string]bool{"a": true}}},
+		{"InvalidCharacter", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a + b"}},
+		{"InvalidConstant", &calculatorpb.LogicRequest{Function: evaluate, Expression: "2 & a"}},
+		{"MissingOperand", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a &"}},
+		{"UnbalancedParenthesis", &calculatorpb.LogicRequest{Function: evaluate, Expression: "(a | b"}},
+		{"TooDeep", &calculatorpb.LogicRequest{Function: evaluate, Expression: strings.Repeat("!", 200) + "a"}},
+		{"VariableNotListed", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a & b", Variables: []string{"a"}}},
+		{"KeywordVariable", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a", Variables: []string{"a", "or"}}},
+		{"RepeatedVariable", &calculatorpb.LogicRequest{Function: evaluate, Expression: "a", Variables: []string{"a", "a"}}},
+		{"TooManyVariables", &calculatorpb.LogicRequest{Function: calculatorpb.LOGIC_LOGIC_TRUTH_TABLE, Expression: "a", Variables: strings.Split("abcdefghijklmnopq", "")}},
+		{"MinimizeWithoutVariables", &calculatorpb.LogicRequest{Function: minimize, Minterms: []uint32{1}}},
+		{"MintermOutOfRange", &calculatorpb.LogicRequest{Function: minimize, Variables: []string{"a"}, Minterms: []uint32{2}}},
+		{"MintermAndDontCare", &calculatorpb.LogicRequest{Function: minimize, Variables: []string{"a"}, Minterms: []uint32{1}, DontCares: []uint32{1}}},
+		{"ExpressionAndMinterms", &calculatorpb.LogicRequest{Function: minimize, Expression: "a", Minterms: []uint32{1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Logic(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	// the implicants of an or of 16 variables are the 3^16 - 2^16 products
+	// without a negation
+	_, err := calculatorSvc.Logic(context.Background(), &calculatorpb.LogicRequest{
+		Function: minimize, Expression: strings.Join(strings.Split("abcdefghijklmnop", ""), " | "), MaxEvaluations: 100000,
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrBudgetExceeded), "unexpected error %v", err)
+}
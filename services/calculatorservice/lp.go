@@ -0,0 +1,435 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxLPVariables   = 1000
+	maxLPConstraints = 1000
+	maxSimplexPivots = 100000
+	// simplexTolerance is the size below which entries of the tableau count as 0
+	simplexTolerance = 1e-9
+	// blandPivots degenerate pivots in a row switch the entering column to
+	// Bland's rule, which can't cycle
+	blandPivots = 50
+)
+
+// lpVariable is a variable of a program in terms of the non-negative columns
+// of the tableau, offset + sign*x[column] - x[negative] where negative is the
+// column of the negative part of a free variable, or -1
+type lpVariable struct {
+	offset, sign     float64
+	column, negative int
+}
+
+// simplexTableau is a program of minimizing cost . x subject to rows x = rhs
+// and x >= 0, with a basis of columns that are the identity in the rows
+type simplexTableau struct {
+	// rows of the columns, the last entry of a row is its right hand side
+	rows [][]float64
+	// cost are the reduced costs of the columns, the last entry is minus the
+	// objective
+	cost   []float64
+	basis  []int
+	barred []bool
+	pivots int
+}
+
+// LinearProgram solves a linear program by the two phase simplex method.
+// Bounds turn into shifts of the variables and constraints of their own, and
+// the program is infeasible when the first phase can't bring the artificial
+// variables of the constraints to 0.
+func (c *Calculator) LinearProgram(ctx context.Context, req *calculatorpb.LinearProgramRequest) (*calculatorpb.LinearProgramResponse, error) {
+	n := len(req.Objective)
+	if n == 0 || n > maxLPVariables {
+		return nil, invalidArgumentf("%d variables are not within [1, %d]", n, maxLPVariables)
+	}
+	if len(req.Constraints) > maxLPConstraints {
+		return nil, invalidArgumentf("%d constraints are above %d", len(req.Constraints), maxLPConstraints)
+	}
+	if req.Sense != calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE && req.Sense != calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE {
+		return nil, invalidArgumentf("sense is not supplied")
+	}
+	if err := finiteValues("objective", req.Objective); err != nil {
+		return nil, err
+	}
+	for i, constraint := range req.Constraints {
+		if len(constraint.Coefficients) != n {
+			return nil, invalidArgumentf("constraint %d has %d coefficients for %d variables", i+1, len(constraint.Coefficients), n)
+		}
+		if err := finiteValues(fmt.Sprintf("constraint %d", i+1), constraint.Coefficients); err != nil {
+			return nil, err
+		}
+		if math.IsNaN(constraint.Rhs) || math.IsInf(constraint.Rhs, 0) {
+			return nil, invalidArgumentf("rhs of constraint %d is %v", i+1, constraint.Rhs)
+		}
+		if constraint.Relation < calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL || constraint.Relation > calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_EQUAL {
+			return nil, invalidArgumentf("constraint %d has no relation", i+1)
+		}
+	}
+	if len(req.Bounds) != 0 && len(req.Bounds) != n {
+		return nil, invalidArgumentf("%d bounds are given for %d variables", len(req.Bounds), n)
+	}
+
+	// the variables as columns, and the constraints of their upper bounds
+	variables := make([]lpVariable, n)
+	type boundRow struct {
+		column int
+		rhs    float64
+	}
+	var bounds []boundRow
+	columns := 0
+	for j := range variables {
+		lower, upper := 0.0, math.Inf(1)
+		if len(req.Bounds) > 0 {
+			lower, upper = req.Bounds[j].Lower, req.Bounds[j].Upper
+		}
+		if math.IsNaN(lower) || math.IsNaN(upper) || lower > upper || math.IsInf(lower, 1) || math.IsInf(upper, -1) {
+			return nil, invalidArgumentf("bounds [%v, %v] of variable %d are empty", lower, upper, j+1)
+		}
+		v := lpVariable{sign: 1, column: columns, negative: -1}
+		columns++
+		switch {
+		case !math.IsInf(lower, 0):
+			v.offset = lower
+			if !math.IsInf(upper, 0) {
+				bounds = append(bounds, boundRow{v.column, upper - lower})
+			}
+		case !math.IsInf(upper, 0):
+			v.offset, v.sign = upper, -1
+		default:
+			v.negative = columns
+			columns++
+		}
+		variables[j] = v
+	}
+
+	m := len(req.Constraints) + len(bounds)
+	relations := make([]calculatorpb.CONSTRAINT_RELATION, m)
+	structural := make([][]float64, m)
+	rhs := make([]float64, m)
+	for i, constraint := range req.Constraints {
+		structural[i] = make([]float64, columns)
+		rhs[i] = constraint.Rhs
+		relations[i] = constraint.Relation
+		for j, a := range constraint.Coefficients {
+			v := variables[j]
+			structural[i][v.column] += a * v.sign
+			if v.negative >= 0 {
+				structural[i][v.negative] -= a
+			}
+			rhs[i] -= a * v.offset
+		}
+	}
+	for k, b := range bounds {
+		i := len(req.Constraints) + k
+		structural[i] = make([]float64, columns)
+		structural[i][b.column] = 1
+		rhs[i] = b.rhs
+		relations[i] = calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL
+	}
+
+	// the rows and then the columns are scaled to unit length, so that the
+	// tolerance of the tableau means the same for all of them
+	rowScale := make([]float64, m)
+	for i, row := range structural {
+		for _, a := range row {
+			rowScale[i] = math.Hypot(rowScale[i], a)
+		}
+		if rowScale[i] == 0 {
+			rowScale[i] = 1
+		}
+		for j := range row {
+			row[j] /= rowScale[i]
+		}
+		rhs[i] /= rowScale[i]
+	}
+	columnScale := make([]float64, columns)
+	for j := range columnScale {
+		for _, row := range structural {
+			columnScale[j] = math.Hypot(columnScale[j], row[j])
+		}
+		if columnScale[j] == 0 {
+			columnScale[j] = 1
+		}
+		for _, row := range structural {
+			row[j] /= columnScale[j]
+		}
+	}
+
+	// a row with a negative right hand side is negated, then each row has a
+	// slack, or a surplus and an artificial variable, or just an artificial
+	// variable, and the slack or artificial variable is its unit column
+	negated := make([]bool, m)
+	unit := make([]int, m)
+	var artificial []int
+	total := columns
+	for i := range structural {
+		if rhs[i] < 0 {
+			negated[i] = true
+			switch relations[i] {
+			case calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL:
+				relations[i] = calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL
+			case calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL:
+				relations[i] = calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL
+			}
+		}
+		if relations[i] == calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL {
+			total++
+		}
+		unit[i] = total
+		total++
+		if relations[i] != calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL {
+			artificial = append(artificial, unit[i])
+		}
+	}
+	t := &simplexTableau{rows: make([][]float64, m), cost: make([]float64, total+1), basis: append([]int(nil), unit...), barred: make([]bool, total)}
+	for i := range t.rows {
+		row := make([]float64, total+1)
+		sign := 1.0
+		if negated[i] {
+			sign = -1
+		}
+		for j, a := range structural[i] {
+			row[j] = sign * a
+		}
+		row[total] = sign * rhs[i]
+		if relations[i] == calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL {
+			row[unit[i]-1] = -1
+		}
+		row[unit[i]] = 1
+		t.rows[i] = row
+	}
+
+	res := &calculatorpb.LinearProgramResponse{}
+	// the first phase minimizes the sum of the artificial variables
+	if len(artificial) > 0 {
+		isArtificial := make([]bool, total)
+		for _, j := range artificial {
+			isArtificial[j] = true
+		}
+		for i, row := range t.rows {
+			if isArtificial[unit[i]] {
+				for j := range t.cost {
+					if j == total || !isArtificial[j] {
+						t.cost[j] -= row[j]
+					}
+				}
+			}
+		}
+		if _, err := t.solve(ctx); err != nil {
+			return nil, err
+		}
+		scale := 1.0
+		for _, row := range t.rows {
+			scale = math.Max(scale, row[total])
+		}
+		if -t.cost[total] > simplexTolerance*scale {
+			res.Status = calculatorpb.LP_STATUS_LP_STATUS_INFEASIBLE
+			res.Pivots = uint32(t.pivots)
+			return res, nil
+		}
+		// artificial variables left in the basis at 0 leave it where their
+		// row has another column, otherwise the row is redundant
+		for i := range t.rows {
+			if !isArtificial[t.basis[i]] {
+				continue
+			}
+			for j := 0; j < total; j++ {
+				if !isArtificial[j] && math.Abs(t.rows[i][j]) > simplexTolerance {
+					t.pivot(i, j)
+					break
+				}
+			}
+		}
+		copy(t.barred, isArtificial)
+	}
+
+	// the second phase minimizes the objective, negated to maximize
+	sense := 1.0
+	if req.Sense == calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE {
+		sense = -1
+	}
+	costs := make([]float64, total)
+	for j, c := range req.Objective {
+		v := variables[j]
+		costs[v.column] = sense * c * v.sign
+		if v.negative >= 0 {
+			costs[v.negative] = -sense * c
+		}
+	}
+	// the costs are in the scaled columns, and scaled to unit length too
+	costScale := 0.0
+	for j := 0; j < columns; j++ {
+		costs[j] /= columnScale[j]
+		costScale = math.Hypot(costScale, costs[j])
+	}
+	if costScale == 0 {
+		costScale = 1
+	}
+	for j := range costs {
+		costs[j] /= costScale
+	}
+	copy(t.cost, costs)
+	t.cost[total] = 0
+	for i, row := range t.rows {
+		if c := costs[t.basis[i]]; c != 0 {
+			for j := range t.cost {
+				t.cost[j] -= c * row[j]
+			}
+		}
+	}
+	unbounded, err := t.solve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res.Pivots = uint32(t.pivots)
+	if unbounded {
+		res.Status = calculatorpb.LP_STATUS_LP_STATUS_UNBOUNDED
+		return res, nil
+	}
+
+	value := make([]float64, total)
+	for i, j := range t.basis {
+		value[j] = t.rows[i][total]
+		if j < columns {
+			value[j] /= columnScale[j]
+		}
+	}
+	res.Status = calculatorpb.LP_STATUS_LP_STATUS_OPTIMAL
+	res.Solution = make([]float64, n)
+	for j, v := range variables {
+		x := v.offset + v.sign*value[v.column]
+		if v.negative >= 0 {
+			x -= value[v.negative]
+		}
+		res.Solution[j] = x
+		res.Objective += req.Objective[j] * x
+	}
+	// the reduced cost of the unit column of a row is minus its dual, in the
+	// sense, the sign and the scale of the row as solved
+	for i, constraint := range req.Constraints {
+		dual := -t.cost[unit[i]] * sense * costScale / rowScale[i]
+		if negated[i] {
+			dual = -dual
+		}
+		if dual == 0 {
+			// not -0
+			dual = 0
+		}
+		res.Duals = append(res.Duals, dual)
+		lhs := 0.0
+		for j, a := range constraint.Coefficients {
+			lhs += a * res.Solution[j]
+		}
+		slack := 0.0
+		switch constraint.Relation {
+		case calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL:
+			slack = math.Max(0, constraint.Rhs-lhs)
+		case calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL:
+			slack = math.Max(0, lhs-constraint.Rhs)
+		}
+		res.Slacks = append(res.Slacks, slack)
+	}
+	return res, nil
+}
+
+// solve pivots to the least cost, entering the column of the most negative
+// reduced cost, and tells whether the cost is unbounded below
+func (t *simplexTableau) solve(ctx context.Context) (bool, error) {
+	last := len(t.cost) - 1
+	degenerate := 0
+	for {
+		enter := -1
+		for j := 0; j < last; j++ {
+			if t.barred[j] || t.cost[j] >= -simplexTolerance {
+				continue
+			}
+			if degenerate >= blandPivots {
+				enter = j
+				break
+			}
+			if enter < 0 || t.cost[j] < t.cost[enter] {
+				enter = j
+			}
+		}
+		if enter < 0 {
+			return false, nil
+		}
+
+		// the ratio test, ties go to the lowest basic column as Bland's rule
+		// has it
+		leave := -1
+		var best float64
+		for i, row := range t.rows {
+			if row[enter] <= simplexTolerance {
+				continue
+			}
+			ratio := row[last] / row[enter]
+			if leave < 0 || ratio < best || (ratio == best && t.basis[i] < t.basis[leave]) {
+				leave, best = i, ratio
+			}
+		}
+		if leave < 0 {
+			return true, nil
+		}
+		if best <= simplexTolerance {
+			degenerate++
+		} else {
+			degenerate = 0
+		}
+
+		if t.pivots == maxSimplexPivots {
+			return false, fmt.Errorf("%w: the simplex method took more than %d pivots", ErrBudgetExceeded, maxSimplexPivots)
+		}
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		t.pivot(leave, enter)
+	}
+}
+
+// pivot makes column the basic column of row
+func (t *simplexTableau) pivot(row, column int) {
+	r := t.rows[row]
+	scale := 1 / r[column]
+	for j := range r {
+		r[j] *= scale
+	}
+	r[column] = 1
+	eliminate := func(other []float64) {
+		f := other[column]
+		if f == 0 {
+			return
+		}
+		for j, v := range r {
+			if v != 0 {
+				other[j] -= f * v
+			}
+		}
+		other[column] = 0
+	}
+	for i, other := range t.rows {
+		if i != row {
+			eliminate(other)
+		}
+	}
+	eliminate(t.cost)
+	t.basis[row] = column
+	t.pivots++
+}
+
+// finiteValues checks that the values of name are finite numbers
+func finiteValues(name string, values []float64) error {
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return invalidArgumentf("value %d of %s is %v", i+1, name, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessEqual(rhs float64, coefficients ...float64) *calculatorpb.LinearConstraint {
+	return &calculatorpb.LinearConstraint{Coefficients: coefficients, Relation: calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_LESS_EQUAL, Rhs: rhs}
+}
+
+func greaterEqual(rhs float64, coefficients ...float64) *calculatorpb.LinearConstraint {
+	return &calculatorpb.LinearConstraint{Coefficients: coefficients, Relation: calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_GREATER_EQUAL, Rhs: rhs}
+}
+
+func equal(rhs float64, coefficients ...float64) *calculatorpb.LinearConstraint {
+	return &calculatorpb.LinearConstraint{Coefficients: coefficients, Relation: calculatorpb.CONSTRAINT_RELATION_CONSTRAINT_RELATION_EQUAL, Rhs: rhs}
+}
+
+func Test_LinearProgram(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	minimize, maximize := calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE, calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE
+	tests := []struct {
+		name      string
+		request   *calculatorpb.LinearProgramRequest
+		solution  []float64
+		objective float64
+		duals     []float64
+		slacks    []float64
+	}{
+		{
+			name: "Maximize",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: maximize, Objective: []float64{3, 5},
+				Constraints: []*calculatorpb.LinearConstraint{lessEqual(4, 1, 0), lessEqual(12, 0, 2), lessEqual(18, 3, 2)},
+			},
+			solution: []float64{2, 6}, objective: 36, duals: []float64{0, 1.5, 1}, slacks: []float64{2, 0, 0},
+		},
+		{
+			name: "Minimize",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: minimize, Objective: []float64{2, 3},
+				Constraints: []*calculatorpb.LinearConstraint{greaterEqual(4, 1, 1), greaterEqual(6, 1, 3)},
+			},
+			solution: []float64{3, 1}, objective: 9, duals: []float64{1.5, 0.5}, slacks: []float64{0, 0},
+		},
+		{
+			name: "NegativeRhs",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: minimize, Objective: []float64{1},
+				Constraints: []*calculatorpb.LinearConstraint{lessEqual(-2, -1)},
+			},
+			solution: []float64{2}, objective: 2, duals: []float64{-1}, slacks: []float64{0},
+		},
+		{
+			name: "FreeAndBounded",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: minimize, Objective: []float64{1, 1},
+				Constraints: []*calculatorpb.LinearConstraint{equal(1, 1, -1)},
+				Bounds:      []*calculatorpb.VariableBounds{{Lower: -5, Upper: 5}, {Lower: math.Inf(-1), Upper: math.Inf(1)}},
+			},
+			solution: []float64{-5, -6}, objective: -11, duals: []float64{-1}, slacks: []float64{0},
+		},
+		{
+			name: "UpperBoundOnly",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: maximize, Objective: []float64{1, -1},
+				Bounds: []*calculatorpb.VariableBounds{{Lower: math.Inf(-1), Upper: 3}, {Lower: 1, Upper: 2}},
+			},
+			solution: []float64{3, 1}, objective: 2,
+		},
+		{
+			name: "RedundantEquality",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: minimize, Objective: []float64{1, 0},
+				Constraints: []*calculatorpb.LinearConstraint{equal(2, 1, 1), equal(4, 2, 2)},
+			},
+			solution: []float64{0, 2}, objective: 0, slacks: []float64{0, 0},
+		},
+		{
+			// Beale's example, on which the simplex method with the most
+			// negative reduced cost cycles without a rule to break ties
+			name: "Degenerate",
+			request: &calculatorpb.LinearProgramRequest{
+				Sense: minimize, Objective: []float64{-0.75, 20, -0.5, 6},
+				Constraints: []*calculatorpb.LinearConstraint{
+					lessEqual(0, 0.25, -8, -1, 9),
+					lessEqual(0, 0.5, -12, -0.5, 3),
+					lessEqual(1, 0, 0, 1, 0),
+				},
+			},
+			solution: []float64{1, 0, 1, 0}, objective: -1.25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.LinearProgram(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_OPTIMAL, res.Status)
+			assert.InDeltaSlice(t, tt.solution, res.Solution, 1e-9)
+			assert.InDelta(t, tt.objective, res.Objective, 1e-9)
+			if tt.duals != nil {
+				assert.InDeltaSlice(t, tt.duals, res.Duals, 1e-9)
+			}
+			if tt.slacks != nil {
+				assert.InDeltaSlice(t, tt.slacks, res.Slacks, 1e-9)
+			}
+		})
+	}
+}
+
+func Test_LinearProgramStatus(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE, Objective: []float64{1},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(1, 1), greaterEqual(2, 1)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_INFEASIBLE, res.Status)
+	assert.Empty(t, res.Solution)
+
+	res, err = calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE, Objective: []float64{1, 1},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(1, 1, -1)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_UNBOUNDED, res.Status)
+
+	res, err = calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE, Objective: []float64{1},
+		Bounds: []*calculatorpb.VariableBounds{{Lower: math.Inf(-1), Upper: math.Inf(1)}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_UNBOUNDED, res.Status)
+}
+
+func Test_LinearProgramBadlyScaled(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE, Objective: []float64{1},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(1, 1e-12)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_OPTIMAL, res.Status)
+	assert.InEpsilon(t, 1e12, res.Solution[0], 1e-9)
+	assert.InEpsilon(t, 1e12, res.Duals[0], 1e-9)
+
+	// a tiny objective and constraints of very different sizes
+	res, err = calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE, Objective: []float64{1e-12, 2e-12},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(4e6, 1e6, 1e6), lessEqual(3e-6, 1e-6, 3e-6)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_OPTIMAL, res.Status)
+	assert.InDeltaSlice(t, []float64{3, 0}, res.Solution, 1e-9)
+
+	res, err = calculatorSvc.LinearProgram(context.Background(), &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE, Objective: []float64{1},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(1e-12, 1e-12), greaterEqual(2e-12, 1e-12)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_INFEASIBLE, res.Status)
+}
+
+func Test_LinearProgramDuality(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// a production plan of 60 products and 40 resources
+	const n, m = 60, 40
+	request := &calculatorpb.LinearProgramRequest{Sense: calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE}
+	for j := 0; j < n; j++ {
+		request.Objective = append(request.Objective, float64(1+(j*7)%11))
+	}
+	for i := 0; i < m; i++ {
+		coefficients := make([]float64, n)
+		for j := range coefficients {
+			coefficients[j] = float64((i*13 + j*17) % 9)
+		}
+		request.Constraints = append(request.Constraints, lessEqual(float64(100+(i*31)%57), coefficients...))
+	}
+	res, err := calculatorSvc.LinearProgram(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, calculatorpb.LP_STATUS_LP_STATUS_OPTIMAL, res.Status)
+
+	// the solution is feasible, the dual objective equals the primal one and
+	// only binding constraints have a price
+	for _, x := range res.Solution {
+		assert.GreaterOrEqual(t, x, -1e-9)
+	}
+	dual := 0.0
+	for i, constraint := range request.Constraints {
+		assert.GreaterOrEqual(t, res.Slacks[i], 0.0)
+		assert.GreaterOrEqual(t, res.Duals[i], -1e-9)
+		assert.InDelta(t, 0, res.Duals[i]*res.Slacks[i], 1e-7)
+		dual += res.Duals[i] * constraint.Rhs
+	}
+	assert.InDelta(t, res.Objective, dual, 1e-7)
+	assert.Greater(t, res.Pivots, uint32(0))
+}
+
+func Test_LinearProgramErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	minimize := calculatorpb.LP_SENSE_LP_SENSE_MINIMIZE
+	tests := []struct {
+		name    string
+		request *calculatorpb.LinearProgramRequest
+	}{
+		{"NoVariables", &calculatorpb.LinearProgramRequest{Sense: minimize}},
+		{"MissingSense", &calculatorpb.LinearProgramRequest{Objective: []float64{1}}},
+		{"NaNObjective", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{math.NaN()}}},
+		{"CoefficientCount", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1, 2}, Constraints: []*calculatorpb.LinearConstraint{lessEqual(1, 1)}}},
+		{"MissingRelation", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1}, Constraints: []*calculatorpb.LinearConstraint{{Coefficients: []float64{1}, Rhs: 1}}}},
+		{"InfiniteRhs", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1}, Constraints: []*calculatorpb.LinearConstraint{lessEqual(math.Inf(1), 1)}}},
+		{"BoundsCount", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1, 2}, Bounds: []*calculatorpb.VariableBounds{{Upper: 1}}}},
+		{"EmptyBounds", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1}, Bounds: []*calculatorpb.VariableBounds{{Lower: 2, Upper: 1}}}},
+		{"InfiniteLower", &calculatorpb.LinearProgramRequest{Sense: minimize, Objective: []float64{1}, Bounds: []*calculatorpb.VariableBounds{{Lower: math.Inf(1), Upper: math.Inf(1)}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.LinearProgram(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := calculatorSvc.LinearProgram(ctx, &calculatorpb.LinearProgramRequest{
+		Sense: calculatorpb.LP_SENSE_LP_SENSE_MAXIMIZE, Objective: []float64{1},
+		Constraints: []*calculatorpb.LinearConstraint{lessEqual(1, 1)},
+	})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
@@ -0,0 +1,206 @@
+package calculatorservice
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	percentDigits = 4
+	maxRatios     = 1000
+)
+
+// MoneyCalculator allocates amounts of money and applies percentages, markups,
+// margins and taxes to them in exact decimals
+func (c *Calculator) MoneyCalculator(ctx context.Context, req *calculatorpb.MoneyCalculateRequest) (*calculatorpb.MoneyCalculateResponse, error) {
+	if req.Amount == nil {
+		return nil, invalidArgumentf("amount is not supplied")
+	}
+	currency, amount, err := parseMoney(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	places := minorUnit(currency)
+	money := func(x *big.Rat) *calculatorpb.Money {
+		return &calculatorpb.Money{Amount: formatDecimal(x, places), Currency: currency}
+	}
+	round := func(x *big.Rat) *big.Rat {
+		return roundDecimal(x, places, req.Rounding)
+	}
+
+	var percent *big.Rat
+	switch req.Operator {
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_OF, calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARKUP,
+		calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARGIN, calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ADD_TAX,
+		calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_REMOVE_TAX:
+		if req.Percent == "" {
+			return nil, invalidArgumentf("percent is not supplied")
+		}
+		if percent, err = parseDecimal(req.Percent); err != nil {
+			return nil, err
+		}
+		// as a fraction
+		percent.Quo(percent, big.NewRat(100, 1))
+	}
+	one := big.NewRat(1, 1)
+
+	switch req.Operator {
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE:
+		parts, err := allocate(amount, req.Ratios, places)
+		if err != nil {
+			return nil, err
+		}
+		res := &calculatorpb.MoneyCalculateResponse{Result: money(amount)}
+		for _, p := range parts {
+			res.Parts = append(res.Parts, money(p))
+		}
+		return res, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_OF:
+		return &calculatorpb.MoneyCalculateResponse{
+			Result: money(round(amount.Mul(amount, percent))),
+		}, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE:
+		if req.Other == nil {
+			return nil, invalidArgumentf("other is not supplied")
+		}
+		otherCurrency, other, err := parseMoney(req.Other)
+		if err != nil {
+			return nil, err
+		}
+		if otherCurrency != currency {
+			return nil, invalidArgumentf("can not compare %s with %s, convert them to one currency first", currency, otherCurrency)
+		}
+		if amount.Sign() == 0 {
+			return nil, invalidArgumentf("the percent change from zero is undefined")
+		}
+		change := new(big.Rat).Sub(other, amount)
+		change.Quo(change, new(big.Rat).Abs(amount))
+		change.Mul(change, big.NewRat(100, 1))
+		return &calculatorpb.MoneyCalculateResponse{
+			Percent: formatDecimal(roundDecimal(change, percentDigits, req.Rounding), percentDigits),
+		}, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARKUP:
+		// price = cost (1 + markup)
+		return &calculatorpb.MoneyCalculateResponse{
+			Result: money(round(amount.Mul(amount, percent.Add(percent, one)))),
+		}, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARGIN:
+		// price = cost / (1 - margin)
+		if percent.Cmp(one) >= 0 {
+			return nil, invalidArgumentf("a margin of %s%% is not below 100%%", req.Percent)
+		}
+		return &calculatorpb.MoneyCalculateResponse{
+			Result: money(round(amount.Quo(amount, percent.Sub(one, percent)))),
+		}, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ADD_TAX:
+		if percent.Sign() < 0 {
+			return nil, invalidArgumentf("tax rate %s%% must not be negative", req.Percent)
+		}
+		tax := round(new(big.Rat).Mul(amount, percent))
+		return &calculatorpb.MoneyCalculateResponse{
+			Result: money(new(big.Rat).Add(amount, tax)),
+			Tax:    money(tax),
+		}, nil
+	case calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_REMOVE_TAX:
+		if percent.Sign() < 0 {
+			return nil, invalidArgumentf("tax rate %s%% must not be negative", req.Percent)
+		}
+		// the net amount is rounded and the tax is what is left, so that they
+		// always add up to the gross amount
+		net := round(new(big.Rat).Quo(amount, percent.Add(percent, one)))
+		return &calculatorpb.MoneyCalculateResponse{
+			Result: money(net),
+			Tax:    money(new(big.Rat).Sub(amount, net)),
+		}, nil
+	default:
+		return nil, invalidArgumentf("money operator is not supplied")
+	}
+}
+
+// parseMoney reads an amount that must be a whole number of minor units of its currency
+func parseMoney(m *calculatorpb.Money) (string, *big.Rat, error) {
+	currency, err := parseCurrency(m.Currency)
+	if err != nil {
+		return "", nil, err
+	}
+	amount, err := parseDecimal(m.Amount)
+	if err != nil {
+		return "", nil, err
+	}
+	places := minorUnit(currency)
+	if roundDecimal(amount, places, calculatorpb.ROUNDING_ROUNDING_DOWN).Cmp(amount) != 0 {
+		return "", nil, invalidArgumentf("%s %s has more than the %d decimals of %s", m.Amount, currency, places, currency)
+	}
+	return currency, amount, nil
+}
+
+// allocate splits amount in proportion to ratios by the largest remainder
+// method. Every part gets its share rounded down to a minor unit, and the minor
+// units left over go to the parts whose shares lost the most, ties to the
+// earlier part, so that 100 split three ways is 33.34, 33.33 and 33.33.
+func allocate(amount *big.Rat, ratios []string, places int) ([]*big.Rat, error) {
+	if len(ratios) == 0 {
+		return nil, invalidArgumentf("at least one ratio is required")
+	}
+	if len(ratios) > maxRatios {
+		return nil, invalidArgumentf("at most %d ratios are allowed", maxRatios)
+	}
+	weights := make([]*big.Rat, len(ratios))
+	sum := new(big.Rat)
+	for i, s := range ratios {
+		w, err := parseDecimal(s)
+		if err != nil {
+			return nil, err
+		}
+		if w.Sign() < 0 {
+			return nil, invalidArgumentf("ratio %s must not be negative", s)
+		}
+		weights[i] = w
+		sum.Add(sum, w)
+	}
+	if sum.Sign() == 0 {
+		return nil, invalidArgumentf("the ratios must not all be zero")
+	}
+
+	// work in minor units, on the magnitude of the amount
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+	units := new(big.Rat).Mul(amount, scale)
+	total := new(big.Int).Abs(units.Num())
+
+	type share struct {
+		index     int
+		units     *big.Int
+		remainder *big.Rat
+	}
+	shares := make([]share, len(weights))
+	left := new(big.Int).Set(total)
+	for i, w := range weights {
+		exact := new(big.Rat).Mul(new(big.Rat).SetInt(total), w)
+		exact.Quo(exact, sum)
+		q := new(big.Int).Quo(exact.Num(), exact.Denom())
+		shares[i] = share{index: i, units: q, remainder: exact.Sub(exact, new(big.Rat).SetInt(q))}
+		left.Sub(left, q)
+	}
+
+	order := append([]share{}, shares...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].remainder.Cmp(order[j].remainder) > 0
+	})
+	// fewer units are left over than there are parts
+	for i := 0; left.Sign() > 0; i++ {
+		order[i].units.Add(order[i].units, big.NewInt(1))
+		left.Sub(left, big.NewInt(1))
+	}
+
+	parts := make([]*big.Rat, len(shares))
+	for _, s := range shares {
+		if amount.Sign() < 0 {
+			s.units.Neg(s.units)
+		}
+		parts[s.index] = new(big.Rat).Quo(new(big.Rat).SetInt(s.units), scale)
+	}
+	return parts, nil
+}
@@ -0,0 +1,199 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Allocate(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name          string
+		amount        *calculatorpb.Money
+		ratios        []string
+		expectedParts []string
+	}{
+		{"ThreeWays", &calculatorpb.Money{Amount: "100", Currency: "USD"}, []string{"1", "1", "1"}, []string{"33.34", "33.33", "33.33"}},
+		{"Negative", &calculatorpb.Money{Amount: "-100.00", Currency: "USD"}, []string{"1", "1", "1"}, []string{"-33.34", "-33.33", "-33.33"}},
+		{"LargestRemainder", &calculatorpb.Money{Amount: "0.07", Currency: "EUR"}, []string{"2", "8"}, []string{"0.01", "0.06"}},
+		{"Percentages", &calculatorpb.Money{Amount: "1000", Currency: "EUR"}, []string{"33.3", "33.3", "33.4"}, []string{"333.00", "333.00", "334.00"}},
+		{"ZeroRatio", &calculatorpb.Money{Amount: "10", Currency: "GBP"}, []string{"1", "0", "2"}, []string{"3.33", "0.00", "6.67"}},
+		{"NoMinorUnit", &calculatorpb.Money{Amount: "1000", Currency: "JPY"}, []string{"1", "1", "1", "1", "1", "1", "1"}, []string{"143", "143", "143", "143", "143", "143", "142"}},
+		{"ThreeDecimals", &calculatorpb.Money{Amount: "1.000", Currency: "KWD"}, []string{"1", "2"}, []string{"0.333", "0.667"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.MoneyCalculator(context.Background(), &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE,
+				Amount:   tt.amount,
+				Ratios:   tt.ratios,
+			})
+			assert.Nil(t, err)
+			parts := make([]string, len(res.Parts))
+			for i, p := range res.Parts {
+				parts[i] = p.Amount
+				assert.Equal(t, tt.amount.Currency, p.Currency)
+			}
+			assert.Equal(t, tt.expectedParts, parts)
+		})
+	}
+}
+
+func Test_MoneyCalculator(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name            string
+		request         *calculatorpb.MoneyCalculateRequest
+		expectedResult  string
+		expectedTax     string
+		expectedPercent string
+	}{
+		{
+			name: "PercentOf",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_OF,
+				Amount:   &calculatorpb.Money{Amount: "80.25", Currency: "USD"}, Percent: "15",
+			},
+			expectedResult: "12.04",
+		},
+		{
+			name: "PercentOfHalfUp",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_OF,
+				Amount:   &calculatorpb.Money{Amount: "0.50", Currency: "USD"}, Percent: "5", Rounding: calculatorpb.ROUNDING_ROUNDING_HALF_UP,
+			},
+			expectedResult: "0.03",
+		},
+		{
+			name: "PercentIncrease",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE,
+				Amount:   &calculatorpb.Money{Amount: "80", Currency: "USD"}, Other: &calculatorpb.Money{Amount: "100", Currency: "USD"},
+			},
+			expectedPercent: "25.0000",
+		},
+		{
+			name: "PercentDecreaseFromNegative",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE,
+				Amount:   &calculatorpb.Money{Amount: "-30", Currency: "USD"}, Other: &calculatorpb.Money{Amount: "-40", Currency: "USD"},
+			},
+			expectedPercent: "-33.3333",
+		},
+		{
+			name: "Markup",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARKUP,
+				Amount:   &calculatorpb.Money{Amount: "60", Currency: "EUR"}, Percent: "25",
+			},
+			expectedResult: "75.00",
+		},
+		{
+			name: "Margin",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARGIN,
+				Amount:   &calculatorpb.Money{Amount: "60", Currency: "EUR"}, Percent: "25",
+			},
+			expectedResult: "80.00",
+		},
+		{
+			name: "MarginRoundedUp",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARGIN,
+				Amount:   &calculatorpb.Money{Amount: "10", Currency: "EUR"}, Percent: "30", Rounding: calculatorpb.ROUNDING_ROUNDING_CEILING,
+			},
+			expectedResult: "14.29",
+		},
+		{
+			name: "AddTax",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ADD_TAX,
+				Amount:   &calculatorpb.Money{Amount: "19.99", Currency: "EUR"}, Percent: "19",
+			},
+			expectedResult: "23.79", expectedTax: "3.80",
+		},
+		{
+			name: "RemoveTax",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_REMOVE_TAX,
+				Amount:   &calculatorpb.Money{Amount: "23.79", Currency: "EUR"}, Percent: "19",
+			},
+			expectedResult: "19.99", expectedTax: "3.80",
+		},
+		{
+			name: "RemoveReducedTax",
+			request: &calculatorpb.MoneyCalculateRequest{
+				Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_REMOVE_TAX,
+				Amount:   &calculatorpb.Money{Amount: "10", Currency: "GBP"}, Percent: "7.5",
+			},
+			expectedResult: "9.30", expectedTax: "0.70",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.MoneyCalculator(context.Background(), tt.request)
+			assert.Nil(t, err)
+			if tt.expectedResult != "" {
+				assert.Equal(t, tt.expectedResult, res.Result.Amount)
+			}
+			if tt.expectedTax != "" {
+				assert.Equal(t, tt.expectedTax, res.Tax.Amount)
+			}
+			assert.Equal(t, tt.expectedPercent, res.Percent)
+		})
+	}
+}
+
+func Test_MoneyCalculatorErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.MoneyCalculateRequest
+	}{
+		{"TooManyDecimals", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE,
+			Amount:   &calculatorpb.Money{Amount: "10.5", Currency: "JPY"}, Ratios: []string{"1", "1"},
+		}},
+		{"NegativeRatio", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE,
+			Amount:   &calculatorpb.Money{Amount: "10", Currency: "USD"}, Ratios: []string{"1", "-1"},
+		}},
+		{"ZeroRatios", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ALLOCATE,
+			Amount:   &calculatorpb.Money{Amount: "10", Currency: "USD"}, Ratios: []string{"0", "0"},
+		}},
+		{"ChangeFromZero", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE,
+			Amount:   &calculatorpb.Money{Amount: "0", Currency: "USD"}, Other: &calculatorpb.Money{Amount: "1", Currency: "USD"},
+		}},
+		{"ChangeAcrossCurrencies", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_PERCENT_CHANGE,
+			Amount:   &calculatorpb.Money{Amount: "1", Currency: "USD"}, Other: &calculatorpb.Money{Amount: "1", Currency: "EUR"},
+		}},
+		{"FullMargin", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_MARGIN,
+			Amount:   &calculatorpb.Money{Amount: "1", Currency: "USD"}, Percent: "100",
+		}},
+		{"MissingPercent", &calculatorpb.MoneyCalculateRequest{
+			Operator: calculatorpb.MONEY_OPERATOR_MONEY_OPERATOR_ADD_TAX,
+			Amount:   &calculatorpb.Money{Amount: "1", Currency: "USD"},
+		}},
+		{"MissingOperator", &calculatorpb.MoneyCalculateRequest{Amount: &calculatorpb.Money{Amount: "1", Currency: "USD"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.MoneyCalculator(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package calculatorservice
+
+import (
+	"context"
+	"math/big"
+	"math/bits"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxNumberTheoryBits = 8192
+	// probablePrimeRounds is the number of Miller-Rabin rounds above 64 bits
+	probablePrimeRounds = 20
+	// factorizationBudget bounds the Pollard rho iterations of one factorization
+	factorizationBudget = 2000000
+	trialDivisionLimit  = 1000
+)
+
+// millerRabinBases make Miller-Rabin deterministic for every n < 2^64
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// NumberTheory runs primality tests, factorization, GCD/LCM and modular arithmetic on arbitrary-size integers
+func (c *Calculator) NumberTheory(ctx context.Context, req *calculatorpb.NumberTheoryRequest) (*calculatorpb.NumberTheoryResponse, error) {
+	operands := make([]*big.Int, len(req.Operands))
+	for i, s := range req.Operands {
+		n, err := parseBigInt(s)
+		if err != nil {
+			return nil, err
+		}
+		operands[i] = n
+	}
+
+	switch req.Function {
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_IS_PRIME:
+		if err := expectOperands(operands, 1); err != nil {
+			return nil, err
+		}
+		n := operands[0]
+		return &calculatorpb.NumberTheoryResponse{
+			IsPrime:  isPrime(n),
+			Probable: n.BitLen() > 64,
+		}, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_FACTORIZE:
+		if err := expectOperands(operands, 1); err != nil {
+			return nil, err
+		}
+		if operands[0].Sign() <= 0 {
+			return nil, invalidArgumentf("only positive integers can be factorized")
+		}
+		factors, unfactored, err := factorize(ctx, operands[0])
+		if err != nil {
+			return nil, err
+		}
+		res := &calculatorpb.NumberTheoryResponse{
+			Factors: factors,
+		}
+		if unfactored.Cmp(big.NewInt(1)) != 0 {
+			res.Unfactored = unfactored.String()
+		}
+		return res, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_GCD, calculatorpb.NUMBER_THEORY_NUMBER_THEORY_LCM:
+		if len(operands) == 0 {
+			return nil, invalidArgumentf("at least one operand is required")
+		}
+		result := new(big.Int).Abs(operands[0])
+		for _, n := range operands[1:] {
+			if req.Function == calculatorpb.NUMBER_THEORY_NUMBER_THEORY_GCD {
+				result.GCD(nil, nil, result, new(big.Int).Abs(n))
+				continue
+			}
+			if result.Sign() == 0 || n.Sign() == 0 {
+				result.SetInt64(0)
+				continue
+			}
+			gcd := new(big.Int).GCD(nil, nil, result, new(big.Int).Abs(n))
+			result.Mul(result, new(big.Int).Quo(new(big.Int).Abs(n), gcd))
+		}
+		return &calculatorpb.NumberTheoryResponse{
+			Result: result.String(),
+		}, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_EXTENDED_GCD:
+		if err := expectOperands(operands, 2); err != nil {
+			return nil, err
+		}
+		x, y := new(big.Int), new(big.Int)
+		gcd := new(big.Int).GCD(x, y, operands[0], operands[1])
+		return &calculatorpb.NumberTheoryResponse{
+			Result: gcd.String(),
+			Bezout: []string{x.String(), y.String()},
+		}, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_INVERSE:
+		if err := expectOperands(operands, 1); err != nil {
+			return nil, err
+		}
+		m, err := parseModulus(req.Modulus)
+		if err != nil {
+			return nil, err
+		}
+		inverse := new(big.Int).ModInverse(new(big.Int).Mod(operands[0], m), m)
+		if inverse == nil {
+			return nil, invalidArgumentf("%s has no inverse modulo %s", operands[0], m)
+		}
+		return &calculatorpb.NumberTheoryResponse{
+			Result: inverse.String(),
+		}, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_POW:
+		if err := expectOperands(operands, 2); err != nil {
+			return nil, err
+		}
+		m, err := parseModulus(req.Modulus)
+		if err != nil {
+			return nil, err
+		}
+		base, exponent := new(big.Int).Mod(operands[0], m), operands[1]
+		if exponent.Sign() < 0 {
+			if base.ModInverse(base, m) == nil {
+				return nil, invalidArgumentf("%s has no inverse modulo %s", operands[0], m)
+			}
+			exponent = new(big.Int).Neg(exponent)
+		}
+		return &calculatorpb.NumberTheoryResponse{
+			Result: new(big.Int).Exp(base, exponent, m).String(),
+		}, nil
+	case calculatorpb.NUMBER_THEORY_NUMBER_THEORY_TOTIENT:
+		if err := expectOperands(operands, 1); err != nil {
+			return nil, err
+		}
+		if operands[0].Sign() <= 0 {
+			return nil, invalidArgumentf("the totient is only defined for positive integers")
+		}
+		factors, unfactored, err := factorize(ctx, operands[0])
+		if err != nil {
+			return nil, err
+		}
+		if unfactored.Cmp(big.NewInt(1)) != 0 {
+			return nil, invalidArgumentf("%s could not be factorized within the iteration budget", operands[0])
+		}
+		// φ(n) = n Π (1 - 1/p)
+		totient := new(big.Int).Set(operands[0])
+		for _, f := range factors {
+			p, _ := new(big.Int).SetString(f.Prime, 10)
+			totient.Quo(totient, p)
+			totient.Mul(totient, p.Sub(p, big.NewInt(1)))
+		}
+		return &calculatorpb.NumberTheoryResponse{
+			Result: totient.String(),
+		}, nil
+	default:
+		return nil, invalidArgumentf("number theory function is not supplied")
+	}
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, invalidArgumentf("%q is not a decimal integer", s)
+	}
+	if n.BitLen() > maxNumberTheoryBits {
+		return nil, invalidArgumentf("integers must not have more than %d bits", maxNumberTheoryBits)
+	}
+	return n, nil
+}
+
+func parseModulus(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, invalidArgumentf("modulus is not supplied")
+	}
+	m, err := parseBigInt(s)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sign() <= 0 {
+		return nil, invalidArgumentf("modulus %s must be positive", m)
+	}
+	return m, nil
+}
+
+func expectOperands(operands []*big.Int, n int) error {
+	if len(operands) != n {
+		return invalidArgumentf("expected %d operands, got %d", n, len(operands))
+	}
+	return nil
+}
+
+// isPrime is exact below 2^64 and a Baillie-PSW plus Miller-Rabin test above
+func isPrime(n *big.Int) bool {
+	if n.Sign() <= 0 {
+		return false
+	}
+	if n.IsUint64() {
+		return isPrime64(n.Uint64())
+	}
+	return n.ProbablyPrime(probablePrimeRounds)
+}
+
+// isPrime64 is a deterministic Miller-Rabin test
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	d, s := n-1, 0
+	for d%2 == 0 {
+		d /= 2
+		s++
+	}
+	for _, a := range millerRabinBases {
+		x := powMod64(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulMod64(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+func mulMod64(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+func powMod64(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulMod64(result, base, m)
+		}
+		base = mulMod64(base, base, m)
+	}
+	return result
+}
+
+// factorize splits n into prime factors by trial division and Pollard's rho, and
+// returns the composite part it could not split within the iteration budget
+func factorize(ctx context.Context, n *big.Int) ([]*calculatorpb.PrimeFactor, *big.Int, error) {
+	exponents := map[string]uint32{}
+	remaining := new(big.Int).Set(n)
+	mod := new(big.Int)
+	for p := int64(2); p <= trialDivisionLimit && remaining.Cmp(big.NewInt(1)) != 0; p++ {
+		bp := big.NewInt(p)
+		for {
+			q, r := new(big.Int).QuoRem(remaining, bp, mod)
+			if r.Sign() != 0 {
+				break
+			}
+			exponents[bp.String()]++
+			remaining = q
+		}
+	}
+
+	unfactored := big.NewInt(1)
+	budget := factorizationBudget
+	stack := []*big.Int{remaining}
+	for len(stack) > 0 {
+		m := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch {
+		case m.Cmp(big.NewInt(1)) == 0:
+			continue
+		case isPrime(m):
+			exponents[m.String()]++
+			continue
+		}
+		d, err := pollardRho(ctx, m, &budget)
+		if err != nil {
+			return nil, nil, err
+		}
+		if d == nil {
+			unfactored.Mul(unfactored, m)
+			continue
+		}
+		stack = append(stack, d, new(big.Int).Quo(m, d))
+	}
+
+	factors := make([]*calculatorpb.PrimeFactor, 0, len(exponents))
+	for p, e := range exponents {
+		factors = append(factors, &calculatorpb.PrimeFactor{Prime: p, Exponent: e})
+	}
+	sort.Slice(factors, func(i, j int) bool {
+		a, _ := new(big.Int).SetString(factors[i].Prime, 10)
+		b, _ := new(big.Int).SetString(factors[j].Prime, 10)
+		return a.Cmp(b) < 0
+	})
+	return factors, unfactored, nil
+}
+
+// pollardRho finds a non trivial divisor of the composite n with Brent's variant of
+// Pollard's rho, it returns nil once the budget is spent
+func pollardRho(ctx context.Context, n *big.Int, budget *int) (*big.Int, error) {
+	one := big.NewInt(1)
+	for c := int64(1); *budget > 0; c++ {
+		bc := big.NewInt(c)
+		f := func(x *big.Int) *big.Int {
+			x.Mul(x, x)
+			x.Add(x, bc)
+			return x.Mod(x, n)
+		}
+
+		y, x := big.NewInt(2), new(big.Int)
+		ys := new(big.Int)
+		q, g := big.NewInt(1), big.NewInt(1)
+		diff := new(big.Int)
+		const batch = 128
+		for r := 1; g.Cmp(one) == 0 && *budget > 0; r *= 2 {
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				f(y)
+			}
+			for k := 0; k < r && g.Cmp(one) == 0; k += batch {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				ys.Set(y)
+				for i := 0; i < batch && i < r-k; i++ {
+					f(y)
+					q.Mul(q, diff.Abs(diff.Sub(x, y)))
+					q.Mod(q, n)
+					*budget--
+				}
+				g.GCD(nil, nil, q, n)
+			}
+		}
+
+		if g.Cmp(n) == 0 {
+			// the batch overshot, step through it one value at a time
+			for {
+				f(ys)
+				g.GCD(nil, nil, diff.Abs(diff.Sub(x, ys)), n)
+				if g.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if g.Cmp(one) != 0 && g.Cmp(n) != 0 {
+			return g, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,173 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsPrime(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name             string
+		n                string
+		expectedPrime    bool
+		expectedProbable bool
+	}{
+		{"Two", "2", true, false},
+		{"One", "1", false, false},
+		{"Carmichael", "561", false, false},
+		{"StrongPseudoprime", "3215031751", false, false},
+		{"Mersenne61", "2305843009213693951", true, false},
+		{"Largest64Bit", "18446744073709551557", true, false},
+		{"Mersenne89", "618970019642690137449562111", true, true},
+		{"Composite128Bit", "1000000016000000063000000000000000001", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.NumberTheory(context.Background(), &calculatorpb.NumberTheoryRequest{
+				Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_IS_PRIME,
+				Operands: []string{tt.n},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedPrime, res.IsPrime)
+			assert.Equal(t, tt.expectedProbable, res.Probable)
+		})
+	}
+}
+
+func Test_Factorize(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name            string
+		n               string
+		expectedFactors []*calculatorpb.PrimeFactor
+	}{
+		{"One", "1", []*calculatorpb.PrimeFactor{}},
+		{"SmallPowers", "108", []*calculatorpb.PrimeFactor{{Prime: "2", Exponent: 2}, {Prime: "3", Exponent: 3}}},
+		{"ProjectEuler", "600851475143", []*calculatorpb.PrimeFactor{
+			{Prime: "71", Exponent: 1}, {Prime: "839", Exponent: 1}, {Prime: "1471", Exponent: 1}, {Prime: "6857", Exponent: 1},
+		}},
+		{"Fermat6", "18446744073709551617", []*calculatorpb.PrimeFactor{{Prime: "274177", Exponent: 1}, {Prime: "67280421310721", Exponent: 1}}},
+		{"Semiprime", "1000000016000000063", []*calculatorpb.PrimeFactor{{Prime: "1000000007", Exponent: 1}, {Prime: "1000000009", Exponent: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.NumberTheory(context.Background(), &calculatorpb.NumberTheoryRequest{
+				Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_FACTORIZE,
+				Operands: []string{tt.n},
+			})
+			assert.Nil(t, err)
+			assert.Empty(t, res.Unfactored)
+			assert.Equal(t, len(tt.expectedFactors), len(res.Factors))
+			for i, f := range tt.expectedFactors {
+				assert.Equal(t, f.Prime, res.Factors[i].Prime)
+				assert.Equal(t, f.Exponent, res.Factors[i].Exponent)
+			}
+		})
+	}
+}
+
+func Test_NumberTheory(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name           string
+		request        *calculatorpb.NumberTheoryRequest
+		expectedResult string
+	}{
+		{
+			name:           "GCD",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_GCD, Operands: []string{"48", "-180", "300"}},
+			expectedResult: "12",
+		},
+		{
+			name:           "LCM",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_LCM, Operands: []string{"4", "6", "10"}},
+			expectedResult: "60",
+		},
+		{
+			name:           "ModInverse",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_INVERSE, Operands: []string{"17"}, Modulus: "3120"},
+			expectedResult: "2753",
+		},
+		{
+			name:           "RSAEncrypt",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_POW, Operands: []string{"65", "17"}, Modulus: "3233"},
+			expectedResult: "2790",
+		},
+		{
+			name:           "RSADecrypt",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_POW, Operands: []string{"2790", "2753"}, Modulus: "3233"},
+			expectedResult: "65",
+		},
+		{
+			name:           "ModPowNegativeExponent",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_POW, Operands: []string{"3", "-1"}, Modulus: "11"},
+			expectedResult: "4",
+		},
+		{
+			name:           "Totient",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_TOTIENT, Operands: []string{"3233"}},
+			expectedResult: "3120",
+		},
+		{
+			name:           "TotientPrimePower",
+			request:        &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_TOTIENT, Operands: []string{"36"}},
+			expectedResult: "12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.NumberTheory(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, res.Result)
+		})
+	}
+}
+
+func Test_ExtendedGCD(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.NumberTheory(context.Background(), &calculatorpb.NumberTheoryRequest{
+		Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_EXTENDED_GCD,
+		Operands: []string{"240", "46"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", res.Result)
+	assert.Len(t, res.Bezout, 2)
+
+	x, _ := new(big.Int).SetString(res.Bezout[0], 10)
+	y, _ := new(big.Int).SetString(res.Bezout[1], 10)
+	sum := new(big.Int).Add(x.Mul(x, big.NewInt(240)), y.Mul(y, big.NewInt(46)))
+	assert.Equal(t, "2", sum.String())
+}
+
+func Test_NumberTheoryErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.NumberTheoryRequest
+	}{
+		{"NotAnInteger", &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_IS_PRIME, Operands: []string{"12.5"}}},
+		{"NoInverse", &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_INVERSE, Operands: []string{"6"}, Modulus: "9"}},
+		{"MissingModulus", &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_MOD_POW, Operands: []string{"2", "3"}}},
+		{"FactorizeZero", &calculatorpb.NumberTheoryRequest{Function: calculatorpb.NUMBER_THEORY_NUMBER_THEORY_FACTORIZE, Operands: []string{"0"}}},
+		{"MissingFunction", &calculatorpb.NumberTheoryRequest{Operands: []string{"7"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.NumberTheory(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package calculatorservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxODEEquations     = 100
+	defaultODESteps     = 100
+	defaultODETolerance = 1e-8
+	// the step of Dormand-Prince changes by at most these factors at a time
+	minStepFactor = 0.2
+	maxStepFactor = 5.0
+	stepSafety    = 0.9
+)
+
+// Dormand-Prince 5(4) tableau, the last row of dormandPrinceA is the order 5
+// solution, whose derivative is the first stage of the next step
+var (
+	dormandPrinceC = [7]float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1, 1}
+	dormandPrinceA = [7][6]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+		{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	}
+	// dormandPrinceE is the order 5 less the order 4 solution
+	dormandPrinceE = [7]float64{
+		71.0 / 57600, 0, -71.0 / 16695, 71.0 / 1920, -17253.0 / 339200, 22.0 / 525, -1.0 / 40,
+	}
+)
+
+// odeSystem computes the derivatives of a system of equations, counting the
+// evaluations of the derivatives against a budget
+type odeSystem struct {
+	variables   []string
+	derivatives []expr
+	time        string
+	values      map[string]float64
+	budget      uint64
+	count       uint64
+}
+
+// SolveODE streams the trajectory of an initial value problem
+func (c *Calculator) SolveODE(ctx context.Context, req *calculatorpb.SolveODERequest, send func(*calculatorpb.ODEPoint) error) error {
+	system, y, err := c.odeSystem(req)
+	if err != nil {
+		return err
+	}
+	start, end := req.Start, req.End
+	if math.IsNaN(start) || math.IsInf(start, 0) || math.IsNaN(end) || math.IsInf(end, 0) || start == end {
+		return invalidArgumentf("start %v and end %v must be different numbers", start, end)
+	}
+	step := req.Step
+	if step == 0 {
+		step = math.Abs(end-start) / defaultODESteps
+	}
+	if !(step > 0) || math.IsInf(step, 0) {
+		return invalidArgumentf("step %v must be positive", req.Step)
+	}
+	step = math.Copysign(step, end-start)
+
+	switch req.Solver {
+	case calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4:
+		steps := math.Ceil((end - start) / step)
+		if steps*4*float64(len(y)) > float64(system.budget) {
+			return fmt.Errorf("%w: %v steps of RK4 take more than %d evaluations", ErrBudgetExceeded, steps, system.budget)
+		}
+		if err := send(odePoint(start, y)); err != nil {
+			return err
+		}
+		return system.rk4(ctx, start, end, step, y, send)
+	case calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE:
+		tolerance := req.Tolerance
+		if tolerance == 0 {
+			tolerance = defaultODETolerance
+		}
+		if !(tolerance > 0) || math.IsInf(tolerance, 0) {
+			return invalidArgumentf("tolerance %v must be positive", req.Tolerance)
+		}
+		if err := send(odePoint(start, y)); err != nil {
+			return err
+		}
+		return system.dormandPrince(ctx, start, end, step, tolerance, y, send)
+	default:
+		return invalidArgumentf("ODE solver is not supplied")
+	}
+}
+
+// odeSystem parses the equations of a request into a system and its
+// initial values
+func (c *Calculator) odeSystem(req *calculatorpb.SolveODERequest) (*odeSystem, []float64, error) {
+	if len(req.Equations) == 0 || len(req.Equations) > maxODEEquations {
+		return nil, nil, invalidArgumentf("%d equations are not within [1, %d]", len(req.Equations), maxODEEquations)
+	}
+	budget, err := c.budget(req.MaxEvaluations)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := &odeSystem{time: req.TimeVariable, values: map[string]float64{}, budget: budget}
+	if s.time == "" {
+		s.time = "t"
+	}
+	known := map[string]bool{}
+	name := func(name string) error {
+		e, err := parseExpression(name)
+		if v, ok := e.(*variableNode); err != nil || !ok || v.name != name {
+			return invalidArgumentf("%q is not a variable name", name)
+		}
+		if _, ok := exprConstants[name]; ok {
+			return invalidArgumentf("%s is a constant and can not be a variable", name)
+		}
+		if known[name] {
+			return invalidArgumentf("variable %s is given twice", name)
+		}
+		known[name] = true
+		return nil
+	}
+	if err := name(s.time); err != nil {
+		return nil, nil, err
+	}
+
+	var y []float64
+	for _, eq := range req.Equations {
+		if err := name(eq.Variable); err != nil {
+			return nil, nil, err
+		}
+		if math.IsNaN(eq.Initial) || math.IsInf(eq.Initial, 0) {
+			return nil, nil, invalidArgumentf("the initial value of %s must be finite", eq.Variable)
+		}
+		s.variables = append(s.variables, eq.Variable)
+		y = append(y, eq.Initial)
+	}
+	for v, value := range req.Values {
+		if err := name(v); err != nil {
+			return nil, nil, err
+		}
+		s.values[v] = value
+	}
+	for i, eq := range req.Equations {
+		e, err := parseExpression(eq.Derivative)
+		if err != nil {
+			return nil, nil, fmt.Errorf("derivative of %s: %w", s.variables[i], err)
+		}
+		vars := map[string]bool{}
+		exprVariables(e, vars)
+		for v := range vars {
+			if !known[v] {
+				return nil, nil, invalidArgumentf("variable %s of the derivative of %s has no value", v, s.variables[i])
+			}
+		}
+		s.derivatives = append(s.derivatives, e)
+	}
+	return s, y, nil
+}
+
+// eval sets dy to the derivatives at t and y
+func (s *odeSystem) eval(t float64, y, dy []float64) error {
+	if s.count+uint64(len(y)) > s.budget {
+		return fmt.Errorf("%w: more than %d evaluations", ErrBudgetExceeded, s.budget)
+	}
+	s.count += uint64(len(y))
+	s.values[s.time] = t
+	for i, v := range s.variables {
+		s.values[v] = y[i]
+	}
+	for i, e := range s.derivatives {
+		dy[i], _ = evalExpr(e, s.values)
+		if math.IsNaN(dy[i]) || math.IsInf(dy[i], 0) {
+			return fmt.Errorf("%w: the derivative of %s is %v at %s = %v", ErrOverflow, s.variables[i], dy[i], s.time, t)
+		}
+	}
+	return nil
+}
+
+// rk4 takes fixed steps from start to end, the last one shortened to end
+func (s *odeSystem) rk4(ctx context.Context, start, end, step float64, y []float64, send func(*calculatorpb.ODEPoint) error) error {
+	n := len(y)
+	k := make([][]float64, 4)
+	for i := range k {
+		k[i] = make([]float64, n)
+	}
+	tmp := make([]float64, n)
+	t := start
+	for i := 1; t != end; i++ {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		// from start rather than accumulated, so that rounding doesn't add up
+		next := start + float64(i)*step
+		if (next-end)*step >= 0 {
+			next = end
+		}
+		h := next - t
+		if err := s.eval(t, y, k[0]); err != nil {
+			return err
+		}
+		for j := range tmp {
+			tmp[j] = y[j] + h/2*k[0][j]
+		}
+		if err := s.eval(t+h/2, tmp, k[1]); err != nil {
+			return err
+		}
+		for j := range tmp {
+			tmp[j] = y[j] + h/2*k[1][j]
+		}
+		if err := s.eval(t+h/2, tmp, k[2]); err != nil {
+			return err
+		}
+		for j := range tmp {
+			tmp[j] = y[j] + h*k[2][j]
+		}
+		if err := s.eval(next, tmp, k[3]); err != nil {
+			return err
+		}
+		for j := range y {
+			y[j] += h / 6 * (k[0][j] + 2*k[1][j] + 2*k[2][j] + k[3][j])
+		}
+		t = next
+		if err := send(odePoint(t, y)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dormandPrince takes adaptive steps from start to end, keeping the
+// estimated local error of every step within tolerance
+func (s *odeSystem) dormandPrince(ctx context.Context, start, end, step, tolerance float64, y []float64, send func(*calculatorpb.ODEPoint) error) error {
+	n := len(y)
+	k := make([][]float64, 7)
+	for i := range k {
+		k[i] = make([]float64, n)
+	}
+	next, tmp := make([]float64, n), make([]float64, n)
+	if err := s.eval(start, y, k[0]); err != nil {
+		return err
+	}
+	t, h := start, step
+	for i := 1; t != end; i++ {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		last := (t+h-end)*h >= 0
+		if last {
+			h = end - t
+		}
+		if t+h == t {
+			return fmt.Errorf("%w: the step fell below the precision of %s = %v, the solution may be singular there", ErrOverflow, s.time, t)
+		}
+
+		// the last stage is at the order 5 solution, a stage that overflows
+		// rejects the step
+		var err error
+		for stage := 1; stage < 7 && err == nil; stage++ {
+			dst := tmp
+			if stage == 6 {
+				dst = next
+			}
+			for j := range dst {
+				sum := 0.0
+				for l := 0; l < stage; l++ {
+					sum += dormandPrinceA[stage][l] * k[l][j]
+				}
+				dst[j] = y[j] + h*sum
+			}
+			err = s.eval(t+dormandPrinceC[stage]*h, dst, k[stage])
+		}
+		if errors.Is(err, ErrOverflow) {
+			h *= minStepFactor
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// root mean square of the error relative to the tolerance
+		errorNorm := 0.0
+		for j := range y {
+			e := 0.0
+			for l := range dormandPrinceE {
+				e += dormandPrinceE[l] * k[l][j]
+			}
+			scale := tolerance * math.Max(1, math.Max(math.Abs(y[j]), math.Abs(next[j])))
+			errorNorm += (h * e / scale) * (h * e / scale)
+		}
+		errorNorm = math.Sqrt(errorNorm / float64(n))
+
+		factor := maxStepFactor
+		if errorNorm > 0 {
+			factor = math.Max(minStepFactor, math.Min(maxStepFactor, stepSafety*math.Pow(errorNorm, -0.2)))
+		}
+		if errorNorm <= 1 {
+			if last {
+				t = end
+			} else {
+				t += h
+			}
+			copy(y, next)
+			copy(k[0], k[6])
+			if err := send(odePoint(t, y)); err != nil {
+				return err
+			}
+		} else {
+			factor = math.Min(1, factor)
+		}
+		h *= factor
+	}
+	return nil
+}
+
+func odePoint(t float64, y []float64) *calculatorpb.ODEPoint {
+	return &calculatorpb.ODEPoint{T: t, Values: append([]float64(nil), y...)}
+}
@@ -0,0 +1,239 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func solveODE(svc calculatorservice.Service, ctx context.Context, req *calculatorpb.SolveODERequest) ([]*calculatorpb.ODEPoint, error) {
+	var points []*calculatorpb.ODEPoint
+	err := svc.SolveODE(ctx, req, func(p *calculatorpb.ODEPoint) error {
+		points = append(points, p)
+		return nil
+	})
+	return points, err
+}
+
+func Test_SolveODE(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	oscillator := []*calculatorpb.ODEEquation{
+		{Variable: "x", Derivative: "v", Initial: 1},
+		{Variable: "v", Derivative: "-x", Initial: 0},
+	}
+	tests := []struct {
+		name     string
+		request  *calculatorpb.SolveODERequest
+		expected []float64
+		points   int
+	}{
+		{
+			name: "DecayRK4",
+			request: &calculatorpb.SolveODERequest{
+				Solver:    calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4,
+				Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-k*y", Initial: 1}},
+				Values:    map[string]float64{"k": 1.5},
+				End:       2,
+				Step:      0.01,
+			},
+			expected: []float64{math.Exp(-3)},
+			points:   201,
+		},
+		{
+			name: "DecayDormandPrince",
+			request: &calculatorpb.SolveODERequest{
+				Solver:    calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE,
+				Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-k*y", Initial: 1}},
+				Values:    map[string]float64{"k": 1.5},
+				End:       2,
+				Tolerance: 1e-10,
+			},
+			expected: []float64{math.Exp(-3)},
+		},
+		{
+			name: "OscillatorRK4",
+			request: &calculatorpb.SolveODERequest{
+				Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4, Equations: oscillator, End: 2 * math.Pi, Step: 0.001,
+			},
+			expected: []float64{1, 0},
+			points:   6285,
+		},
+		{
+			name: "OscillatorDormandPrince",
+			request: &calculatorpb.SolveODERequest{
+				Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE, Equations: oscillator, End: 2 * math.Pi, Tolerance: 1e-11,
+			},
+			expected: []float64{1, 0},
+		},
+		{
+			name: "Backwards",
+			request: &calculatorpb.SolveODERequest{
+				Solver:    calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE,
+				Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "y", Initial: math.E}},
+				Start:     1,
+				End:       0,
+			},
+			expected: []float64{1},
+		},
+		{
+			name: "TimeVariable",
+			request: &calculatorpb.SolveODERequest{
+				Solver:       calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4,
+				Equations:    []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "3s^2", Initial: 1}},
+				TimeVariable: "s",
+				End:          2,
+			},
+			expected: []float64{9},
+			points:   101,
+		},
+		{
+			name: "LastStepShortened",
+			request: &calculatorpb.SolveODERequest{
+				Solver:    calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4,
+				Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "1", Initial: 0}},
+				End:       1,
+				Step:      0.3,
+			},
+			expected: []float64{1},
+			points:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := solveODE(calculatorSvc, context.Background(), tt.request)
+			assert.Nil(t, err)
+			if tt.points > 0 {
+				assert.Len(t, points, tt.points)
+			}
+			first, last := points[0], points[len(points)-1]
+			assert.Equal(t, tt.request.Start, first.T)
+			for i, eq := range tt.request.Equations {
+				assert.Equal(t, eq.Initial, first.Values[i])
+			}
+			assert.Equal(t, tt.request.End, last.T)
+			for i, v := range tt.expected {
+				assert.InDelta(t, v, last.Values[i], 1e-8)
+			}
+			direction := math.Copysign(1, tt.request.End-tt.request.Start)
+			for i := 1; i < len(points); i++ {
+				assert.Greater(t, (points[i].T-points[i-1].T)*direction, 0.0)
+			}
+		})
+	}
+}
+
+func Test_DormandPrinceAdaptsStep(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	solve := func(tolerance float64) []*calculatorpb.ODEPoint {
+		points, err := solveODE(calculatorSvc, context.Background(), &calculatorpb.SolveODERequest{
+			Solver:    calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE,
+			Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-50*(y - cos(t))", Initial: 0}},
+			End:       1,
+			Tolerance: tolerance,
+		})
+		assert.Nil(t, err)
+		return points
+	}
+	loose, tight := solve(1e-4), solve(1e-10)
+	assert.Less(t, len(loose), len(tight))
+
+	// the steps are short while the solution moves onto cos(t) and grow after
+	first := tight[1].T - tight[0].T
+	final := tight[len(tight)-2].T - tight[len(tight)-3].T
+	assert.Less(t, first, final)
+	y := tight[len(tight)-1].Values[0]
+	expected := (2500*math.Cos(1) + 50*math.Sin(1) - 2500*math.Exp(-50)) / 2501
+	assert.InDelta(t, expected, y, 1e-8)
+}
+
+func Test_SolveODEStreaming(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithEvaluationBudget(100000))
+	decay := []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-y", Initial: 1}}
+
+	// an error of the stream stops the solver
+	sent := 0
+	streamErr := errors.New("stream closed")
+	err := calculatorSvc.SolveODE(context.Background(), &calculatorpb.SolveODERequest{
+		Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4, Equations: decay, End: 1,
+	}, func(*calculatorpb.ODEPoint) error {
+		sent++
+		if sent == 3 {
+			return streamErr
+		}
+		return nil
+	})
+	assert.Equal(t, streamErr, err)
+	assert.Equal(t, 3, sent)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = solveODE(calculatorSvc, ctx, &calculatorpb.SolveODERequest{
+		Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4, Equations: decay, End: 1, Step: 1e-4,
+	})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+
+	points, err := solveODE(calculatorSvc, context.Background(), &calculatorpb.SolveODERequest{
+		Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4, Equations: decay, End: 1, Step: 1e-5,
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrBudgetExceeded), "unexpected error %v", err)
+	assert.Empty(t, points)
+
+	_, err = solveODE(calculatorSvc, context.Background(), &calculatorpb.SolveODERequest{
+		Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE, Equations: decay, End: 1, MaxEvaluations: 20,
+	})
+	assert.True(t, errors.Is(err, calculatorservice.ErrBudgetExceeded), "unexpected error %v", err)
+
+	// y' = y^2 from 1 is 1/(1-t), which is infinite at 1. The fixed steps of
+	// RK4 may step over the pole before the solution overflows.
+	for _, solver := range []calculatorpb.ODE_SOLVER{calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4, calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE} {
+		points, err = solveODE(calculatorSvc, context.Background(), &calculatorpb.SolveODERequest{
+			Solver:    solver,
+			Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "y^2", Initial: 1}},
+			End:       2,
+		})
+		assert.True(t, errors.Is(err, calculatorservice.ErrOverflow), "unexpected error %v", err)
+		assert.NotEmpty(t, points)
+		assert.Less(t, points[len(points)-1].T, 1.1)
+	}
+}
+
+func Test_SolveODEErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	y := []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-y", Initial: 1}}
+	rk4 := calculatorpb.ODE_SOLVER_ODE_SOLVER_RK4
+	tests := []struct {
+		name    string
+		request *calculatorpb.SolveODERequest
+	}{
+		{"MissingSolver", &calculatorpb.SolveODERequest{Equations: y, End: 1}},
+		{"NoEquations", &calculatorpb.SolveODERequest{Solver: rk4, End: 1}},
+		{"EmptyRange", &calculatorpb.SolveODERequest{Solver: rk4, Equations: y, Start: 1, End: 1}},
+		{"InfiniteRange", &calculatorpb.SolveODERequest{Solver: rk4, Equations: y, End: math.Inf(1)}},
+		{"NegativeStep", &calculatorpb.SolveODERequest{Solver: rk4, Equations: y, End: 1, Step: -0.1}},
+		{"NegativeTolerance", &calculatorpb.SolveODERequest{Solver: calculatorpb.ODE_SOLVER_ODE_SOLVER_DORMAND_PRINCE, Equations: y, End: 1, Tolerance: -1}},
+		{"DuplicateVariable", &calculatorpb.SolveODERequest{Solver: rk4, Equations: append(y, y[0]), End: 1}},
+		{"TimeAsVariable", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "t", Derivative: "1"}}, End: 1}},
+		{"ConstantVariable", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "pi", Derivative: "1"}}, End: 1}},
+		{"InvalidName", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "2y", Derivative: "1"}}, End: 1}},
+		{"UnknownVariable", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "-k*y"}}, End: 1}},
+		{"ValueOfVariable", &calculatorpb.SolveODERequest{Solver: rk4, Equations: y, End: 1, Values: map[string]float64{"y": 2}}},
+		{"InvalidDerivative", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "y +"}}, End: 1}},
+		{"NaNInitial", &calculatorpb.SolveODERequest{Solver: rk4, Equations: []*calculatorpb.ODEEquation{{Variable: "y", Derivative: "y", Initial: math.NaN()}}, End: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := solveODE(calculatorSvc, context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+			assert.Empty(t, points)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package calculatorservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxPlotSeries      = 10
+	defaultPlotSamples = 200
+	maxPlotSamples     = 10000
+	defaultPlotWidth   = 640
+	defaultPlotHeight  = 400
+	minPlotSize        = 100
+	maxPlotSize        = 4096
+	// maxPlotDepth halvings refine an interval between two samples
+	maxPlotDepth = 12
+	// plotTolerance is the distance of the middle of an interval from the line
+	// between its ends, as a fraction of the y axis, below which the interval
+	// is drawn as that line
+	plotTolerance = 1e-3
+	// plotJump is the change over an interval refined maxPlotDepth times, as
+	// a fraction of the y axis, above which the series breaks there
+	plotJump = 0.05
+)
+
+// Plot samples expressions over a range of their variable, refining the
+// samples where a series bends, jumps or stops being defined
+func (c *Calculator) Plot(ctx context.Context, req *calculatorpb.PlotRequest) (*calculatorpb.PlotResponse, error) {
+	if len(req.Series) == 0 || len(req.Series) > maxPlotSeries {
+		return nil, invalidArgumentf("%d series are not within [1, %d]", len(req.Series), maxPlotSeries)
+	}
+	lower, upper := req.Lower, req.Upper
+	if !(lower < upper) || math.IsInf(upper-lower, 0) {
+		return nil, invalidArgumentf("lower %v must be below upper %v and both finite, as well as their difference", lower, upper)
+	}
+	samples := int(req.Samples)
+	if samples == 0 {
+		samples = defaultPlotSamples
+	}
+	if samples < 2 || samples > maxPlotSamples {
+		return nil, invalidArgumentf("samples %d are not within [2, %d]", samples, maxPlotSamples)
+	}
+	width, height := int(req.Width), int(req.Height)
+	if width == 0 {
+		width = defaultPlotWidth
+	}
+	if height == 0 {
+		height = defaultPlotHeight
+	}
+	if width < minPlotSize || width > maxPlotSize || height < minPlotSize || height > maxPlotSize {
+		return nil, invalidArgumentf("size %dx%d is not within [%d, %d]", width, height, minPlotSize, maxPlotSize)
+	}
+	yLower, yUpper := req.YLower, req.YUpper
+	fixed := yLower != 0 || yUpper != 0
+	if fixed && (!(yLower < yUpper) || math.IsInf(yUpper-yLower, 0)) {
+		return nil, invalidArgumentf("y_lower %v must be below y_upper %v and both finite, as well as their difference", yLower, yUpper)
+	}
+
+	// the series take turns with one function, so that they share its budget
+	var f *budgetedFunction
+	exprs := make([]expr, len(req.Series))
+	for i, s := range req.Series {
+		g, err := c.expressionFunction(ctx, s.Expression, req.Variable, "x", req.Values, req.MaxEvaluations)
+		if err != nil {
+			return nil, fmt.Errorf("series %d: %w", i+1, err)
+		}
+		if f == nil {
+			f = g
+		}
+		exprs[i] = g.e
+	}
+	// a value that is not finite is a gap in the series rather than an error
+	sample := func(i int, x float64) (float64, bool, error) {
+		f.e = exprs[i]
+		y, err := f.eval(x)
+		if errors.Is(err, ErrInvalidArgument) {
+			return 0, false, nil
+		}
+		return y, err == nil, err
+	}
+
+	xs := make([]float64, samples)
+	for j := range xs {
+		xs[j] = lower + float64(j)*(upper-lower)/float64(samples-1)
+	}
+	xs[samples-1] = upper
+	ys := make([][]float64, len(req.Series))
+	defined := make([][]bool, len(req.Series))
+	var all []float64
+	for i := range req.Series {
+		ys[i], defined[i] = make([]float64, samples), make([]bool, samples)
+		for j, x := range xs {
+			y, ok, err := sample(i, x)
+			if err != nil {
+				return nil, err
+			}
+			ys[i][j], defined[i][j] = y, ok
+			if ok {
+				all = append(all, y)
+			}
+		}
+	}
+	if !fixed {
+		yLower, yUpper = plotRange(all)
+	}
+
+	res := &calculatorpb.PlotResponse{YLower: yLower, YUpper: yUpper}
+	for i, s := range req.Series {
+		p := &plotBuilder{yLower: yLower, yUpper: yUpper}
+		p.sample = func(x float64) (float64, bool, error) { return sample(i, x) }
+		p.end(xs[0], ys[i][0], defined[i][0])
+		for j := 1; j < samples; j++ {
+			if err := p.refine(xs[j-1], ys[i][j-1], defined[i][j-1], xs[j], ys[i][j], defined[i][j], 0); err != nil {
+				return nil, err
+			}
+		}
+		p.gap()
+		label := s.Label
+		if label == "" {
+			label = s.Expression
+		}
+		res.Series = append(res.Series, &calculatorpb.PlotData{Label: label, Segments: p.segments})
+	}
+	res.Evaluations = f.count
+	if req.Svg {
+		res.Svg = renderPlot(req.Title, width, height, lower, upper, yLower, yUpper, res.Series)
+	}
+	return res, nil
+}
+
+// plotRange is the range of the y axis for values, which keeps to the bulk
+// of the values when a few, such as those next to a pole, are far out
+func plotRange(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return -1, 1
+	}
+	sort.Float64s(values)
+	lower, upper := values[0], values[len(values)-1]
+	// the values beyond the 5th and 95th percentiles stay in range within a
+	// margin as wide again as the range between them
+	q0, q1 := values[len(values)/20], values[len(values)-1-len(values)/20]
+	lower, upper = math.Max(lower, q0-(q1-q0)), math.Min(upper, q1+(q1-q0))
+	// a 20th of the range, of the 20ths of its bounds so that it does not
+	// overflow
+	pad := upper/20 - lower/20
+	if lower == upper {
+		pad = math.Max(1, math.Abs(lower)/10)
+	}
+	lower, upper = math.Max(lower-pad, -math.MaxFloat64), math.Min(upper+pad, math.MaxFloat64)
+	if math.IsInf(upper-lower, 0) {
+		// the axis is as wide as a double allows, around the middle of the
+		// values, and the values beyond are drawn at its edges
+		middle := lower/2 + upper/2
+		return middle - math.MaxFloat64/2, middle + math.MaxFloat64/2
+	}
+	return lower, upper
+}
+
+// plotBuilder collects the points of a series into segments
+type plotBuilder struct {
+	sample         func(x float64) (float64, bool, error)
+	yLower, yUpper float64
+	segments       []*calculatorpb.PlotSegment
+	current        []*calculatorpb.PlotPoint
+}
+
+// end adds a point where the series is defined and otherwise breaks it
+func (p *plotBuilder) end(x, y float64, ok bool) {
+	if !ok {
+		p.gap()
+		return
+	}
+	p.current = append(p.current, &calculatorpb.PlotPoint{X: x, Y: y})
+}
+
+// gap ends the current segment
+func (p *plotBuilder) gap() {
+	if len(p.current) > 0 {
+		p.segments = append(p.segments, &calculatorpb.PlotSegment{Points: p.current})
+		p.current = nil
+	}
+}
+
+// refine adds the points of (x0, x1], halving the interval until the series
+// is a straight line on it or a jump or the edge of where it is defined
+// narrows to maxPlotDepth halvings
+func (p *plotBuilder) refine(x0, y0 float64, ok0 bool, x1, y1 float64, ok1 bool, depth int) error {
+	span := p.yUpper - p.yLower
+	switch {
+	case ok0 && ok1:
+		// off the chart on one side, the line is not drawn anyway
+		outside := (y0 > p.yUpper && y1 > p.yUpper) || (y0 < p.yLower && y1 < p.yLower)
+		if outside || depth == maxPlotDepth {
+			if !outside && math.Abs(y1-y0) > plotJump*span {
+				p.gap()
+			}
+			p.end(x1, y1, true)
+			return nil
+		}
+	case !ok0 && !ok1:
+		// a gap, unless the series is defined in the middle of a sample
+		// interval
+		if depth > 0 {
+			p.gap()
+			return nil
+		}
+	default:
+		if depth == maxPlotDepth {
+			p.end(x1, y1, ok1)
+			return nil
+		}
+	}
+
+	xm := x0 + (x1-x0)/2
+	ym, okm, err := p.sample(xm)
+	if err != nil {
+		return err
+	}
+	switch {
+	case ok0 && ok1 && okm && math.Abs(ym-(y0+y1)/2) <= plotTolerance*span:
+		p.end(xm, ym, true)
+		p.end(x1, y1, true)
+		return nil
+	case !ok0 && !ok1 && !okm:
+		p.gap()
+		return nil
+	}
+	if err := p.refine(x0, y0, ok0, xm, ym, okm, depth+1); err != nil {
+		return err
+	}
+	return p.refine(xm, ym, okm, x1, y1, ok1, depth+1)
+}
@@ -0,0 +1,235 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Plot(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		expression string
+		lower      float64
+		upper      float64
+		segments   int
+		expected   func(x float64) float64
+	}{
+		{"Sine", "sin(x)", 0, 2 * math.Pi, 1, math.Sin},
+		{"Parabola", "x^2 - 1", -2, 2, 1, func(x float64) float64 { return x*x - 1 }},
+		{"Pole", "1/x", -1, 1, 2, func(x float64) float64 { return 1 / x }},
+		{"Jump", "abs(x - 0.3)/(x - 0.3)", -1, 1, 2, func(x float64) float64 { return math.Copysign(1, x-0.3) }},
+		{"Domain", "sqrt(x)", -1, 1, 1, math.Sqrt},
+		{"Tangent", "tan(x)", -5, 5, 5, math.Tan},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Plot(context.Background(), &calculatorpb.PlotRequest{
+				Series: []*calculatorpb.PlotSeries{{Expression: tt.expression}},
+				Lower:  tt.lower,
+				Upper:  tt.upper,
+			})
+			assert.Nil(t, err)
+			assert.Len(t, res.Series, 1)
+			assert.Equal(t, tt.expression, res.Series[0].Label)
+			assert.Len(t, res.Series[0].Segments, tt.segments)
+			assert.Less(t, res.YLower, res.YUpper)
+			previous := math.Inf(-1)
+			for _, segment := range res.Series[0].Segments {
+				for _, p := range segment.Points {
+					assert.Greater(t, p.X, previous)
+					assert.InDelta(t, tt.expected(p.X), p.Y, 1e-9*math.Max(1, math.Abs(p.Y)))
+					previous = p.X
+				}
+			}
+		})
+	}
+}
+
+func Test_PlotRefinement(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	plot := func(expression string) *calculatorpb.PlotResponse {
+		res, err := calculatorSvc.Plot(context.Background(), &calculatorpb.PlotRequest{
+			Series: []*calculatorpb.PlotSeries{{Expression: expression}}, Lower: -1, Upper: 1, Samples: 20,
+		})
+		assert.Nil(t, err)
+		return res
+	}
+
+	// a line needs no more than the samples and the middles between them
+	res := plot("2x + 1")
+	assert.Equal(t, uint64(39), res.Evaluations)
+	assert.Len(t, res.Series[0].Segments[0].Points, 39)
+	assert.InDelta(t, -1.2, res.YLower, 1e-12)
+	assert.InDelta(t, 3.2, res.YUpper, 1e-12)
+
+	// the edge of the domain is found to within a few halvings of a sample
+	// interval, and a jump is narrowed as far
+	res = plot("sqrt(x)")
+	assert.InDelta(t, 0, res.Series[0].Segments[0].Points[0].X, 2.0/19/1024)
+	res = plot("abs(x)/x")
+	segments := res.Series[0].Segments
+	assert.Len(t, segments, 2)
+	left, right := segments[0].Points[len(segments[0].Points)-1].X, segments[1].Points[0].X
+	assert.Less(t, right-left, 2.0/19/1024)
+
+	// curves are refined where they bend
+	res = plot("sin(20x)")
+	assert.Greater(t, res.Evaluations, uint64(100))
+}
+
+func Test_PlotSeriesAndRange(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.Plot(context.Background(), &calculatorpb.PlotRequest{
+		Series: []*calculatorpb.PlotSeries{
+			{Expression: "a*t", Label: "linear"},
+			{Expression: "t^2"},
+		},
+		Variable: "t",
+		Values:   map[string]float64{"a": 3},
+		Lower:    0,
+		Upper:    2,
+		YLower:   -1,
+		YUpper:   10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, -1.0, res.YLower)
+	assert.Equal(t, 10.0, res.YUpper)
+	assert.Equal(t, "linear", res.Series[0].Label)
+	assert.Equal(t, "t^2", res.Series[1].Label)
+	for i, s := range res.Series {
+		points := s.Segments[0].Points
+		assert.Equal(t, 0.0, points[0].X)
+		assert.Equal(t, 2.0, points[len(points)-1].X)
+		assert.InDelta(t, []float64{6, 4}[i], points[len(points)-1].Y, 1e-12)
+	}
+	assert.Empty(t, res.Svg)
+
+	// the range keeps to the bulk of the samples next to a pole
+	res, err = calculatorSvc.Plot(context.Background(), &calculatorpb.PlotRequest{
+		Series: []*calculatorpb.PlotSeries{{Expression: "1/x"}}, Lower: -1, Upper: 1,
+	})
+	assert.Nil(t, err)
+	assert.Less(t, res.YUpper, 100.0)
+	assert.Greater(t, res.YLower, -100.0)
+}
+
+func Test_PlotSVG(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	request := &calculatorpb.PlotRequest{
+		Series: []*calculatorpb.PlotSeries{
+			{Expression: "sin(x)"},
+			{Expression: "1/x", Label: "1/x < 0 & > 0"},
+		},
+		Lower:  -math.Pi,
+		Upper:  math.Pi,
+		Svg:    true,
+		Width:  800,
+		Height: 500,
+		Title:  "Sine & <reciprocal>",
+	}
+	res, err := calculatorSvc.Plot(context.Background(), request)
+	assert.Nil(t, err)
+	svg := res.Svg
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="800" height="500"`))
+	assert.Contains(t, svg, "Sine &amp; &lt;reciprocal&gt;")
+	assert.Contains(t, svg, "1/x &lt; 0 &amp; &gt; 0")
+	assert.Equal(t, 2, strings.Count(svg, "<path "))
+	// the reciprocal is drawn in two pieces
+	assert.Equal(t, 1+2, strings.Count(svg, "M"))
+	for _, label := range []string{">-3<", ">0<", ">3<"} {
+		assert.Contains(t, svg, label)
+	}
+
+	// the chart is well formed XML
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if !assert.Nil(t, err) {
+			break
+		}
+	}
+
+	again, err := calculatorSvc.Plot(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, svg, again.Svg)
+}
+
+func Test_PlotSVGHugeValues(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name       string
+		expression string
+		yLower     float64
+		yUpper     float64
+	}{
+		{"Overflowing", "x*1e307", 0, 0},
+		{"Largest", "1.7e308", 0, 0},
+		{"LargestNegative", "-1.7e308 + x", 0, 0},
+		{"FixedRange", "x*1e307", -1e308, 1e308 / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Plot(context.Background(), &calculatorpb.PlotRequest{
+				Series: []*calculatorpb.PlotSeries{{Expression: tt.expression}},
+				Lower:  -100, Upper: 100, YLower: tt.yLower, YUpper: tt.yUpper, Svg: true,
+			})
+			assert.Nil(t, err)
+			assert.Less(t, res.YLower, res.YUpper)
+			assert.False(t, math.IsInf(res.YUpper-res.YLower, 0))
+			assert.NotContains(t, res.Svg, "NaN")
+			assert.NotContains(t, res.Svg, "Inf")
+		})
+	}
+}
+
+func Test_PlotErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	x := []*calculatorpb.PlotSeries{{Expression: "x"}}
+	tests := []struct {
+		name     string
+		request  *calculatorpb.PlotRequest
+		expected error
+	}{
+		{"NoSeries", &calculatorpb.PlotRequest{Upper: 1}, calculatorservice.ErrInvalidArgument},
+		{"EmptyRange", &calculatorpb.PlotRequest{Series: x, Lower: 1, Upper: 1}, calculatorservice.ErrInvalidArgument},
+		{"InfiniteRange", &calculatorpb.PlotRequest{Series: x, Upper: math.Inf(1)}, calculatorservice.ErrInvalidArgument},
+		{"OneSample", &calculatorpb.PlotRequest{Series: x, Upper: 1, Samples: 1}, calculatorservice.ErrInvalidArgument},
+		{"TooManySamples", &calculatorpb.PlotRequest{Series: x, Upper: 1, Samples: 10001}, calculatorservice.ErrInvalidArgument},
+		{"TooNarrow", &calculatorpb.PlotRequest{Series: x, Upper: 1, Width: 50}, calculatorservice.ErrInvalidArgument},
+		{"ReversedYRange", &calculatorpb.PlotRequest{Series: x, Upper: 1, YLower: 1, YUpper: -1}, calculatorservice.ErrInvalidArgument},
+		{"OverflowingRange", &calculatorpb.PlotRequest{Series: x, Lower: -1e308, Upper: 1e308}, calculatorservice.ErrInvalidArgument},
+		{"OverflowingYRange", &calculatorpb.PlotRequest{Series: x, Upper: 1, YLower: -1e308, YUpper: 1e308}, calculatorservice.ErrInvalidArgument},
+		{"MissingValue", &calculatorpb.PlotRequest{Series: []*calculatorpb.PlotSeries{{Expression: "a*x"}}, Upper: 1}, calculatorservice.ErrInvalidArgument},
+		{"InvalidExpression", &calculatorpb.PlotRequest{Series: []*calculatorpb.PlotSeries{{Expression: "x +"}}, Upper: 1}, calculatorservice.ErrInvalidArgument},
+		{"Budget", &calculatorpb.PlotRequest{Series: x, Upper: 1, MaxEvaluations: 100}, calculatorservice.ErrBudgetExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Plot(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, tt.expected), "unexpected error %v", err)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := calculatorSvc.Plot(ctx, &calculatorpb.PlotRequest{Series: x, Upper: 1})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
@@ -0,0 +1,279 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+// integerType is a fixed-width two's complement integer type
+type integerType struct {
+	name   string
+	width  uint
+	signed bool
+}
+
+var integerTypes = map[calculatorpb.INTEGER_TYPE]integerType{
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8:   {"int8", 8, true},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT16:  {"int16", 16, true},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT32:  {"int32", 32, true},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT64:  {"int64", 64, true},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8:  {"uint8", 8, false},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT16: {"uint16", 16, false},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT32: {"uint32", 32, false},
+	calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT64: {"uint64", 64, false},
+}
+
+// IntegerCalculator computes on fixed-width integers with wraparound, saturating or checked overflow
+func (c *Calculator) IntegerCalculator(ctx context.Context, req *calculatorpb.IntegerCalculateRequest) (*calculatorpb.IntegerCalculateResponse, error) {
+	t, ok := integerTypes[req.Type]
+	if !ok {
+		return nil, invalidArgumentf("integer type is not supplied")
+	}
+	outputRadix := int(req.OutputRadix)
+	if outputRadix == 0 {
+		outputRadix = 10
+	}
+	if outputRadix < 2 || outputRadix > 36 {
+		return nil, invalidArgumentf("output radix %d must be within [2, 36]", outputRadix)
+	}
+
+	a, err := t.parse(req.Operand_1, req.InputRadix)
+	if err != nil {
+		return nil, err
+	}
+	var b *big.Int
+	switch req.Operator {
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NEGATE, calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NOT:
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_LEFT, calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_RIGHT,
+		calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_LEFT, calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_RIGHT:
+		// bit counts are always read as decimals, whatever the input radix
+		b, err = parseRadix(req.Operand_2, 0)
+		if err != nil {
+			return nil, err
+		}
+		if b.Sign() < 0 || b.Cmp(big.NewInt(int64(t.width))) > 0 {
+			return nil, invalidArgumentf("bit count %s must be within [0, %d]", b, t.width)
+		}
+	default:
+		b, err = t.parse(req.Operand_2, req.InputRadix)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// arithmetic is exact and then brought back into range, bitwise operators
+	// work on the bit patterns and can't overflow
+	result := new(big.Int)
+	exact := true
+	switch req.Operator {
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD:
+		result.Add(a, b)
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SUBTRACT:
+		result.Sub(a, b)
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_MULTIPLY:
+		result.Mul(a, b)
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_DIVIDE, calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_REMAINDER:
+		if b.Sign() == 0 {
+			return nil, invalidArgumentf("you can not divide %s by 0", a)
+		}
+		if req.Operator == calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_DIVIDE {
+			result.Quo(a, b)
+		} else {
+			result.Rem(a, b)
+		}
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NEGATE:
+		result.Neg(a)
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_LEFT:
+		result.Lsh(a, uint(b.Uint64()))
+	case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_RIGHT:
+		result.Rsh(a, uint(b.Uint64()))
+	default:
+		exact = false
+	}
+
+	var pattern uint64
+	overflowed := false
+	if exact {
+		if pattern, overflowed, err = t.fit(result, req.Overflow); err != nil {
+			return nil, err
+		}
+	} else {
+		pa := t.pattern(a)
+		switch req.Operator {
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_AND:
+			pattern = pa & t.pattern(b)
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_OR:
+			pattern = pa | t.pattern(b)
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_XOR:
+			pattern = pa ^ t.pattern(b)
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NOT:
+			pattern = ^pa & t.mask()
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_LEFT:
+			pattern = t.rotateLeft(pa, int(b.Int64()))
+		case calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_RIGHT:
+			pattern = t.rotateLeft(pa, -int(b.Int64()))
+		default:
+			return nil, invalidArgumentf("integer operator is not supplied")
+		}
+	}
+
+	value := t.value(pattern)
+	res := &calculatorpb.IntegerCalculateResponse{
+		Result:      value.String(),
+		Decimal:     value.String(),
+		Binary:      fmt.Sprintf("%0*b", t.width, pattern),
+		Octal:       strconv.FormatUint(pattern, 8),
+		Hexadecimal: fmt.Sprintf("%0*x", t.width/4, pattern),
+		Overflowed:  overflowed,
+	}
+	if outputRadix != 10 {
+		res.Result = strconv.FormatUint(pattern, outputRadix)
+	}
+	return res, nil
+}
+
+// FloatBits breaks a float64 down into its IEEE-754 sign, exponent and mantissa
+func (c *Calculator) FloatBits(ctx context.Context, req *calculatorpb.FloatBitsRequest) (*calculatorpb.FloatBitsResponse, error) {
+	raw := math.Float64bits(req.Value)
+	sign := uint32(raw >> 63)
+	biased := uint32(raw>>52) & 0x7ff
+	mantissa := raw & (1<<52 - 1)
+
+	res := &calculatorpb.FloatBitsResponse{
+		Sign:           sign,
+		BiasedExponent: biased,
+		Exponent:       int32(biased) - 1023,
+		Mantissa:       mantissa,
+		Binary:         fmt.Sprintf("%b %011b %052b", sign, biased, mantissa),
+		Hexadecimal:    fmt.Sprintf("%016x", raw),
+	}
+	switch {
+	case biased == 0x7ff && mantissa == 0:
+		res.Class = calculatorpb.FLOAT_CLASS_FLOAT_CLASS_INFINITE
+	case biased == 0x7ff:
+		res.Class = calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NAN
+	case biased == 0 && mantissa == 0:
+		res.Class = calculatorpb.FLOAT_CLASS_FLOAT_CLASS_ZERO
+		res.Exponent = 0
+	case biased == 0:
+		// subnormals share the exponent of the smallest normal number
+		res.Class = calculatorpb.FLOAT_CLASS_FLOAT_CLASS_SUBNORMAL
+		res.Exponent = -1022
+	default:
+		res.Class = calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NORMAL
+	}
+	return res, nil
+}
+
+func (t integerType) min() *big.Int {
+	if !t.signed {
+		return new(big.Int)
+	}
+	return new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), t.width-1))
+}
+
+func (t integerType) max() *big.Int {
+	width := t.width
+	if t.signed {
+		width--
+	}
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), width), big.NewInt(1))
+}
+
+func (t integerType) mask() uint64 {
+	return math.MaxUint64 >> (64 - t.width)
+}
+
+// parse reads an operand, signed types also accept the unsigned bit pattern
+func (t integerType) parse(s string, radix uint32) (*big.Int, error) {
+	v, err := parseRadix(s, radix)
+	if err != nil {
+		return nil, err
+	}
+	patternMax := new(big.Int).SetUint64(t.mask())
+	if v.Cmp(t.min()) < 0 || v.Cmp(patternMax) > 0 {
+		return nil, invalidArgumentf("%s does not fit in %s", s, t.name)
+	}
+	if v.Cmp(t.max()) > 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), t.width))
+	}
+	return v, nil
+}
+
+// fit brings an exact result into the range of the type
+func (t integerType) fit(v *big.Int, mode calculatorpb.OVERFLOW) (pattern uint64, overflowed bool, err error) {
+	min, max := t.min(), t.max()
+	switch {
+	case v.Cmp(min) >= 0 && v.Cmp(max) <= 0:
+		return t.pattern(v), false, nil
+	case mode == calculatorpb.OVERFLOW_OVERFLOW_CHECKED:
+		return 0, true, fmt.Errorf("%w: %s does not fit in %s", ErrOverflow, v, t.name)
+	case mode == calculatorpb.OVERFLOW_OVERFLOW_SATURATE:
+		if v.Sign() < 0 {
+			return t.pattern(min), true, nil
+		}
+		return t.pattern(max), true, nil
+	}
+	return t.pattern(v), true, nil
+}
+
+// pattern is the two's complement bit pattern of v, truncated to the width of the type
+func (t integerType) pattern(v *big.Int) uint64 {
+	modulus := new(big.Int).Lsh(big.NewInt(1), t.width)
+	return new(big.Int).Mod(v, modulus).Uint64()
+}
+
+// value reads a bit pattern as a number of the type
+func (t integerType) value(pattern uint64) *big.Int {
+	v := new(big.Int).SetUint64(pattern)
+	if t.signed && pattern>>(t.width-1)&1 == 1 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), t.width))
+	}
+	return v
+}
+
+func (t integerType) rotateLeft(pattern uint64, n int) uint64 {
+	if t.width == 64 {
+		return bits.RotateLeft64(pattern, n)
+	}
+	w := int(t.width)
+	n = ((n % w) + w) % w
+	return (pattern<<n | pattern>>(w-n)) & t.mask()
+}
+
+// parseRadix parses an integer in radix 2 to 36, or a decimal with an optional
+// 0b, 0o or 0x prefix when radix is zero
+func parseRadix(s string, radix uint32) (*big.Int, error) {
+	digits := strings.ReplaceAll(strings.TrimSpace(s), "_", "")
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(strings.TrimPrefix(digits, "-"), "+")
+
+	base := int(radix)
+	if radix == 0 {
+		base = 10
+		if len(digits) > 2 && digits[0] == '0' {
+			if prefixed, ok := map[byte]int{'b': 2, 'B': 2, 'o': 8, 'O': 8, 'x': 16, 'X': 16}[digits[1]]; ok {
+				base, digits = prefixed, digits[2:]
+			}
+		}
+	}
+	if base < 2 || base > 36 {
+		return nil, invalidArgumentf("input radix %d must be within [2, 36]", radix)
+	}
+
+	v, ok := new(big.Int).SetString(digits, base)
+	if !ok || digits == "" || strings.ContainsAny(digits, "+-") {
+		return nil, invalidArgumentf("%q is not an integer in radix %d", s, base)
+	}
+	if negative {
+		v.Neg(v)
+	}
+	return v, nil
+}
@@ -0,0 +1,246 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IntegerCalculator(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name               string
+		request            *calculatorpb.IntegerCalculateRequest
+		expectedResult     string
+		expectedBinary     string
+		expectedOverflowed bool
+	}{
+		{
+			name: "WrapInt8",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "127", Operand_2: "1",
+			},
+			expectedResult: "-128", expectedBinary: "10000000", expectedOverflowed: true,
+		},
+		{
+			name: "SaturateUint8",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_MULTIPLY, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8,
+				Overflow: calculatorpb.OVERFLOW_OVERFLOW_SATURATE, Operand_1: "20", Operand_2: "20",
+			},
+			expectedResult: "255", expectedBinary: "11111111", expectedOverflowed: true,
+		},
+		{
+			name: "SaturateInt16Negative",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SUBTRACT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT16,
+				Overflow: calculatorpb.OVERFLOW_OVERFLOW_SATURATE, Operand_1: "-32000", Operand_2: "1000",
+			},
+			expectedResult: "-32768", expectedBinary: "1000000000000000", expectedOverflowed: true,
+		},
+		{
+			name: "TruncatedDivision",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_DIVIDE, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT32,
+				Operand_1: "-7", Operand_2: "2",
+			},
+			expectedResult: "-3", expectedBinary: "11111111111111111111111111111101",
+		},
+		{
+			name: "Remainder",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_REMAINDER, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "-7", Operand_2: "2",
+			},
+			expectedResult: "-1", expectedBinary: "11111111",
+		},
+		{
+			name: "NegateMinInt8",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NEGATE, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "-128",
+			},
+			expectedResult: "-128", expectedBinary: "10000000", expectedOverflowed: true,
+		},
+		{
+			name: "HexInputAnd",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_AND, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT16,
+				Operand_1: "0xff0f", Operand_2: "0x0ff0", OutputRadix: 16,
+			},
+			expectedResult: "f00", expectedBinary: "0000111100000000",
+		},
+		{
+			name: "NotSignedBitPattern",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_NOT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "0b11110000",
+			},
+			expectedResult: "15", expectedBinary: "00001111",
+		},
+		{
+			name: "ArithmeticShiftRight",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_RIGHT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "-16", Operand_2: "2",
+			},
+			expectedResult: "-4", expectedBinary: "11111100",
+		},
+		{
+			name: "LogicalShiftRight",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_RIGHT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8,
+				Operand_1: "240", Operand_2: "2",
+			},
+			expectedResult: "60", expectedBinary: "00111100",
+		},
+		{
+			name: "RotateLeft",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_LEFT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8,
+				Operand_1: "10010110", Operand_2: "3", InputRadix: 2, OutputRadix: 2,
+			},
+			expectedResult: "10110100", expectedBinary: "10110100",
+		},
+		{
+			name: "RotateRightUint64",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ROTATE_RIGHT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT64,
+				Operand_1: "1", Operand_2: "1", OutputRadix: 16,
+			},
+			expectedResult: "8000000000000000", expectedBinary: "1" + strings.Repeat("0", 63),
+		},
+		{
+			name: "ShiftLeftWraps",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_LEFT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT8,
+				Operand_1: "3", Operand_2: "6",
+			},
+			expectedResult: "-64", expectedBinary: "11000000", expectedOverflowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.IntegerCalculator(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, res.Result)
+			assert.Equal(t, tt.expectedBinary, res.Binary)
+			assert.Equal(t, tt.expectedOverflowed, res.Overflowed)
+		})
+	}
+}
+
+func Test_IntegerCalculatorErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name          string
+		request       *calculatorpb.IntegerCalculateRequest
+		expectedError error
+	}{
+		{
+			name: "CheckedOverflow",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT32,
+				Overflow: calculatorpb.OVERFLOW_OVERFLOW_CHECKED, Operand_1: "4294967295", Operand_2: "1",
+			},
+			expectedError: calculatorservice.ErrOverflow,
+		},
+		{
+			name: "DivideByZero",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_DIVIDE, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT32,
+				Operand_1: "1", Operand_2: "0",
+			},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+		{
+			name: "OperandOutOfRange",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8,
+				Operand_1: "256", Operand_2: "1",
+			},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+		{
+			name: "NegativeUnsigned",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_UINT8,
+				Operand_1: "-1", Operand_2: "1",
+			},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+		{
+			name: "ShiftTooFar",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_SHIFT_LEFT, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT16,
+				Operand_1: "1", Operand_2: "17",
+			},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+		{
+			name: "BadDigits",
+			request: &calculatorpb.IntegerCalculateRequest{
+				Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Type: calculatorpb.INTEGER_TYPE_INTEGER_TYPE_INT32,
+				Operand_1: "102", Operand_2: "1", InputRadix: 2,
+			},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+		{
+			name:          "MissingType",
+			request:       &calculatorpb.IntegerCalculateRequest{Operator: calculatorpb.INTEGER_OPERATOR_INTEGER_OPERATOR_ADD, Operand_1: "1", Operand_2: "1"},
+			expectedError: calculatorservice.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.IntegerCalculator(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, tt.expectedError), "unexpected error %v", err)
+		})
+	}
+}
+
+func Test_FloatBits(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name                string
+		value               float64
+		expectedSign        uint32
+		expectedExponent    int32
+		expectedMantissa    uint64
+		expectedClass       calculatorpb.FLOAT_CLASS
+		expectedHexadecimal string
+	}{
+		{"One", 1, 0, 0, 0, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NORMAL, "3ff0000000000000"},
+		{"MinusTwo", -2, 1, 1, 0, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NORMAL, "c000000000000000"},
+		{"OneTenth", 0.1, 0, -4, 0x999999999999a, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NORMAL, "3fb999999999999a"},
+		{"Zero", 0, 0, 0, 0, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_ZERO, "0000000000000000"},
+		{"SmallestSubnormal", math.SmallestNonzeroFloat64, 0, -1022, 1, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_SUBNORMAL, "0000000000000001"},
+		{"Infinity", math.Inf(-1), 1, 1024, 0, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_INFINITE, "fff0000000000000"},
+		{"NaN", math.NaN(), 0, 1024, 1, calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NAN, "7ff8000000000001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.FloatBits(context.Background(), &calculatorpb.FloatBitsRequest{Value: tt.value})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedSign, res.Sign)
+			assert.Equal(t, tt.expectedExponent, res.Exponent)
+			assert.Equal(t, tt.expectedClass, res.Class)
+			assert.Equal(t, tt.expectedHexadecimal, res.Hexadecimal)
+			if tt.expectedClass != calculatorpb.FLOAT_CLASS_FLOAT_CLASS_NAN {
+				assert.Equal(t, tt.expectedMantissa, res.Mantissa)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const maxRandomCount = 100000
+
+// Random draws count samples from a distribution, the same seed always yields the same sequence
+func (c *Calculator) Random(ctx context.Context, req *calculatorpb.RandomRequest) (*calculatorpb.RandomResponse, error) {
+	if req.Count == 0 || req.Count > maxRandomCount {
+		return nil, invalidArgumentf("count %d must be within [1, %d]", req.Count, maxRandomCount)
+	}
+	dist, err := newDistribution(req.Distribution, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := seedOrRandom(req.Seed)
+	rng := rand.New(rand.NewSource(seed))
+	values := make([]float64, req.Count)
+	for i := range values {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		// direct samplers, whose cost doesn't grow with the parameters the way
+		// inverting the CDF by bisection does
+		values[i] = dist.sample(rng)
+	}
+	return &calculatorpb.RandomResponse{
+		Seed:   seed,
+		Values: values,
+	}, nil
+}
+
+// RollDice evaluates dice notation such as "4d6kh3+2"
+func (c *Calculator) RollDice(ctx context.Context, req *calculatorpb.RollDiceRequest) (*calculatorpb.RollDiceResponse, error) {
+	terms, err := parseDice(req.Notation)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := seedOrRandom(req.Seed)
+	rng := rand.New(rand.NewSource(seed))
+	res := &calculatorpb.RollDiceResponse{
+		Seed: seed,
+	}
+	for _, term := range terms {
+		rolled := term.roll(rng)
+		res.Total += rolled.Value
+		res.Terms = append(res.Terms, rolled)
+	}
+	return res, nil
+}
+
+func seedOrRandom(seed int64) int64 {
+	for seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return seed
+}
+
+// openUnit returns a uniform number in (0, 1), so quantile functions stay finite
+func openUnit(rng *rand.Rand) float64 {
+	for {
+		if u := rng.Float64(); u > 0 {
+			return u
+		}
+	}
+}
+
+// logGammaSample draws the log of a gamma distributed number with the shape
+// and a scale of 1, by Marsaglia and Tsang's method. Shapes below 1 are
+// boosted by one and scaled by U^(1/shape), which underflows for small shapes
+// unless kept in logs.
+func logGammaSample(rng *rand.Rand, shape float64) float64 {
+	if shape < 1 {
+		return logGammaSample(rng, shape+1) + math.Log(openUnit(rng))/shape
+	}
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rng.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := openUnit(rng)
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < x*x/2+d*(1-v+math.Log(v)) {
+			return math.Log(d * v)
+		}
+	}
+}
+
+// binomialSample draws from the binomial distribution with p at most 0.5, by
+// inversion when the mean is small and else by Hörmann's transformed
+// rejection (BTRS), both in constant expected time
+func binomialSample(rng *rand.Rand, n, p float64) float64 {
+	if n*p < 10 {
+		// walk up the probability masses from P(0) = q^n
+		q := 1 - p
+		s := p / q
+		for {
+			u, k, mass := rng.Float64(), 0.0, math.Exp(n*math.Log1p(-p))
+			for u > mass && k < n {
+				u -= mass
+				k++
+				mass *= (n - k + 1) / k * s
+			}
+			if u <= mass {
+				return k
+			}
+		}
+	}
+	spq := math.Sqrt(n * p * (1 - p))
+	b := 1.15 + 2.53*spq
+	a := -0.0873 + 0.0248*b + 0.01*p
+	c := n*p + 0.5
+	vr := 0.92 - 4.2/b
+	alpha := (2.83 + 5.1/b) * spq
+	lpq := math.Log(p / (1 - p))
+	m := math.Floor((n + 1) * p)
+	h := lgamma(m+1) + lgamma(n-m+1)
+	for {
+		u := rng.Float64() - 0.5
+		v := openUnit(rng)
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + c)
+		if k < 0 || k > n {
+			continue
+		}
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if math.Log(v*alpha/(a/(us*us)+b)) <= h-lgamma(k+1)-lgamma(n-k+1)+(k-m)*lpq {
+			return k
+		}
+	}
+}
+
+// poissonSample draws from the Poisson distribution, by multiplying uniforms
+// while their product stays above e^-lambda when lambda is small and else by
+// Hörmann's transformed rejection (PTRS)
+func poissonSample(rng *rand.Rand, lambda float64) float64 {
+	if lambda < 10 {
+		limit, product, k := math.Exp(-lambda), rng.Float64(), 0.0
+		for product > limit {
+			product *= rng.Float64()
+			k++
+		}
+		return k
+	}
+	logLambda := math.Log(lambda)
+	b := 0.931 + 2.53*math.Sqrt(lambda)
+	a := -0.059 + 0.02483*b
+	logInvAlpha := math.Log(1.1239 + 1.1328/(b-3.4))
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := rng.Float64() - 0.5
+		v := openUnit(rng)
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		if math.Log(v)+logInvAlpha-math.Log(a/(us*us)+b) <= -lambda+k*logLambda-lgamma(k+1) {
+			return k
+		}
+	}
+}
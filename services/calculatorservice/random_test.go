@@ -0,0 +1,155 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Random(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name         string
+		distribution calculatorpb.DISTRIBUTION
+		parameters   map[string]float64
+		min, max     float64
+	}{
+		{"Uniform", calculatorpb.DISTRIBUTION_DISTRIBUTION_UNIFORM, map[string]float64{"a": 5, "b": 10}, 5, 10},
+		{"Normal", calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, map[string]float64{"mu": 100}, 90, 110},
+		{"Poisson", calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON, map[string]float64{"lambda": 4}, 0, 30},
+		{"Beta", calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA, map[string]float64{"alpha": 2, "beta": 3}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &calculatorpb.RandomRequest{Seed: 42, Distribution: tt.distribution, Parameters: tt.parameters, Count: 500}
+			first, err := calculatorSvc.Random(context.Background(), req)
+			assert.Nil(t, err)
+			second, err := calculatorSvc.Random(context.Background(), req)
+			assert.Nil(t, err)
+
+			assert.Equal(t, int64(42), first.Seed)
+			assert.Len(t, first.Values, 500)
+			assert.Equal(t, first.Values, second.Values)
+			for _, v := range first.Values {
+				assert.True(t, v >= tt.min && v <= tt.max, "%v is outside [%v, %v]", v, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func Test_RandomMoments(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name           string
+		distribution   calculatorpb.DISTRIBUTION
+		parameters     map[string]float64
+		mean, variance float64
+	}{
+		{"LargeBinomial", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, map[string]float64{"n": 1e6, "p": 0.3}, 3e5, 2.1e5},
+		{"SmallBinomial", calculatorpb.DISTRIBUTION_DISTRIBUTION_BINOMIAL, map[string]float64{"n": 20, "p": 0.9}, 18, 1.8},
+		{"LargePoisson", calculatorpb.DISTRIBUTION_DISTRIBUTION_POISSON, map[string]float64{"lambda": 1000}, 1000, 1000},
+		{"StudentT", calculatorpb.DISTRIBUTION_DISTRIBUTION_STUDENT_T, map[string]float64{"df": 5}, 0, 5.0 / 3},
+		{"SmallShapeGamma", calculatorpb.DISTRIBUTION_DISTRIBUTION_GAMMA, map[string]float64{"shape": 0.3, "scale": 2}, 0.6, 1.2},
+		{"ChiSquare", calculatorpb.DISTRIBUTION_DISTRIBUTION_CHI_SQUARE, map[string]float64{"df": 3}, 3, 6},
+		{"Beta", calculatorpb.DISTRIBUTION_DISTRIBUTION_BETA, map[string]float64{"alpha": 2, "beta": 3}, 0.4, 0.04},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Random(context.Background(), &calculatorpb.RandomRequest{
+				Seed: 1, Distribution: tt.distribution, Parameters: tt.parameters, Count: 100000,
+			})
+			assert.Nil(t, err)
+			var mean, variance float64
+			for _, v := range res.Values {
+				mean += v
+			}
+			mean /= float64(len(res.Values))
+			for _, v := range res.Values {
+				variance += (v - mean) * (v - mean)
+			}
+			variance /= float64(len(res.Values))
+			// a few standard errors of the sample mean and variance
+			assert.InDelta(t, tt.mean, mean, 5*math.Sqrt(tt.variance/1e5))
+			assert.InEpsilon(t, tt.variance, variance, 0.05)
+		})
+	}
+}
+
+func Test_RandomWithoutSeed(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	req := &calculatorpb.RandomRequest{Distribution: calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL, Count: 10}
+	first, err := calculatorSvc.Random(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotZero(t, first.Seed)
+
+	replay, err := calculatorSvc.Random(context.Background(), &calculatorpb.RandomRequest{
+		Seed:         first.Seed,
+		Distribution: calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL,
+		Count:        10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, first.Values, replay.Values)
+
+	_, err = calculatorSvc.Random(context.Background(), &calculatorpb.RandomRequest{Distribution: calculatorpb.DISTRIBUTION_DISTRIBUTION_NORMAL})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+}
+
+func Test_RollDice(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name          string
+		notation      string
+		expectedTerms []string
+		expectedRolls []int
+		expectedKept  []int
+		min, max      int64
+	}{
+		{"KeepHighest", "4d6kh3+2", []string{"4d6kh3", "2"}, []int{4, 0}, []int{3, 0}, 5, 20},
+		{"DropLowest", "4d6dl1", []string{"4d6dl1"}, []int{4}, []int{3}, 3, 18},
+		{"Percentile", "d%", []string{"d%"}, []int{1}, []int{1}, 1, 100},
+		{"MixedTerms", "2d8 - 1d4 + 3", []string{"2d8", "1d4", "3"}, []int{2, 1, 0}, []int{2, 1, 0}, 1, 18},
+		{"KeepLowest", "2d20kl1", []string{"2d20kl1"}, []int{2}, []int{1}, 1, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &calculatorpb.RollDiceRequest{Notation: tt.notation, Seed: 7}
+			res, err := calculatorSvc.RollDice(context.Background(), req)
+			assert.Nil(t, err)
+			assert.True(t, res.Total >= tt.min && res.Total <= tt.max, "%d is outside [%d, %d]", res.Total, tt.min, tt.max)
+
+			total := int64(0)
+			assert.Len(t, res.Terms, len(tt.expectedTerms))
+			for i, term := range res.Terms {
+				assert.Equal(t, tt.expectedTerms[i], term.Notation)
+				assert.Len(t, term.Rolls, tt.expectedRolls[i])
+				assert.Len(t, term.Kept, tt.expectedKept[i])
+				total += term.Value
+			}
+			assert.Equal(t, res.Total, total)
+
+			replay, err := calculatorSvc.RollDice(context.Background(), req)
+			assert.Nil(t, err)
+			assert.Equal(t, res.Total, replay.Total)
+		})
+	}
+}
+
+func Test_RollDiceInvalidNotation(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	for _, notation := range []string{"", "4d", "4d6kh5", "4d6+", "2x6", "0d6", "5000d6", "4d6 4"} {
+		t.Run(notation, func(t *testing.T) {
+			_, err := calculatorSvc.RollDice(context.Background(), &calculatorpb.RollDiceRequest{Notation: notation})
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+		})
+	}
+}
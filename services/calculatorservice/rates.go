@@ -0,0 +1,347 @@
+package calculatorservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRatesRefresh = time.Hour
+	// ratesFetchTimeout bounds a fetch of rates, which is shared by the
+	// requests waiting for it and so bound to none of their contexts
+	ratesFetchTimeout = 30 * time.Second
+	// ratesRetryInterval is the wait after a failed fetch before the next,
+	// at most the refresh interval
+	ratesRetryInterval = time.Minute
+)
+
+// ExchangeRate is the price of one unit of Base in Quote as of a point in time
+type ExchangeRate struct {
+	Base  string
+	Quote string
+	Rate  *big.Rat
+	AsOf  time.Time
+}
+
+// RateProvider supplies the exchange rates of currency conversions
+type RateProvider interface {
+	// Rate returns the rate from base to quote, or an error wrapping
+	// ErrRateUnavailable when there is none
+	Rate(ctx context.Context, base, quote string) (ExchangeRate, error)
+}
+
+// rateTable holds quoted rates and derives the inverse and cross rates from them
+type rateTable map[[2]string]ExchangeRate
+
+func (t rateTable) add(base, quote, rate string, asOf time.Time) error {
+	base, err := parseCurrency(base)
+	if err != nil {
+		return err
+	}
+	quote, err = parseCurrency(quote)
+	if err != nil {
+		return err
+	}
+	r, err := parseDecimal(rate)
+	if err != nil {
+		return err
+	}
+	if r.Sign() <= 0 {
+		return fmt.Errorf("the rate from %s to %s must be positive", base, quote)
+	}
+	t[[2]string{base, quote}] = ExchangeRate{Base: base, Quote: quote, Rate: r, AsOf: asOf}
+	return nil
+}
+
+// rate looks the pair up directly, inverted, or crossed over a common currency,
+// a derived rate is as old as the oldest rate it is derived from
+func (t rateTable) rate(base, quote string) (ExchangeRate, bool) {
+	if r, ok := t.direct(base, quote); ok {
+		return r, true
+	}
+
+	var (
+		best    ExchangeRate
+		bestVia string
+		found   bool
+	)
+	for pair, first := range t {
+		var via ExchangeRate
+		switch base {
+		case pair[0]:
+			via = first
+		case pair[1]:
+			via = ExchangeRate{Base: base, Quote: pair[0], Rate: new(big.Rat).Inv(first.Rate), AsOf: first.AsOf}
+		default:
+			continue
+		}
+		second, ok := t.direct(via.Quote, quote)
+		if !ok {
+			continue
+		}
+		asOf := via.AsOf
+		if second.AsOf.Before(asOf) {
+			asOf = second.AsOf
+		}
+		// prefer the freshest cross rate, so that the choice doesn't depend on map order
+		if !found || asOf.After(best.AsOf) || (asOf.Equal(best.AsOf) && via.Quote < bestVia) {
+			best = ExchangeRate{Base: base, Quote: quote, Rate: new(big.Rat).Mul(via.Rate, second.Rate), AsOf: asOf}
+			bestVia, found = via.Quote, true
+		}
+	}
+	return best, found
+}
+
+func (t rateTable) direct(base, quote string) (ExchangeRate, bool) {
+	if r, ok := t[[2]string{base, quote}]; ok {
+		return r, true
+	}
+	if r, ok := t[[2]string{quote, base}]; ok {
+		return ExchangeRate{Base: base, Quote: quote, Rate: new(big.Rat).Inv(r.Rate), AsOf: r.AsOf}, true
+	}
+	return ExchangeRate{}, false
+}
+
+func (t rateTable) lookup(base, quote string) (ExchangeRate, error) {
+	r, ok := t.rate(base, quote)
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("%w: no rate from %s to %s", ErrRateUnavailable, base, quote)
+	}
+	return r, nil
+}
+
+// ratesDocument is the JSON form of a set of rates, quoted against base
+//
+//	{"base": "EUR", "as_of": "2024-05-02T16:00:00Z", "rates": {"USD": "1.0723", "GBP": "0.8562"}}
+//
+// as_of is either an RFC 3339 time or a date.
+type ratesDocument struct {
+	Base  string                 `json:"base"`
+	AsOf  string                 `json:"as_of"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+func parseRatesJSON(data []byte) (rateTable, error) {
+	// rates are decoded as json.Number so that they stay exact
+	var doc ratesDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse rates: %w", err)
+	}
+	asOf, err := parseAsOf(doc.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	table := rateTable{}
+	for quote, rate := range doc.Rates {
+		if err := table.add(doc.Base, quote, rate.String(), asOf); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+// parseRatesCSV reads rates with a base,quote,rate,as_of header
+func parseRatesCSV(data []byte) (rateTable, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rates: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the rates file is empty")
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"base", "quote", "rate", "as_of"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("the rates file has no %s column", name)
+		}
+	}
+
+	table := rateTable{}
+	for line, record := range records[1:] {
+		asOf, err := parseAsOf(record[columns["as_of"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+2, err)
+		}
+		if err := table.add(record[columns["base"]], record[columns["quote"]], record[columns["rate"]], asOf); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+2, err)
+		}
+	}
+	return table, nil
+}
+
+func parseAsOf(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(isoDate, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("as of %q is neither an RFC 3339 time nor a date", s)
+	}
+	return t, nil
+}
+
+// FileRateProvider serves the rates of a CSV or JSON file, which is read once
+type FileRateProvider struct {
+	table rateTable
+}
+
+// NewFileRateProvider reads rates from a .csv file with a base,quote,rate,as_of
+// header, or from a .json file in the format of ratesDocument
+func NewFileRateProvider(path string) (*FileRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file: %w", err)
+	}
+	var table rateTable
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		table, err = parseRatesCSV(data)
+	} else {
+		table, err = parseRatesJSON(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &FileRateProvider{table: table}, nil
+}
+
+// Rate ...
+func (p *FileRateProvider) Rate(ctx context.Context, base, quote string) (ExchangeRate, error) {
+	return p.table.lookup(base, quote)
+}
+
+// HTTPRateProvider fetches rates as JSON, in the format of ratesDocument, and
+// keeps them for the refresh interval. Concurrent requests share a single
+// fetch. Once there are rates, a refresh happens in the background while the
+// previous rates are served, and a failed one is retried after
+// ratesRetryInterval.
+type HTTPRateProvider struct {
+	url     string
+	client  *http.Client
+	refresh time.Duration
+
+	mu        sync.Mutex
+	table     rateTable
+	fetchedAt time.Time
+	// fetching is closed when the fetch in flight ends, nil when there is
+	// none, fetchErr is the error of the last fetch and failedAt when it
+	// failed
+	fetching chan struct{}
+	fetchErr error
+	failedAt time.Time
+}
+
+// NewHTTPRateProvider fetches rates from url, a refresh of zero keeps them for an hour
+func NewHTTPRateProvider(url string, client *http.Client, refresh time.Duration) *HTTPRateProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if refresh <= 0 {
+		refresh = defaultRatesRefresh
+	}
+	return &HTTPRateProvider{url: url, client: client, refresh: refresh}
+}
+
+// Rate ...
+func (p *HTTPRateProvider) Rate(ctx context.Context, base, quote string) (ExchangeRate, error) {
+	p.mu.Lock()
+	retry := p.fetchErr != nil && time.Since(p.failedAt) < p.retryInterval()
+	if p.table != nil {
+		if time.Since(p.fetchedAt) > p.refresh && !retry {
+			p.startFetch()
+		}
+		// the stale rates are served, with their as of, until a refresh ends
+		table := p.table
+		p.mu.Unlock()
+		return table.lookup(base, quote)
+	}
+	if retry && p.fetching == nil {
+		err := p.fetchErr
+		p.mu.Unlock()
+		return ExchangeRate{}, fmt.Errorf("%w: %v", ErrRateUnavailable, err)
+	}
+	done := p.startFetch()
+	p.mu.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ExchangeRate{}, ctx.Err()
+	}
+
+	p.mu.Lock()
+	table, err := p.table, p.fetchErr
+	p.mu.Unlock()
+	if table == nil {
+		return ExchangeRate{}, fmt.Errorf("%w: %v", ErrRateUnavailable, err)
+	}
+	return table.lookup(base, quote)
+}
+
+func (p *HTTPRateProvider) retryInterval() time.Duration {
+	if p.refresh < ratesRetryInterval {
+		return p.refresh
+	}
+	return ratesRetryInterval
+}
+
+// startFetch fetches the rates in the background unless a fetch is already in
+// flight, and returns the channel closed when it ends. p.mu must be held.
+func (p *HTTPRateProvider) startFetch() <-chan struct{} {
+	if p.fetching != nil {
+		return p.fetching
+	}
+	done := make(chan struct{})
+	p.fetching = done
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), ratesFetchTimeout)
+		defer cancel()
+		table, err := p.fetch(ctx)
+
+		p.mu.Lock()
+		if err == nil {
+			p.table, p.fetchedAt = table, time.Now()
+		} else {
+			p.failedAt = time.Now()
+		}
+		p.fetchErr, p.fetching = err, nil
+		p.mu.Unlock()
+		close(done)
+	}()
+	return done
+}
+
+func (p *HTTPRateProvider) fetch(ctx context.Context) (rateTable, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rates endpoint responded with %s", res.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseRatesJSON(data)
+}
@@ -0,0 +1,306 @@
+package calculatorservice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultRulesReload = 30 * time.Second
+	maxRuleDecimals    = 18
+)
+
+// ruleTablesFile is the YAML or JSON format of rule tables, for example
+//
+//	tables:
+//	  income-tax:
+//	    mode: marginal
+//	    brackets:
+//	      - {from: 0, percent: 10}
+//	      - {from: 11600, percent: 12}
+//	  shipping:
+//	    mode: flat
+//	    brackets:
+//	      - {from: 0, fixed: 5.99}
+//	      - {from: 50, fixed: 0}
+//
+// Numbers are read as exact decimals.
+type ruleTablesFile struct {
+	Tables map[string]struct {
+		// Mode is marginal, where every bracket applies to the part of the
+		// amount within it, or flat, where only the bracket the amount falls
+		// in applies, to the whole amount. The default is marginal.
+		Mode string `yaml:"mode"`
+		// Decimals the amount of every bracket is rounded to, default 2
+		Decimals *int `yaml:"decimals"`
+		Brackets []struct {
+			From    string `yaml:"from"`
+			Percent string `yaml:"percent"`
+			Fixed   string `yaml:"fixed"`
+		} `yaml:"brackets"`
+	} `yaml:"tables"`
+}
+
+type ruleBracket struct {
+	from    *big.Rat
+	percent *big.Rat
+	fixed   *big.Rat
+}
+
+type ruleTable struct {
+	flat     bool
+	decimals int
+	brackets []ruleBracket
+}
+
+// RuleTables holds the bracket tables of EvaluateRuleTable, loaded from the
+// YAML and JSON files of a directory in the format of ruleTablesFile
+type RuleTables struct {
+	dir string
+
+	mu     sync.RWMutex
+	tables map[string]*ruleTable
+	// stamp tells whether the files changed since they were loaded
+	stamp string
+}
+
+// NewRuleTables loads the rule tables of dir
+func NewRuleTables(dir string) (*RuleTables, error) {
+	r := &RuleTables{dir: dir}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the tables again when a file was added, removed or changed and
+// reports whether it did. The tables loaded before are kept when a file is
+// invalid.
+func (r *RuleTables) Reload() (bool, error) {
+	files, stamp, err := ruleTableFiles(r.dir)
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	unchanged := r.tables != nil && stamp == r.stamp
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	tables := map[string]*ruleTable{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return false, fmt.Errorf("failed to read rule tables: %w", err)
+		}
+		if err := parseRuleTables(data, tables); err != nil {
+			return false, fmt.Errorf("failed to parse rule tables %s: %w", filepath.Base(file), err)
+		}
+	}
+	r.mu.Lock()
+	r.tables, r.stamp = tables, stamp
+	r.mu.Unlock()
+	return true, nil
+}
+
+// Watch reloads the tables every interval until ctx is done, a zero interval
+// checks every 30 seconds
+func (r *RuleTables) Watch(ctx context.Context, interval time.Duration, logger log.Logger) {
+	if interval <= 0 {
+		interval = defaultRulesReload
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				level.Error(logger).Log("msg", "failed to reload rule tables, keeping the previous ones", "err", err)
+			} else if reloaded {
+				level.Info(logger).Log("msg", "reloaded rule tables", "dir", r.dir)
+			}
+		}
+	}
+}
+
+func (r *RuleTables) table(name string) (*ruleTable, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.tables[name]
+	return t, ok
+}
+
+// ruleTableFiles lists the YAML and JSON files of dir with a stamp of their
+// names, sizes and modification times
+func ruleTableFiles(dir string) ([]string, string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read rule tables: %w", err)
+	}
+	var files []string
+	var stamp strings.Builder
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read rule tables: %w", err)
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+		fmt.Fprintf(&stamp, "%s %d %d\n", entry.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	return files, stamp.String(), nil
+}
+
+func parseRuleTables(data []byte, tables map[string]*ruleTable) error {
+	var file ruleTablesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return err
+	}
+	for name, def := range file.Tables {
+		if _, ok := tables[name]; ok {
+			return fmt.Errorf("table %s is defined twice", name)
+		}
+		t := &ruleTable{decimals: 2}
+		switch def.Mode {
+		case "", "marginal":
+		case "flat":
+			t.flat = true
+		default:
+			return fmt.Errorf("table %s has mode %q, not marginal or flat", name, def.Mode)
+		}
+		if def.Decimals != nil {
+			if *def.Decimals < 0 || *def.Decimals > maxRuleDecimals {
+				return fmt.Errorf("table %s has %d decimals, not 0 to %d", name, *def.Decimals, maxRuleDecimals)
+			}
+			t.decimals = *def.Decimals
+		}
+		if len(def.Brackets) == 0 {
+			return fmt.Errorf("table %s has no brackets", name)
+		}
+		for i, b := range def.Brackets {
+			var bracket ruleBracket
+			for _, field := range []struct {
+				value string
+				dst   **big.Rat
+			}{{b.From, &bracket.from}, {b.Percent, &bracket.percent}, {b.Fixed, &bracket.fixed}} {
+				value := field.value
+				if value == "" {
+					value = "0"
+				}
+				x, err := parseDecimal(value)
+				if err != nil {
+					return fmt.Errorf("table %s: %w", name, err)
+				}
+				*field.dst = x
+			}
+			if bracket.from.Sign() < 0 {
+				return fmt.Errorf("table %s has a bracket from %s, below zero", name, b.From)
+			}
+			if i > 0 && bracket.from.Cmp(t.brackets[i-1].from) <= 0 {
+				return fmt.Errorf("the brackets of table %s are not in increasing order of from", name)
+			}
+			t.brackets = append(t.brackets, bracket)
+		}
+		tables[name] = t
+	}
+	return nil
+}
+
+// EvaluateRuleTable applies a bracket table to an amount
+func (c *Calculator) EvaluateRuleTable(ctx context.Context, req *calculatorpb.RuleTableRequest) (*calculatorpb.RuleTableResponse, error) {
+	if c.rules == nil {
+		return nil, invalidArgumentf("no rule tables are loaded")
+	}
+	table, ok := c.rules.table(req.Table)
+	if !ok {
+		return nil, invalidArgumentf("unknown rule table %q", req.Table)
+	}
+	amount, err := parseDecimal(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() < 0 {
+		return nil, invalidArgumentf("amount %s must not be negative", req.Amount)
+	}
+
+	// the bracket the amount falls in, brackets start at their from
+	current := sort.Search(len(table.brackets), func(i int) bool {
+		return table.brackets[i].from.Cmp(amount) > 0
+	}) - 1
+	format := func(x *big.Rat) string {
+		return formatDecimal(x, decimalPlaces(x))
+	}
+
+	res := &calculatorpb.RuleTableResponse{}
+	result := new(big.Rat)
+	for i, b := range table.brackets {
+		if i > current || (table.flat && i != current) {
+			continue
+		}
+		base := amount
+		if !table.flat {
+			// the part of the amount between from and the next from
+			base = new(big.Rat).Sub(amount, b.from)
+			if i+1 < len(table.brackets) && table.brackets[i+1].from.Cmp(amount) < 0 {
+				base.Sub(table.brackets[i+1].from, b.from)
+			}
+		}
+		charge := new(big.Rat).Mul(base, b.percent)
+		charge.Quo(charge, big.NewRat(100, 1))
+		charge = roundDecimal(charge.Add(charge, b.fixed), table.decimals, req.Rounding)
+		result.Add(result, charge)
+
+		bracket := &calculatorpb.RuleTableBracket{
+			From:    format(b.from),
+			Percent: format(b.percent),
+			Fixed:   format(b.fixed),
+			Base:    format(base),
+			Amount:  formatDecimal(charge, table.decimals),
+		}
+		if i+1 < len(table.brackets) {
+			bracket.To = format(table.brackets[i+1].from)
+		}
+		res.Brackets = append(res.Brackets, bracket)
+	}
+
+	res.Result = formatDecimal(result, table.decimals)
+	if current >= 0 {
+		res.MarginalPercent = format(table.brackets[current].percent)
+	}
+	if amount.Sign() > 0 {
+		effective := new(big.Rat).Quo(result, amount)
+		effective.Mul(effective, big.NewRat(100, 1))
+		res.EffectivePercent = formatDecimal(roundDecimal(effective, percentDigits, req.Rounding), percentDigits)
+	}
+	return res, nil
+}
+
+// decimalPlaces is the number of decimals of x, which must be a decimal
+func decimalPlaces(x *big.Rat) int {
+	places := 0
+	ten := big.NewInt(10)
+	// the smallest power of ten that the denominator divides
+	for p := big.NewInt(1); new(big.Int).Rem(p, x.Denom()).Sign() != 0; places++ {
+		p.Mul(p, ten)
+	}
+	return places
+}
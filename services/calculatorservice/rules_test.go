@@ -0,0 +1,236 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+const taxTablesYAML = `tables:
+  income-tax:
+    brackets:
+      - {from: 0, percent: 10}
+      - {from: 11600, percent: 12}
+      - {from: 47150, percent: 22}
+      - {from: 100525, percent: 24}
+  surcharge:
+    mode: marginal
+    brackets:
+      - {from: 0}
+      - {from: 1000, percent: 1, fixed: 25}
+`
+
+const discountTablesJSON = `{"tables": {
+  "shipping": {"mode": "flat", "brackets": [{"from": 0, "fixed": 5.99}, {"from": "50", "fixed": 0}]},
+  "volume-discount": {"mode": "flat", "brackets": [{"from": 1000, "percent": 5}, {"from": 5000, "percent": 7.5}]},
+  "points": {"mode": "flat", "decimals": 0, "brackets": [{"from": 0, "percent": 33.3}]}
+}}`
+
+func writeRuleTables(t *testing.T, dir, name, content string) {
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func Test_EvaluateRuleTable(t *testing.T) {
+	dir := t.TempDir()
+	writeRuleTables(t, dir, "tax.yaml", taxTablesYAML)
+	writeRuleTables(t, dir, "discounts.json", discountTablesJSON)
+	rules, err := calculatorservice.NewRuleTables(dir)
+	assert.Nil(t, err)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRuleTables(rules))
+
+	tests := []struct {
+		name              string
+		request           *calculatorpb.RuleTableRequest
+		expectedResult    string
+		expectedEffective string
+		expectedMarginal  string
+		expectedBrackets  []*calculatorpb.RuleTableBracket
+	}{
+		{
+			name:              "Marginal",
+			request:           &calculatorpb.RuleTableRequest{Table: "income-tax", Amount: "52000"},
+			expectedResult:    "6493.00",
+			expectedEffective: "12.4865",
+			expectedMarginal:  "22",
+			expectedBrackets: []*calculatorpb.RuleTableBracket{
+				{From: "0", To: "11600", Percent: "10", Fixed: "0", Base: "11600", Amount: "1160.00"},
+				{From: "11600", To: "47150", Percent: "12", Fixed: "0", Base: "35550", Amount: "4266.00"},
+				{From: "47150", To: "100525", Percent: "22", Fixed: "0", Base: "4850", Amount: "1067.00"},
+			},
+		},
+		{
+			name:              "MarginalTopBracket",
+			request:           &calculatorpb.RuleTableRequest{Table: "income-tax", Amount: "100525.50"},
+			expectedResult:    "17168.62",
+			expectedEffective: "17.0789",
+			expectedMarginal:  "24",
+		},
+		{
+			name:              "MarginalWithFixedAmount",
+			request:           &calculatorpb.RuleTableRequest{Table: "surcharge", Amount: "1500"},
+			expectedResult:    "30.00",
+			expectedEffective: "2.0000",
+			expectedMarginal:  "1",
+			expectedBrackets: []*calculatorpb.RuleTableBracket{
+				{From: "0", To: "1000", Percent: "0", Fixed: "0", Base: "1000", Amount: "0.00"},
+				{From: "1000", Percent: "1", Fixed: "25", Base: "500", Amount: "30.00"},
+			},
+		},
+		{
+			name:              "FlatFixed",
+			request:           &calculatorpb.RuleTableRequest{Table: "shipping", Amount: "49.99"},
+			expectedResult:    "5.99",
+			expectedEffective: "11.9824",
+			expectedMarginal:  "0",
+			expectedBrackets: []*calculatorpb.RuleTableBracket{
+				{From: "0", To: "50", Percent: "0", Fixed: "5.99", Base: "49.99", Amount: "5.99"},
+			},
+		},
+		{
+			name:              "FlatAtThreshold",
+			request:           &calculatorpb.RuleTableRequest{Table: "shipping", Amount: "50"},
+			expectedResult:    "0.00",
+			expectedEffective: "0.0000",
+			expectedMarginal:  "0",
+		},
+		{
+			name:              "FlatPercent",
+			request:           &calculatorpb.RuleTableRequest{Table: "volume-discount", Amount: "1234.56"},
+			expectedResult:    "61.73",
+			expectedEffective: "5.0002",
+			expectedMarginal:  "5",
+		},
+		{
+			name:              "FlatPercentRoundedDown",
+			request:           &calculatorpb.RuleTableRequest{Table: "volume-discount", Amount: "1234.56", Rounding: calculatorpb.ROUNDING_ROUNDING_DOWN},
+			expectedResult:    "61.72",
+			expectedEffective: "4.9993",
+			expectedMarginal:  "5",
+		},
+		{
+			name:              "BelowFirstBracket",
+			request:           &calculatorpb.RuleTableRequest{Table: "volume-discount", Amount: "999.99"},
+			expectedResult:    "0.00",
+			expectedEffective: "0.0000",
+			expectedBrackets:  []*calculatorpb.RuleTableBracket{},
+		},
+		{
+			name:              "NoDecimals",
+			request:           &calculatorpb.RuleTableRequest{Table: "points", Amount: "10"},
+			expectedResult:    "3",
+			expectedEffective: "30.0000",
+			expectedMarginal:  "33.3",
+		},
+		{
+			name:             "Zero",
+			request:          &calculatorpb.RuleTableRequest{Table: "income-tax", Amount: "0"},
+			expectedResult:   "0.00",
+			expectedMarginal: "10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.EvaluateRuleTable(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, res.Result)
+			assert.Equal(t, tt.expectedEffective, res.EffectivePercent)
+			assert.Equal(t, tt.expectedMarginal, res.MarginalPercent)
+			if tt.expectedBrackets != nil {
+				assert.Len(t, res.Brackets, len(tt.expectedBrackets))
+				for i, b := range tt.expectedBrackets {
+					assert.Equal(t, b.String(), res.Brackets[i].String())
+				}
+			}
+		})
+	}
+}
+
+func Test_ReloadRuleTables(t *testing.T) {
+	dir := t.TempDir()
+	writeRuleTables(t, dir, "tax.yaml", taxTablesYAML)
+	rules, err := calculatorservice.NewRuleTables(dir)
+	assert.Nil(t, err)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRuleTables(rules))
+	evaluate := func() string {
+		res, err := calculatorSvc.EvaluateRuleTable(context.Background(), &calculatorpb.RuleTableRequest{Table: "income-tax", Amount: "10000"})
+		if err != nil {
+			return err.Error()
+		}
+		return res.Result
+	}
+	assert.Equal(t, "1000.00", evaluate())
+
+	reloaded, err := rules.Reload()
+	assert.Nil(t, err)
+	assert.False(t, reloaded)
+
+	writeRuleTables(t, dir, "tax.yaml", "tables: {income-tax: {brackets: [{from: 0, percent: 15}]}}")
+	reloaded, err = rules.Reload()
+	assert.Nil(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, "1500.00", evaluate())
+
+	// a broken file keeps the tables that were loaded
+	writeRuleTables(t, dir, "tax.yaml", "tables: {income-tax: {brackets: [{from: 10, percent: 15}, {from: 5}]}}")
+	_, err = rules.Reload()
+	assert.NotNil(t, err)
+	assert.Equal(t, "1500.00", evaluate())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go rules.Watch(ctx, 10*time.Millisecond, log.NewNopLogger())
+	writeRuleTables(t, dir, "tax.yaml", "tables: {income-tax: {brackets: [{from: 0, percent: 20.0}]}}")
+	assert.Eventually(t, func() bool { return evaluate() == "2000.00" }, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_RuleTableErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeRuleTables(t, dir, "tax.yaml", taxTablesYAML)
+	rules, err := calculatorservice.NewRuleTables(dir)
+	assert.Nil(t, err)
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithRuleTables(rules))
+	for name, req := range map[string]*calculatorpb.RuleTableRequest{
+		"UnknownTable":   {Table: "vat", Amount: "100"},
+		"NegativeAmount": {Table: "income-tax", Amount: "-100"},
+		"NotADecimal":    {Table: "income-tax", Amount: "1e5"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := calculatorSvc.EvaluateRuleTable(context.Background(), req)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	withoutTables, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	_, err = withoutTables.EvaluateRuleTable(context.Background(), &calculatorpb.RuleTableRequest{Table: "income-tax", Amount: "100"})
+	assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument))
+
+	for name, content := range map[string]string{
+		"BadMode":     "tables: {t: {mode: progressive, brackets: [{from: 0}]}}",
+		"NoBrackets":  "tables: {t: {brackets: []}}",
+		"Unordered":   "tables: {t: {brackets: [{from: 10}, {from: 10}]}}",
+		"BadPercent":  "tables: {t: {brackets: [{from: 0, percent: ten}]}}",
+		"BadDecimals": "tables: {t: {decimals: 40, brackets: [{from: 0}]}}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeRuleTables(t, dir, "t.yaml", content)
+			_, err := calculatorservice.NewRuleTables(dir)
+			assert.NotNil(t, err)
+		})
+	}
+
+	dir = t.TempDir()
+	writeRuleTables(t, dir, "a.yaml", "tables: {t: {brackets: [{from: 0}]}}")
+	writeRuleTables(t, dir, "b.yml", "tables: {t: {brackets: [{from: 0}]}}")
+	_, err = calculatorservice.NewRuleTables(dir)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,156 @@
+package calculatorservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	// maxLevinOrder bounds the order of the Levin transform, above which its
+	// alternating binomial sums lose the digits they gain. The series is
+	// summed directly past its first maxLevinOrder+1 terms.
+	maxLevinOrder = 14
+	// negligibleTerms in a row below the rounding of the partial sum end a
+	// series that converges without acceleration
+	negligibleTerms = 10
+)
+
+// SumSeries sums an expression over a range of integers or an infinite series
+func (c *Calculator) SumSeries(ctx context.Context, req *calculatorpb.SumSeriesRequest) (*calculatorpb.SumSeriesResponse, error) {
+	f, err := c.expressionFunction(ctx, req.Expression, req.Variable, "n", req.Values, req.MaxEvaluations)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Infinite {
+		if req.End < req.Start {
+			return nil, invalidArgumentf("end %d is below start %d", req.End, req.Start)
+		}
+		// the count wraps to 0 over the whole range of int64
+		count := uint64(req.End) - uint64(req.Start) + 1
+		if count == 0 || count > f.budget {
+			return nil, fmt.Errorf("%w: the series has more than %d terms", ErrBudgetExceeded, f.budget)
+		}
+		var sum compensatedSum
+		var magnitude float64
+		for i := uint64(0); i < count; i++ {
+			a, err := f.eval(float64(req.Start) + float64(i))
+			if err != nil {
+				return nil, err
+			}
+			sum.add(a)
+			magnitude += math.Abs(a)
+		}
+		return &calculatorpb.SumSeriesResponse{
+			Value:  sum.value(),
+			Error:  machineEpsilon * magnitude,
+			Terms:  count,
+			Method: "direct",
+		}, nil
+	}
+
+	tolerance := req.Tolerance
+	if tolerance == 0 {
+		tolerance = defaultNumericTolerance
+	}
+	if !(tolerance > 0) || math.IsInf(tolerance, 0) {
+		return nil, invalidArgumentf("tolerance %v must be positive", req.Tolerance)
+	}
+	var sum compensatedSum
+	var terms, sums []float64
+	previous, previousDiff := math.NaN(), math.Inf(1)
+	negligible := 0
+	for n := 0; ; n++ {
+		a, err := f.eval(float64(req.Start) + float64(n))
+		if err != nil {
+			if errors.Is(err, ErrBudgetExceeded) {
+				return nil, fmt.Errorf("%w: the series did not converge within %d terms", ErrBudgetExceeded, f.budget)
+			}
+			return nil, err
+		}
+		sum.add(a)
+
+		if math.Abs(a) <= machineEpsilon*math.Abs(sum.value()) {
+			negligible++
+		} else {
+			negligible = 0
+		}
+		if negligible == negligibleTerms {
+			return &calculatorpb.SumSeriesResponse{
+				Value: sum.value(), Error: machineEpsilon * math.Abs(sum.value()), Terms: f.count, Method: "direct",
+			}, nil
+		}
+
+		if len(terms) > maxLevinOrder {
+			continue
+		}
+		terms, sums = append(terms, a), append(sums, sum.value())
+		// the Levin transforms of increasing order, accepted once one agrees
+		// with the one before and that one roughly with its own predecessor.
+		// A series whose terms don't shrink diverges, even if the transform
+		// finds an antilimit.
+		estimate, ok := levinTransform(terms, sums)
+		if !ok || math.Abs(a) >= math.Abs(terms[0]) {
+			previous, previousDiff = math.NaN(), math.Inf(1)
+			continue
+		}
+		diff := math.Abs(estimate - previous)
+		bound := tolerance * math.Max(1, math.Abs(estimate))
+		if diff <= bound && previousDiff <= 10*bound {
+			return &calculatorpb.SumSeriesResponse{Value: estimate, Error: diff, Terms: f.count, Method: "levin"}, nil
+		}
+		previous, previousDiff = estimate, diff
+	}
+}
+
+// levinTransform is the Levin u-transform of the partial sums sums of terms,
+// it is not defined when a term is 0
+func levinTransform(terms, sums []float64) (float64, bool) {
+	k := len(terms) - 1
+	if k < 1 {
+		return 0, false
+	}
+	var numerator, denominator float64
+	binomial := 1.0
+	for j := 0; j <= k; j++ {
+		// the remainder estimate of the u-transform, (j+1)*terms[j]
+		omega := float64(j+1) * terms[j]
+		if omega == 0 {
+			return 0, false
+		}
+		weight := binomial * math.Pow(float64(j+1)/float64(k+1), float64(k-1)) / omega
+		if j%2 == 1 {
+			weight = -weight
+		}
+		// relative to the last partial sum, as the transform commutes with
+		// adding a constant and the sums are close to each other
+		numerator += weight * (sums[j] - sums[k])
+		denominator += weight
+		binomial = binomial * float64(k-j) / float64(j+1)
+	}
+	estimate := sums[k] + numerator/denominator
+	return estimate, !math.IsNaN(estimate) && !math.IsInf(estimate, 0)
+}
+
+// compensatedSum is Neumaier's variant of Kahan summation
+type compensatedSum struct {
+	sum, compensation float64
+}
+
+func (s *compensatedSum) add(x float64) {
+	t := s.sum + x
+	if math.Abs(s.sum) >= math.Abs(x) {
+		s.compensation += (s.sum - t) + x
+	} else {
+		s.compensation += (x - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s *compensatedSum) value() float64 {
+	return s.sum + s.compensation
+}
@@ -0,0 +1,95 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SumSeries(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name           string
+		request        *calculatorpb.SumSeriesRequest
+		expected       float64
+		expectedMethod string
+	}{
+		{"Finite", &calculatorpb.SumSeriesRequest{Expression: "n^2", Start: 1, End: 100}, 338350, "direct"},
+		{"FiniteSingleTerm", &calculatorpb.SumSeriesRequest{Expression: "n^2", Start: 7, End: 7}, 49, "direct"},
+		{"FiniteNegativeRange", &calculatorpb.SumSeriesRequest{Expression: "k", Variable: "k", Start: -10, End: 5}, -40, "direct"},
+		{"FiniteCompensated", &calculatorpb.SumSeriesRequest{Expression: "0.1", Start: 1, End: 100000}, 10000, "direct"},
+		{"Geometric", &calculatorpb.SumSeriesRequest{Expression: "r^n", Values: map[string]float64{"r": 0.5}, Infinite: true}, 2, "levin"},
+		{"Telescoping", &calculatorpb.SumSeriesRequest{Expression: "1/(n*(n+1))", Start: 1, Infinite: true}, 1, "levin"},
+		{"Basel", &calculatorpb.SumSeriesRequest{Expression: "1/n^2", Start: 1, Infinite: true}, math.Pi * math.Pi / 6, "levin"},
+		{"AlternatingHarmonic", &calculatorpb.SumSeriesRequest{Expression: "(-1)^n/(n+1)", Infinite: true}, math.Ln2, "levin"},
+		{"Leibniz", &calculatorpb.SumSeriesRequest{Expression: "4*(-1)^n/(2n+1)", Infinite: true}, math.Pi, "levin"},
+		{"LooseTolerance", &calculatorpb.SumSeriesRequest{Expression: "1/n^4", Start: 1, Infinite: true, Tolerance: 1e-6}, math.Pow(math.Pi, 4) / 90, "levin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.SumSeries(context.Background(), tt.request)
+			assert.Nil(t, err)
+			tolerance := tt.request.Tolerance
+			if tolerance == 0 {
+				tolerance = 1e-10
+			}
+			assert.InDelta(t, tt.expected, res.Value, tolerance*math.Max(1, math.Abs(tt.expected)))
+			assert.LessOrEqual(t, res.Error, tolerance*math.Max(1, math.Abs(tt.expected)))
+			assert.Equal(t, tt.expectedMethod, res.Method)
+		})
+	}
+}
+
+func Test_SumSeriesAcceleration(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// the partial sums of the Basel problem are still 1e-6 off after a million
+	// terms, the Levin transform gets there in a handful
+	res, err := calculatorSvc.SumSeries(context.Background(), &calculatorpb.SumSeriesRequest{Expression: "1/n^2", Start: 1, Infinite: true})
+	assert.Nil(t, err)
+	assert.Less(t, res.Terms, uint64(20))
+
+	// factorially decreasing terms vanish before the transform settles
+	res, err = calculatorSvc.SumSeries(context.Background(), &calculatorpb.SumSeriesRequest{Expression: "1/2^(n^2)", Infinite: true})
+	assert.Nil(t, err)
+	assert.InDelta(t, 1.5644684136, res.Value, 1e-10)
+}
+
+func Test_SumSeriesErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithEvaluationBudget(10000))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.SumSeriesRequest
+		expected error
+	}{
+		{"Harmonic", &calculatorpb.SumSeriesRequest{Expression: "1/n", Start: 1, Infinite: true}, calculatorservice.ErrBudgetExceeded},
+		{"Grandi", &calculatorpb.SumSeriesRequest{Expression: "(-1)^n", Infinite: true}, calculatorservice.ErrBudgetExceeded},
+		{"TooManyTerms", &calculatorpb.SumSeriesRequest{Expression: "n", Start: 1, End: 10001}, calculatorservice.ErrBudgetExceeded},
+		{"WholeRange", &calculatorpb.SumSeriesRequest{Expression: "n", Start: math.MinInt64, End: math.MaxInt64}, calculatorservice.ErrBudgetExceeded},
+		{"RequestBudget", &calculatorpb.SumSeriesRequest{Expression: "n", Start: 1, End: 100, MaxEvaluations: 50}, calculatorservice.ErrBudgetExceeded},
+		{"AboveServiceBudget", &calculatorpb.SumSeriesRequest{Expression: "n", End: 1, MaxEvaluations: 20000}, calculatorservice.ErrInvalidArgument},
+		{"EndBelowStart", &calculatorpb.SumSeriesRequest{Expression: "n", Start: 2, End: 1}, calculatorservice.ErrInvalidArgument},
+		{"UndefinedTerm", &calculatorpb.SumSeriesRequest{Expression: "1/n", End: 3}, calculatorservice.ErrInvalidArgument},
+		{"MissingValue", &calculatorpb.SumSeriesRequest{Expression: "r^n", Infinite: true}, calculatorservice.ErrInvalidArgument},
+		{"NegativeTolerance", &calculatorpb.SumSeriesRequest{Expression: "1/2^n", Infinite: true, Tolerance: -1}, calculatorservice.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.SumSeries(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, tt.expected), "unexpected error %v", err)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := calculatorSvc.SumSeries(ctx, &calculatorpb.SumSeriesRequest{Expression: "n", End: 10})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
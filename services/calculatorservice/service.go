@@ -10,15 +10,127 @@ import (
 // Service ...
 type Service interface {
 	Calculator(ctx context.Context, operator calculatorpb.OPERATOR, operands *calculatorpb.OPERANDS) (result float64, err error)
+	StreamStatistics(ctx context.Context, recv func() (*calculatorpb.StatisticsStreamRequest, error), send func(*calculatorpb.StatisticsSnapshot) error) error
+	TTest(ctx context.Context, req *calculatorpb.TTestRequest) (*calculatorpb.HypothesisTestResponse, error)
+	ChiSquareTest(ctx context.Context, req *calculatorpb.ChiSquareTestRequest) (*calculatorpb.HypothesisTestResponse, error)
+	Correlation(ctx context.Context, req *calculatorpb.CorrelationRequest) (*calculatorpb.HypothesisTestResponse, error)
+	Distribution(ctx context.Context, req *calculatorpb.DistributionRequest) (*calculatorpb.DistributionResponse, error)
+	Random(ctx context.Context, req *calculatorpb.RandomRequest) (*calculatorpb.RandomResponse, error)
+	RollDice(ctx context.Context, req *calculatorpb.RollDiceRequest) (*calculatorpb.RollDiceResponse, error)
+	Combinatorics(ctx context.Context, req *calculatorpb.CombinatoricsRequest) (*calculatorpb.CombinatoricsResponse, error)
+	NumberTheory(ctx context.Context, req *calculatorpb.NumberTheoryRequest) (*calculatorpb.NumberTheoryResponse, error)
+	IntegerCalculator(ctx context.Context, req *calculatorpb.IntegerCalculateRequest) (*calculatorpb.IntegerCalculateResponse, error)
+	FloatBits(ctx context.Context, req *calculatorpb.FloatBitsRequest) (*calculatorpb.FloatBitsResponse, error)
+	UnitCalculator(ctx context.Context, req *calculatorpb.UnitCalculateRequest) (*calculatorpb.UnitCalculateResponse, error)
+	ConvertCurrency(ctx context.Context, req *calculatorpb.ConvertCurrencyRequest) (*calculatorpb.ConvertCurrencyResponse, error)
+	MoneyCalculator(ctx context.Context, req *calculatorpb.MoneyCalculateRequest) (*calculatorpb.MoneyCalculateResponse, error)
+	TimeValue(ctx context.Context, req *calculatorpb.TimeValueRequest) (*calculatorpb.TimeValueResponse, error)
+	CashFlow(ctx context.Context, req *calculatorpb.CashFlowRequest) (*calculatorpb.CashFlowResponse, error)
+	Amortization(ctx context.Context, req *calculatorpb.AmortizationRequest, send func(*calculatorpb.AmortizationRow) error) error
+	Depreciation(ctx context.Context, req *calculatorpb.DepreciationRequest) (*calculatorpb.DepreciationResponse, error)
+	DateCalculator(ctx context.Context, req *calculatorpb.DateCalculateRequest) (*calculatorpb.DateCalculateResponse, error)
+	EvaluateRuleTable(ctx context.Context, req *calculatorpb.RuleTableRequest) (*calculatorpb.RuleTableResponse, error)
+	Symbolic(ctx context.Context, req *calculatorpb.SymbolicRequest) (*calculatorpb.SymbolicResponse, error)
+	EvaluateExpression(ctx context.Context, req *calculatorpb.EvaluateExpressionRequest) (*calculatorpb.EvaluateExpressionResponse, error)
+	Solve(ctx context.Context, req *calculatorpb.SolveRequest) (*calculatorpb.SolveResponse, error)
+	Integrate(ctx context.Context, req *calculatorpb.IntegrateRequest) (*calculatorpb.IntegrateResponse, error)
+	SumSeries(ctx context.Context, req *calculatorpb.SumSeriesRequest) (*calculatorpb.SumSeriesResponse, error)
+	SolveODE(ctx context.Context, req *calculatorpb.SolveODERequest, send func(*calculatorpb.ODEPoint) error) error
+	Plot(ctx context.Context, req *calculatorpb.PlotRequest) (*calculatorpb.PlotResponse, error)
+	Interpolate(ctx context.Context, req *calculatorpb.InterpolateRequest) (*calculatorpb.InterpolateResponse, error)
+	Fit(ctx context.Context, req *calculatorpb.FitRequest) (*calculatorpb.FitResponse, error)
+	LinearProgram(ctx context.Context, req *calculatorpb.LinearProgramRequest) (*calculatorpb.LinearProgramResponse, error)
+	FourierTransform(ctx context.Context, req *calculatorpb.FourierTransformRequest) (*calculatorpb.FourierTransformResponse, error)
+	PowerSpectrum(ctx context.Context, req *calculatorpb.PowerSpectrumRequest) (*calculatorpb.PowerSpectrumResponse, error)
+	Window(ctx context.Context, req *calculatorpb.WindowRequest) (*calculatorpb.WindowResponse, error)
+	FIRFilter(ctx context.Context, req *calculatorpb.FIRFilterRequest) (*calculatorpb.FIRFilterResponse, error)
+	Geometry(ctx context.Context, req *calculatorpb.GeometryRequest) (*calculatorpb.GeometryResponse, error)
+	Logic(ctx context.Context, req *calculatorpb.LogicRequest) (*calculatorpb.LogicResponse, error)
+	IntervalCalculator(ctx context.Context, req *calculatorpb.IntervalCalculateRequest) (*calculatorpb.IntervalCalculateResponse, error)
 }
 
 type Calculator struct {
-	logger log.Logger
+	logger             log.Logger
+	combinatoricsLimit uint64
+	evaluationBudget   uint64
+	unitsFile          string
+	units              *unitRegistry
+	rates              RateProvider
+	calendarsDir       string
+	calendars          map[string]*holidayCalendar
+	rules              *RuleTables
+}
+
+// Option configures the calculator service
+type Option func(*Calculator)
+
+// WithCombinatoricsLimit caps n for the exact combinatorics functions, so a single
+// request can't pin a CPU
+func WithCombinatoricsLimit(n uint64) Option {
+	return func(c *Calculator) {
+		c.combinatoricsLimit = n
+	}
+}
+
+// WithEvaluationBudget caps the evaluations of an expression by a single
+// integral or series
+func WithEvaluationBudget(n uint64) Option {
+	return func(c *Calculator) {
+		c.evaluationBudget = n
+	}
+}
+
+// WithUnitsFile loads extra unit definitions from a YAML or JSON file, in the
+// format of units.yaml, on top of the built-in ones
+func WithUnitsFile(path string) Option {
+	return func(c *Calculator) {
+		c.unitsFile = path
+	}
+}
+
+// WithRateProvider sets where currency conversions get their exchange rates from
+func WithRateProvider(p RateProvider) Option {
+	return func(c *Calculator) {
+		c.rates = p
+	}
+}
+
+// WithHolidayCalendars loads the holiday calendars of business day calculations
+// from the YAML and JSON files of a directory
+func WithHolidayCalendars(dir string) Option {
+	return func(c *Calculator) {
+		c.calendarsDir = dir
+	}
+}
+
+// WithRuleTables sets the bracket tables of EvaluateRuleTable
+func WithRuleTables(r *RuleTables) Option {
+	return func(c *Calculator) {
+		c.rules = r
+	}
 }
 
 // NewService ...
-func NewService(logger log.Logger) (Service, error) {
-	return &Calculator{
-		logger: logger,
-	}, nil
+func NewService(logger log.Logger, opts ...Option) (Service, error) {
+	c := &Calculator{
+		logger:             logger,
+		combinatoricsLimit: defaultCombinatoricsLimit,
+		evaluationBudget:   defaultEvaluationBudget,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	units, err := loadUnitRegistry(c.unitsFile)
+	if err != nil {
+		return nil, err
+	}
+	c.units = units
+
+	calendars, err := loadHolidayCalendars(c.calendarsDir)
+	if err != nil {
+		return nil, err
+	}
+	c.calendars = calendars
+	return c, nil
 }
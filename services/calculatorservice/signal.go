@@ -0,0 +1,305 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"math/bits"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultFilterLength = 101
+	maxFilterLength     = 10001
+	// directFilterTaps is the most taps filtered by direct convolution, more
+	// take the overlap-add method with the FFT
+	directFilterTaps = 64
+)
+
+// PowerSpectrum computes the one-sided power spectral density of samples
+func (c *Calculator) PowerSpectrum(ctx context.Context, req *calculatorpb.PowerSpectrumRequest) (*calculatorpb.PowerSpectrumResponse, error) {
+	n := len(req.Samples)
+	if err := signalLength(n); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("samples", req.Samples); err != nil {
+		return nil, err
+	}
+	rate, err := sampleRate(req.SampleRate)
+	if err != nil {
+		return nil, err
+	}
+	w, err := window(req.Window, n)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]complex128, n)
+	power := 0.0
+	for i, v := range req.Samples {
+		x[i] = complex(v*w[i], 0)
+		power += w[i] * w[i]
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fft(x, false)
+	res := &calculatorpb.PowerSpectrumResponse{
+		Frequencies: make([]float64, n/2+1),
+		Power:       make([]float64, n/2+1),
+	}
+	for k := range res.Power {
+		res.Frequencies[k] = float64(k) * rate / float64(n)
+		p := (real(x[k])*real(x[k]) + imag(x[k])*imag(x[k])) / (rate * power)
+		// the negative frequencies fold onto the positive ones, all but 0
+		// and the Nyquist frequency of an even n, which are their own
+		if k > 0 && 2*k != n {
+			p *= 2
+		}
+		res.Power[k] = p
+	}
+	return res, nil
+}
+
+// Window applies a window to samples
+func (c *Calculator) Window(ctx context.Context, req *calculatorpb.WindowRequest) (*calculatorpb.WindowResponse, error) {
+	if err := signalLength(len(req.Samples)); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("samples", req.Samples); err != nil {
+		return nil, err
+	}
+	w, err := window(req.Window, len(req.Samples))
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range req.Samples {
+		w[i] *= v
+	}
+	return &calculatorpb.WindowResponse{Samples: w}, nil
+}
+
+// FIRFilter filters samples with a finite impulse response filter
+func (c *Calculator) FIRFilter(ctx context.Context, req *calculatorpb.FIRFilterRequest) (*calculatorpb.FIRFilterResponse, error) {
+	if err := signalLength(len(req.Samples)); err != nil {
+		return nil, err
+	}
+	if err := finiteValues("samples", req.Samples); err != nil {
+		return nil, err
+	}
+	var taps []float64
+	if req.Filter == calculatorpb.FIR_FILTER_FIR_FILTER_TAPS {
+		if len(req.Taps) == 0 {
+			return nil, invalidArgumentf("taps are not supplied")
+		}
+		if len(req.Taps) > maxSignalSamples {
+			return nil, invalidArgumentf("%d taps are more than the limit of %d", len(req.Taps), maxSignalSamples)
+		}
+		if err := finiteValues("taps", req.Taps); err != nil {
+			return nil, err
+		}
+		taps = req.Taps
+	} else {
+		var err error
+		if taps, err = designFilter(req); err != nil {
+			return nil, err
+		}
+	}
+
+	y, err := convolve(ctx, req.Samples, taps)
+	if err != nil {
+		return nil, err
+	}
+	return &calculatorpb.FIRFilterResponse{Samples: y, Taps: taps}, nil
+}
+
+func sampleRate(rate float64) (float64, error) {
+	switch {
+	case rate == 0:
+		return 1, nil
+	case !(rate > 0) || math.IsInf(rate, 0):
+		return 0, invalidArgumentf("sample rate %v is not a positive number", rate)
+	}
+	return rate, nil
+}
+
+// window is the symmetric window of n samples
+func window(kind calculatorpb.WINDOW, n int) ([]float64, error) {
+	// cosine terms of the generalized cosine windows
+	var a []float64
+	switch kind {
+	case calculatorpb.WINDOW_WINDOW_RECTANGULAR:
+		a = []float64{1}
+	case calculatorpb.WINDOW_WINDOW_HANN:
+		a = []float64{0.5, 0.5}
+	case calculatorpb.WINDOW_WINDOW_HAMMING:
+		a = []float64{0.54, 0.46}
+	case calculatorpb.WINDOW_WINDOW_BLACKMAN:
+		a = []float64{0.42, 0.5, 0.08}
+	default:
+		return nil, invalidArgumentf("unknown window %v", kind)
+	}
+	w := make([]float64, n)
+	for i := range w {
+		if n == 1 {
+			w[i] = 1
+			continue
+		}
+		// w = a0 - a1 cos(2 pi i/(n-1)) + a2 cos(4 pi i/(n-1)) - ...
+		phase := 2 * math.Pi * float64(i) / float64(n-1)
+		sign := 1.0
+		for k, ak := range a {
+			w[i] += sign * ak * math.Cos(float64(k)*phase)
+			sign = -sign
+		}
+	}
+	return w, nil
+}
+
+// designFilter designs a filter by the window method, the ideal response
+// of sinc functions truncated to the length of the filter and tapered by the
+// window
+func designFilter(req *calculatorpb.FIRFilterRequest) ([]float64, error) {
+	length := int(req.Length)
+	switch {
+	case length == 0:
+		length = defaultFilterLength
+	case length > maxFilterLength:
+		return nil, invalidArgumentf("filter length %d is more than the limit of %d", length, maxFilterLength)
+	case length%2 == 0:
+		// the high pass and band stop filters of an even length are 0 at the
+		// Nyquist frequency
+		return nil, invalidArgumentf("filter length %d is not odd", length)
+	}
+	rate, err := sampleRate(req.SampleRate)
+	if err != nil {
+		return nil, err
+	}
+	nyquist := rate / 2
+	cutoff := func(f float64) error {
+		if !(f > 0 && f < nyquist) {
+			return invalidArgumentf("cutoff %v is not between 0 and half the sample rate %v", f, nyquist)
+		}
+		return nil
+	}
+	if err := cutoff(req.Cutoff); err != nil {
+		return nil, err
+	}
+	band := req.Filter == calculatorpb.FIR_FILTER_FIR_FILTER_BAND_PASS || req.Filter == calculatorpb.FIR_FILTER_FIR_FILTER_BAND_STOP
+	if band {
+		if err := cutoff(req.CutoffHigh); err != nil {
+			return nil, err
+		}
+		if req.CutoffHigh <= req.Cutoff {
+			return nil, invalidArgumentf("cutoff high %v is not above cutoff %v", req.CutoffHigh, req.Cutoff)
+		}
+	}
+	w, err := window(req.Window, length)
+	if err != nil {
+		return nil, err
+	}
+
+	// lowPass is the ideal low pass response at tap i, the sinc function
+	// about the middle of the filter
+	middle := length / 2
+	lowPass := func(f float64, i int) float64 {
+		fc := f / rate
+		if i == middle {
+			return 2 * fc
+		}
+		t := float64(i - middle)
+		return math.Sin(2*math.Pi*fc*t) / (math.Pi * t)
+	}
+	// the pass band frequency whose gain is scaled to 1
+	var unit float64
+	taps := make([]float64, length)
+	for i := range taps {
+		var h float64
+		switch req.Filter {
+		case calculatorpb.FIR_FILTER_FIR_FILTER_LOW_PASS:
+			h = lowPass(req.Cutoff, i)
+		case calculatorpb.FIR_FILTER_FIR_FILTER_HIGH_PASS:
+			h, unit = -lowPass(req.Cutoff, i), nyquist
+		case calculatorpb.FIR_FILTER_FIR_FILTER_BAND_PASS:
+			h, unit = lowPass(req.CutoffHigh, i)-lowPass(req.Cutoff, i), (req.Cutoff+req.CutoffHigh)/2
+		case calculatorpb.FIR_FILTER_FIR_FILTER_BAND_STOP:
+			h = lowPass(req.Cutoff, i) - lowPass(req.CutoffHigh, i)
+		default:
+			return nil, invalidArgumentf("unknown filter %v", req.Filter)
+		}
+		// the high pass and band stop filters subtract the low pass ones from
+		// the impulse
+		if i == middle && (req.Filter == calculatorpb.FIR_FILTER_FIR_FILTER_HIGH_PASS || req.Filter == calculatorpb.FIR_FILTER_FIR_FILTER_BAND_STOP) {
+			h++
+		}
+		taps[i] = h * w[i]
+	}
+
+	// the gain at unit, of the response without its linear phase
+	gain := 0.0
+	for i, h := range taps {
+		gain += h * math.Cos(2*math.Pi*unit/rate*float64(i-middle))
+	}
+	for i := range taps {
+		taps[i] /= gain
+	}
+	return taps, nil
+}
+
+// convolve filters x with taps, as many outputs as inputs
+func convolve(ctx context.Context, x, taps []float64) ([]float64, error) {
+	y := make([]float64, len(x))
+	if len(taps) <= directFilterTaps {
+		for i := range y {
+			if i%(1<<16) == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			sum := 0.0
+			for k, h := range taps {
+				if k > i {
+					break
+				}
+				sum += h * x[i-k]
+			}
+			y[i] = sum
+		}
+		return y, nil
+	}
+
+	// overlap-add, blocks of x convolved with the taps by the FFT of a power
+	// of 2 of at least 4 times the taps, whose tails add to the next blocks
+	size := 1 << bits.Len(uint(4*len(taps)-1))
+	block := size - len(taps) + 1
+	response := make([]complex128, size)
+	for i, h := range taps {
+		response[i] = complex(h, 0)
+	}
+	fft(response, false)
+	buffer := make([]complex128, size)
+	scale := 1 / float64(size)
+	for start := 0; start < len(x); start += block {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i := range buffer {
+			buffer[i] = 0
+			if i < block && start+i < len(x) {
+				buffer[i] = complex(x[start+i], 0)
+			}
+		}
+		fft(buffer, false)
+		for i := range buffer {
+			buffer[i] *= response[i]
+		}
+		fft(buffer, true)
+		for i, v := range buffer {
+			if start+i >= len(y) {
+				break
+			}
+			y[start+i] += real(v) * scale
+		}
+	}
+	return y, nil
+}
@@ -0,0 +1,220 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Window(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	ones := []float64{1, 1, 1, 1, 1}
+	tests := []struct {
+		name     string
+		request  *calculatorpb.WindowRequest
+		expected []float64
+	}{
+		{"Rectangular", &calculatorpb.WindowRequest{Samples: []float64{3, -1, 2}}, []float64{3, -1, 2}},
+		{"Hann", &calculatorpb.WindowRequest{Window: calculatorpb.WINDOW_WINDOW_HANN, Samples: ones}, []float64{0, 0.5, 1, 0.5, 0}},
+		{"Hamming", &calculatorpb.WindowRequest{Window: calculatorpb.WINDOW_WINDOW_HAMMING, Samples: ones}, []float64{0.08, 0.54, 1, 0.54, 0.08}},
+		{"Blackman", &calculatorpb.WindowRequest{Window: calculatorpb.WINDOW_WINDOW_BLACKMAN, Samples: ones}, []float64{0, 0.34, 1, 0.34, 0}},
+		{"Scaled", &calculatorpb.WindowRequest{Window: calculatorpb.WINDOW_WINDOW_HANN, Samples: []float64{4, 4, -2}}, []float64{0, 4, 0}},
+		{"OneSample", &calculatorpb.WindowRequest{Window: calculatorpb.WINDOW_WINDOW_BLACKMAN, Samples: []float64{2}}, []float64{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Window(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expected, res.Samples, 1e-12)
+		})
+	}
+}
+
+func Test_PowerSpectrum(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	// 1 + 2 sin(2 pi 50 t) sampled at 1000Hz for a second
+	const n, rate = 1000, 1000.0
+	samples := make([]float64, n)
+	meanSquare := 0.0
+	for i := range samples {
+		samples[i] = 1 + 2*math.Sin(2*math.Pi*50*float64(i)/rate)
+		meanSquare += samples[i] * samples[i] / n
+	}
+	res, err := calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{Samples: samples, SampleRate: rate})
+	assert.Nil(t, err)
+	assert.Len(t, res.Frequencies, n/2+1)
+	assert.Equal(t, 50.0, res.Frequencies[50])
+	assert.Equal(t, 500.0, res.Frequencies[n/2])
+
+	// the power of the offset is 1 and of the sine its amplitude squared over
+	// 2, the frequencies are 1Hz apart
+	assert.InDelta(t, 1, res.Power[0], 1e-9)
+	assert.InDelta(t, 2, res.Power[50], 1e-9)
+	total := 0.0
+	for _, p := range res.Power {
+		total += p
+	}
+	assert.InDelta(t, meanSquare, total, 1e-9)
+
+	// a sine between the frequencies leaks into its neighbours, far less
+	// with a window
+	for i := range samples {
+		samples[i] = math.Sin(2 * math.Pi * 50.5 * float64(i) / rate)
+	}
+	rectangular, err := calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{Samples: samples, SampleRate: rate})
+	assert.Nil(t, err)
+	hann, err := calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{Samples: samples, SampleRate: rate, Window: calculatorpb.WINDOW_WINDOW_HANN})
+	assert.Nil(t, err)
+	assert.Less(t, hann.Power[60], rectangular.Power[60]/100)
+	assert.Greater(t, hann.Power[50], hann.Power[49])
+	assert.Greater(t, hann.Power[51], hann.Power[52])
+
+	// the Nyquist frequency of an odd number of samples is not a frequency
+	res, err = calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{Samples: []float64{1, -1, 1}})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0, 1.0 / 3}, res.Frequencies, 1e-12)
+}
+
+func Test_FIRFilter(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: []float64{1, 3, 5, 7}, Taps: []float64{0.5, 0.5}})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0.5, 2, 4, 6}, res.Samples, 1e-12)
+
+	// many taps take the FFT, which agrees with the convolution
+	r := rand.New(rand.NewSource(1))
+	samples, taps := make([]float64, 5000), make([]float64, 300)
+	for i := range samples {
+		samples[i] = r.NormFloat64()
+	}
+	for i := range taps {
+		taps[i] = r.NormFloat64()
+	}
+	res, err = calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Taps: taps})
+	assert.Nil(t, err)
+	for _, i := range []int{0, 1, 299, 300, 1000, 1234, 4999} {
+		y := 0.0
+		for k := 0; k < len(taps) && k <= i; k++ {
+			y += taps[k] * samples[i-k]
+		}
+		assert.InDelta(t, y, res.Samples[i], 1e-9, "sample %d", i)
+	}
+}
+
+func Test_FIRFilterDesign(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	const n, rate = 2000, 1000.0
+	sine := func(f float64) []float64 {
+		s := make([]float64, n)
+		for i := range s {
+			s[i] = math.Sin(2 * math.Pi * f * float64(i) / rate)
+		}
+		return s
+	}
+	// the filters delay by half their length, 50 samples, so their output
+	// past the first 100 samples is the delayed sine times the gain
+	tests := []struct {
+		name      string
+		request   *calculatorpb.FIRFilterRequest
+		frequency float64
+		gain      float64
+	}{
+		{"LowPassPasses", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_LOW_PASS, Cutoff: 100}, 10, 1},
+		{"LowPassStops", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_LOW_PASS, Cutoff: 100}, 250, 0},
+		{"HighPassPasses", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_HIGH_PASS, Cutoff: 100}, 300, 1},
+		{"HighPassStops", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_HIGH_PASS, Cutoff: 100}, 20, 0},
+		{"BandPassPasses", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_BAND_PASS, Cutoff: 150, CutoffHigh: 250}, 200, 1},
+		{"BandPassStops", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_BAND_PASS, Cutoff: 150, CutoffHigh: 250}, 50, 0},
+		{"BandStopPasses", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_BAND_STOP, Cutoff: 150, CutoffHigh: 250}, 400, 1},
+		{"BandStopStops", &calculatorpb.FIRFilterRequest{Filter: calculatorpb.FIR_FILTER_FIR_FILTER_BAND_STOP, Cutoff: 150, CutoffHigh: 250}, 200, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.Samples, tt.request.SampleRate, tt.request.Window = sine(tt.frequency), rate, calculatorpb.WINDOW_WINDOW_HAMMING
+			res, err := calculatorSvc.FIRFilter(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.Len(t, res.Taps, 101)
+			// linear phase filters are symmetric
+			for i := range res.Taps {
+				assert.InDelta(t, res.Taps[i], res.Taps[len(res.Taps)-1-i], 1e-15)
+			}
+			for i := 100; i < n; i++ {
+				assert.InDelta(t, tt.gain*tt.request.Samples[i-50], res.Samples[i], 0.01, "sample %d", i)
+			}
+		})
+	}
+}
+
+func Test_SignalErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	lowPass := calculatorpb.FIR_FILTER_FIR_FILTER_LOW_PASS
+	bandPass := calculatorpb.FIR_FILTER_FIR_FILTER_BAND_PASS
+	samples := []float64{1, 2, 3}
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"SpectrumNoSamples", func() error {
+			_, err := calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{})
+			return err
+		}},
+		{"SpectrumSampleRate", func() error {
+			_, err := calculatorSvc.PowerSpectrum(context.Background(), &calculatorpb.PowerSpectrumRequest{Samples: samples, SampleRate: -1})
+			return err
+		}},
+		{"WindowNaN", func() error {
+			_, err := calculatorSvc.Window(context.Background(), &calculatorpb.WindowRequest{Samples: []float64{math.Inf(1)}})
+			return err
+		}},
+		{"FilterNoTaps", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples})
+			return err
+		}},
+		{"FilterEvenLength", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: lowPass, Cutoff: 0.1, Length: 10})
+			return err
+		}},
+		{"FilterTooLong", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: lowPass, Cutoff: 0.1, Length: 10003})
+			return err
+		}},
+		{"FilterCutoffAboveNyquist", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: lowPass, Cutoff: 0.5})
+			return err
+		}},
+		{"FilterMissingCutoff", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: lowPass})
+			return err
+		}},
+		{"FilterBandReversed", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: bandPass, Cutoff: 0.3, CutoffHigh: 0.2})
+			return err
+		}},
+		{"FilterUnknown", func() error {
+			_, err := calculatorSvc.FIRFilter(context.Background(), &calculatorpb.FIRFilterRequest{Samples: samples, Filter: 9, Cutoff: 0.1})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := calculatorSvc.FIRFilter(ctx, &calculatorpb.FIRFilterRequest{Samples: samples, Taps: []float64{1}})
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+}
@@ -0,0 +1,535 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"math/cmplx"
+	"sort"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultSolveTolerance  = 1e-12
+	defaultSolveIterations = 100
+	maxSolveIterations     = 10000
+	maxPolynomialDegree    = 4
+	// maxIntermediateDegree bounds the degree of the terms of a polynomial
+	// before like terms cancel
+	maxIntermediateDegree = 64
+	machineEpsilon        = 0x1p-52
+	// polishSteps of Newton's method refine the closed-form roots
+	polishSteps = 3
+)
+
+// Solve finds the roots of an equation in one variable
+func (c *Calculator) Solve(ctx context.Context, req *calculatorpb.SolveRequest) (*calculatorpb.SolveResponse, error) {
+	e, err := parseEquation(req.Equation)
+	if err != nil {
+		return nil, err
+	}
+	variable := req.Variable
+	if variable == "" {
+		variable = "x"
+	}
+	if _, ok := exprConstants[variable]; ok {
+		return nil, invalidArgumentf("can not solve for the constant %s", variable)
+	}
+	values := map[string]float64{}
+	for name, v := range req.Values {
+		if _, ok := exprConstants[name]; ok {
+			return nil, invalidArgumentf("%s is a constant and can not be given a value", name)
+		}
+		if name == variable {
+			return nil, invalidArgumentf("the variable %s solved for can not be given a value", name)
+		}
+		values[name] = v
+	}
+	vars := map[string]bool{}
+	exprVariables(e, vars)
+	if !vars[variable] {
+		return nil, invalidArgumentf("the equation has no variable %s", variable)
+	}
+	for name := range vars {
+		if _, ok := values[name]; !ok && name != variable {
+			return nil, invalidArgumentf("variable %s has no value", name)
+		}
+	}
+
+	if req.Solver == calculatorpb.SOLVER_SOLVER_POLYNOMIAL {
+		coefficients, err := polynomialCoefficients(e, variable, values)
+		if err != nil {
+			return nil, err
+		}
+		return solvePolynomial(coefficients)
+	}
+
+	tolerance := req.Tolerance
+	if tolerance == 0 {
+		tolerance = defaultSolveTolerance
+	}
+	if !(tolerance > 0) || math.IsInf(tolerance, 0) {
+		return nil, invalidArgumentf("tolerance %v must be positive", req.Tolerance)
+	}
+	iterations := int(req.MaxIterations)
+	if iterations == 0 {
+		iterations = defaultSolveIterations
+	}
+	if iterations > maxSolveIterations {
+		return nil, invalidArgumentf("max_iterations %d is above the maximum of %d", req.MaxIterations, maxSolveIterations)
+	}
+	f := func(x float64) float64 {
+		values[variable] = x
+		v, _ := evalExpr(e, values)
+		return v
+	}
+
+	var root float64
+	var convergence *calculatorpb.Convergence
+	switch req.Solver {
+	case calculatorpb.SOLVER_SOLVER_BISECTION, calculatorpb.SOLVER_SOLVER_BRENT:
+		lo, hi := req.Lower, req.Upper
+		if !(lo < hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+			return nil, invalidArgumentf("lower %v must be below upper %v", lo, hi)
+		}
+		flo, fhi := f(lo), f(hi)
+		if math.IsNaN(flo) || math.IsNaN(fhi) {
+			return nil, invalidArgumentf("the equation is not defined at lower %v or upper %v", lo, hi)
+		}
+		if flo != 0 && fhi != 0 && math.Signbit(flo) == math.Signbit(fhi) {
+			return nil, invalidArgumentf("the equation does not change sign between lower %v and upper %v", lo, hi)
+		}
+		switch {
+		case flo == 0 || fhi == 0:
+			// a root on a bound, which the sign tests of the solvers would
+			// move away from
+			method := "bisection"
+			if req.Solver == calculatorpb.SOLVER_SOLVER_BRENT {
+				method = "brent"
+			}
+			root = lo
+			if flo != 0 {
+				root = hi
+			}
+			convergence = &calculatorpb.Convergence{Method: method, Converged: true}
+		case req.Solver == calculatorpb.SOLVER_SOLVER_BISECTION:
+			root, convergence = bisect(f, lo, hi, flo, tolerance, iterations)
+		default:
+			root, convergence = brent(f, lo, hi, flo, fhi, tolerance, iterations)
+		}
+	case calculatorpb.SOLVER_SOLVER_NEWTON:
+		index := map[string]int{variable: 0}
+		root, convergence = newton(func(x float64) (float64, float64) {
+			values[variable] = x
+			d, _ := evalDual(e, values, index)
+			if d.partials == nil {
+				return d.value, 0
+			}
+			return d.value, d.partials[0]
+		}, req.Initial, tolerance, iterations)
+	default:
+		return nil, invalidArgumentf("solver is not supplied")
+	}
+	return &calculatorpb.SolveResponse{
+		Roots:       []*calculatorpb.Root{{Real: root}},
+		Convergence: convergence,
+	}, nil
+}
+
+// parseEquation parses "f" or "lhs = rhs" into the expression that is zero at
+// the solutions
+func parseEquation(input string) (expr, error) {
+	sides := strings.Split(input, "=")
+	if len(sides) > 2 {
+		return nil, invalidArgumentf("equation has more than one =")
+	}
+	lhs, err := parseExpression(sides[0])
+	if err != nil || len(sides) == 1 {
+		return lhs, err
+	}
+	rhs, err := parseExpression(sides[1])
+	if err != nil {
+		return nil, err
+	}
+	return &binaryNode{'-', lhs, rhs}, nil
+}
+
+// bisect halves the bracket lo to hi, where f changes sign, until its half
+// width is within tolerance
+func bisect(f func(float64) float64, lo, hi, flo, tolerance float64, iterations int) (float64, *calculatorpb.Convergence) {
+	res := &calculatorpb.Convergence{Method: "bisection"}
+	for ; ; res.Iterations++ {
+		mid := lo + (hi-lo)/2
+		res.Error = (hi - lo) / 2
+		fmid := f(mid)
+		if fmid == 0 {
+			res.Error = 0
+		}
+		// the bracket can not shrink below adjacent floats
+		if res.Error <= tolerance || mid == lo || mid == hi {
+			res.Converged = true
+		}
+		if res.Converged || int(res.Iterations) == iterations {
+			res.Residual = fmid
+			return mid, res
+		}
+		if math.Signbit(fmid) == math.Signbit(flo) {
+			lo, flo = mid, fmid
+		} else {
+			hi = mid
+		}
+	}
+}
+
+// brent is Brent's method over the bracket a to b, where f changes sign
+func brent(f func(float64) float64, a, b, fa, fb, tolerance float64, iterations int) (float64, *calculatorpb.Convergence) {
+	res := &calculatorpb.Convergence{Method: "brent"}
+	c, fc := b, fb
+	var d, e float64
+	for ; ; res.Iterations++ {
+		// c is the other end of the bracket and b the best estimate
+		if fb != 0 && math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol := 2*machineEpsilon*math.Abs(b) + tolerance/2
+		half := (c - b) / 2
+		res.Error, res.Residual = math.Abs(half), fb
+		if fb == 0 {
+			res.Error = 0
+		}
+		if res.Error <= tol {
+			res.Converged = true
+			return b, res
+		}
+		if int(res.Iterations) == iterations {
+			return b, res
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// the secant method or inverse quadratic interpolation, kept when
+			// it falls within the bracket and shrinks fast enough
+			s := fb / fa
+			var p, q float64
+			if a == c {
+				p = 2 * half * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*half*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*half*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = half
+				e = d
+			}
+		} else {
+			d = half
+			e = d
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, half)
+		}
+		fb = f(b)
+	}
+}
+
+// newton is Newton's method from x, f returns the value and the derivative
+func newton(f func(float64) (float64, float64), x, tolerance float64, iterations int) (float64, *calculatorpb.Convergence) {
+	res := &calculatorpb.Convergence{Method: "newton", Error: math.NaN()}
+	for ; ; res.Iterations++ {
+		fx, slope := f(x)
+		res.Residual = fx
+		if fx == 0 {
+			res.Converged, res.Error = true, 0
+			return x, res
+		}
+		if int(res.Iterations) == iterations || slope == 0 || math.IsNaN(slope) || math.IsInf(slope, 0) {
+			return x, res
+		}
+		step := fx / slope
+		next := x - step
+		if math.IsNaN(next) || math.IsInf(next, 0) {
+			return x, res
+		}
+		x, res.Error = next, math.Abs(step)
+		if res.Error <= tolerance+2*machineEpsilon*math.Abs(x) {
+			res.Converged = true
+			res.Iterations++
+			res.Residual, _ = f(x)
+			return x, res
+		}
+	}
+}
+
+// polynomialCoefficients expands e into a polynomial in x, lowest degree
+// first. The subexpressions without x are computed with values.
+func polynomialCoefficients(e expr, x string, values map[string]float64) ([]float64, error) {
+	if !dependsOn(e, x) {
+		v, err := evalExpr(e, values)
+		return []float64{v}, err
+	}
+	switch e := e.(type) {
+	case *variableNode:
+		return []float64{0, 1}, nil
+	case *negNode:
+		p, err := polynomialCoefficients(e.x, x, values)
+		for i := range p {
+			p[i] = -p[i]
+		}
+		return p, err
+	case *binaryNode:
+		l, err := polynomialCoefficients(e.left, x, values)
+		if err != nil {
+			return nil, err
+		}
+		if e.op == '^' {
+			n, err := evalExpr(e.right, values)
+			if err != nil || dependsOn(e.right, x) || n != math.Trunc(n) || n < 0 || n > maxIntermediateDegree {
+				return nil, invalidArgumentf("%s is not a polynomial in %s", e, x)
+			}
+			p := []float64{1}
+			for i := 0; i < int(n); i++ {
+				if p, err = multiplyPolynomials(p, l, x); err != nil {
+					return nil, err
+				}
+			}
+			return p, nil
+		}
+		if e.op == '/' && dependsOn(e.right, x) {
+			return nil, invalidArgumentf("%s is not a polynomial in %s", e, x)
+		}
+		r, err := polynomialCoefficients(e.right, x, values)
+		if err != nil {
+			return nil, err
+		}
+		switch e.op {
+		case '*':
+			return multiplyPolynomials(l, r, x)
+		case '/':
+			for i := range l {
+				l[i] /= r[0]
+			}
+			return l, nil
+		}
+		if e.op == '-' {
+			for i := range r {
+				r[i] = -r[i]
+			}
+		}
+		if len(l) < len(r) {
+			l, r = r, l
+		}
+		for i := range r {
+			l[i] += r[i]
+		}
+		return l, nil
+	}
+	return nil, invalidArgumentf("%s is not a polynomial in %s", e, x)
+}
+
+func multiplyPolynomials(p, q []float64, x string) ([]float64, error) {
+	if len(p)+len(q)-2 > maxIntermediateDegree {
+		return nil, invalidArgumentf("the polynomial in %s has a degree above %d", x, maxIntermediateDegree)
+	}
+	res := make([]float64, len(p)+len(q)-1)
+	for i, a := range p {
+		for j, b := range q {
+			res[i+j] += a * b
+		}
+	}
+	return res, nil
+}
+
+// solvePolynomial finds the roots of a polynomial, lowest degree first, in
+// closed form
+func solvePolynomial(coefficients []float64) (*calculatorpb.SolveResponse, error) {
+	for _, a := range coefficients {
+		if math.IsNaN(a) || math.IsInf(a, 0) {
+			return nil, invalidArgumentf("the polynomial has a coefficient of %v", a)
+		}
+	}
+	degree := len(coefficients) - 1
+	for degree > 0 && coefficients[degree] == 0 {
+		degree--
+	}
+	if degree == 0 {
+		return nil, invalidArgumentf("the equation is constant after like terms cancel")
+	}
+	if degree > maxPolynomialDegree {
+		return nil, invalidArgumentf("the polynomial has degree %d, the closed form is limited to %d", degree, maxPolynomialDegree)
+	}
+
+	// monic, highest degree first
+	a := make([]float64, degree+1)
+	for i := range a {
+		a[i] = coefficients[degree-i] / coefficients[degree]
+	}
+	var roots []complex128
+	switch degree {
+	case 1:
+		roots = []complex128{complex(-a[1], 0)}
+	case 2:
+		roots = quadraticRoots(a[1], a[2])
+	case 3:
+		roots = cubicRoots(a[1], a[2], a[3])
+	case 4:
+		roots = quarticRoots(a[1], a[2], a[3], a[4])
+	}
+
+	res := &calculatorpb.SolveResponse{
+		Convergence: &calculatorpb.Convergence{Converged: true, Method: []string{"", "linear", "quadratic", "cubic", "quartic"}[degree]},
+	}
+	for i, z := range roots {
+		roots[i] = polishRoot(a, z)
+		res.Convergence.Residual = math.Max(res.Convergence.Residual, cmplx.Abs(evalPolynomial(a, roots[i])))
+		if cmplx.IsNaN(roots[i]) || cmplx.IsInf(roots[i]) {
+			res.Convergence.Converged = false
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+	for _, z := range roots {
+		res.Roots = append(res.Roots, &calculatorpb.Root{Real: real(z), Imag: imag(z)})
+	}
+	return res, nil
+}
+
+// quadraticRoots are the roots of x^2 + b*x + c
+func quadraticRoots(b, c float64) []complex128 {
+	disc := b*b - 4*c
+	if disc < 0 {
+		re, im := -b/2, math.Sqrt(-disc)/2
+		return []complex128{complex(re, -im), complex(re, im)}
+	}
+	// the larger root first, the other from their product, avoids cancellation
+	q := -(b + math.Copysign(math.Sqrt(disc), b)) / 2
+	if q == 0 {
+		return []complex128{0, 0}
+	}
+	return []complex128{complex(q, 0), complex(c/q, 0)}
+}
+
+// cubicRoots are the roots of x^3 + b*x^2 + c*x + d
+func cubicRoots(b, c, d float64) []complex128 {
+	// t^3 + p*t + q with x = t - b/3
+	shift := b / 3
+	p := c - b*shift
+	q := 2*shift*shift*shift - c*shift + d
+	disc := q*q/4 + p*p*p/27
+
+	var t float64
+	switch {
+	case p == 0 && q == 0:
+		return []complex128{complex(-shift, 0), complex(-shift, 0), complex(-shift, 0)}
+	case disc <= 0:
+		// three real roots, by the trigonometric method
+		m := 2 * math.Sqrt(-p/3)
+		theta := math.Acos(math.Max(-1, math.Min(1, 3*q/(p*m)))) / 3
+		roots := make([]complex128, 3)
+		for k := range roots {
+			roots[k] = complex(m*math.Cos(theta-2*math.Pi*float64(k)/3)-shift, 0)
+		}
+		return roots
+	default:
+		// one real root by Cardano's formula, the others from the quadratic
+		// left after dividing it out
+		s := math.Sqrt(disc)
+		t = math.Cbrt(-q/2+s) + math.Cbrt(-q/2-s)
+	}
+	x := t - shift
+	return append([]complex128{complex(x, 0)}, quadraticRoots(b+x, c+x*(b+x))...)
+}
+
+// quarticRoots are the roots of x^4 + b*x^3 + c*x^2 + d*x + e, by Ferrari's
+// method
+func quarticRoots(b, c, d, e float64) []complex128 {
+	// y^4 + p*y^2 + q*y + r with x = y - b/4
+	shift := b / 4
+	p := c - 6*shift*shift
+	q := d - 2*c*shift + 8*shift*shift*shift
+	r := e - d*shift + c*shift*shift - 3*shift*shift*shift*shift
+
+	// y^4 + p*y^2 + q*y + r = (y^2 + p/2 + m)^2 - 2m*(y - q/(4m))^2 for
+	// a root m of the resolvent cubic, which has a positive one unless q*q
+	// underflows
+	if q != 0 {
+		m := 0.0
+		for _, z := range cubicRoots(p, p*p/4-r, -q*q/8) {
+			if imag(z) == 0 && real(z) > m {
+				m = real(z)
+			}
+		}
+		if s := math.Sqrt(2 * m); s != 0 {
+			roots := append(quadraticRoots(s, p/2+m-q/(2*s)), quadraticRoots(-s, p/2+m+q/(2*s))...)
+			return shiftRoots(roots, shift)
+		}
+	}
+	// biquadratic, or as good as for the precision: a quadratic in y^2,
+	// whose roots the Newton polishing then corrects for q
+	var roots []complex128
+	for _, z := range quadraticRoots(p, r) {
+		y := cmplx.Sqrt(z)
+		roots = append(roots, y, -y)
+	}
+	return shiftRoots(roots, shift)
+}
+
+// shiftRoots subtracts shift from every root in place
+func shiftRoots(roots []complex128, shift float64) []complex128 {
+	for i := range roots {
+		roots[i] -= complex(shift, 0)
+	}
+	return roots
+}
+
+// evalPolynomial computes the polynomial a, highest degree first, at z
+func evalPolynomial(a []float64, z complex128) complex128 {
+	var v complex128
+	for _, c := range a {
+		v = v*z + complex(c, 0)
+	}
+	return v
+}
+
+// polishRoot takes steps of Newton's method from a closed-form root while
+// they reduce the residual
+func polishRoot(a []float64, z complex128) complex128 {
+	for i := 0; i < polishSteps; i++ {
+		var v, slope complex128
+		for _, c := range a {
+			slope = slope*z + v
+			v = v*z + complex(c, 0)
+		}
+		if v == 0 || slope == 0 {
+			break
+		}
+		next := z - v/slope
+		if cmplx.Abs(evalPolynomial(a, next)) >= cmplx.Abs(v) {
+			break
+		}
+		z = next
+	}
+	return z
+}
@@ -0,0 +1,171 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SolvePolynomial(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		equation string
+		values   map[string]float64
+		expected []complex128
+	}{
+		{"2x + 3", nil, []complex128{-1.5}},
+		{"x^2 - 5x + 6", nil, []complex128{2, 3}},
+		{"x^2 = 2", nil, []complex128{-math.Sqrt2, math.Sqrt2}},
+		{"x^2 + 1", nil, []complex128{-1i, 1i}},
+		{"(x - 1)^2", nil, []complex128{1, 1}},
+		{"a*x^2 - 1", map[string]float64{"a": 4}, []complex128{-0.5, 0.5}},
+		{"x^3 - 6x^2 + 11x - 6", nil, []complex128{1, 2, 3}},
+		{"x^3 - 1", nil, []complex128{complex(-0.5, -math.Sqrt(3)/2), complex(-0.5, math.Sqrt(3)/2), 1}},
+		{"(x - 1)^3", nil, []complex128{1, 1, 1}},
+		{"(x - 1)^2*(x + 2)", nil, []complex128{-2, 1, 1}},
+		{"x^4 - 5x^2 + 4", nil, []complex128{-2, -1, 1, 2}},
+		{"(x-1)*(x-2)*(x-3)*(x-4)", nil, []complex128{1, 2, 3, 4}},
+		{"x^4 + x^3 - 5x^2 + x - 6", nil, []complex128{-3, -1i, 1i, 2}},
+		{"x^4 + 1", nil, []complex128{
+			complex(-math.Sqrt2/2, -math.Sqrt2/2), complex(-math.Sqrt2/2, math.Sqrt2/2),
+			complex(math.Sqrt2/2, -math.Sqrt2/2), complex(math.Sqrt2/2, math.Sqrt2/2),
+		}},
+		{"x^4 + 1e-170*x - 1", nil, []complex128{-1, -1i, 1i, 1}},
+		{"x^5 - x^5 + x^2 - 4", nil, []complex128{-2, 2}},
+		{"x^2/2 + sin(pi/2)*x - 1.5", nil, []complex128{-3, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.equation, func(t *testing.T) {
+			res, err := calculatorSvc.Solve(context.Background(), &calculatorpb.SolveRequest{
+				Solver:   calculatorpb.SOLVER_SOLVER_POLYNOMIAL,
+				Equation: tt.equation,
+				Values:   tt.values,
+			})
+			assert.Nil(t, err)
+			assert.True(t, res.Convergence.Converged)
+			assert.InDelta(t, 0, res.Convergence.Residual, 1e-9)
+			assert.Len(t, res.Roots, len(tt.expected))
+			for i, z := range tt.expected {
+				if i < len(res.Roots) {
+					assert.InDelta(t, real(z), res.Roots[i].Real, 1e-6)
+					assert.InDelta(t, imag(z), res.Roots[i].Imag, 1e-6)
+				}
+			}
+		})
+	}
+}
+
+func Test_SolveNumerically(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name     string
+		request  *calculatorpb.SolveRequest
+		expected float64
+	}{
+		{"Bisection", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "x^2 - 2", Lower: 0, Upper: 2}, math.Sqrt2},
+		{"Brent", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "x^2 - 2", Lower: 0, Upper: 2}, math.Sqrt2},
+		{"Newton", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_NEWTON, Equation: "x^2 - 2", Initial: 1}, math.Sqrt2},
+		{"BrentTranscendental", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "cos(x) = x", Lower: 0, Upper: 1}, 0.7390851332151607},
+		{"NewtonTranscendental", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_NEWTON, Equation: "cos(x) = x", Initial: 1}, 0.7390851332151607},
+		{"BisectionRootAtBound", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "x - 3", Lower: 1, Upper: 3}, 3},
+		{"BisectionRootAtLower", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "x", Lower: 0, Upper: 1}, 0},
+		{"BisectionNegatedRootAtLower", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "-x", Lower: 0, Upper: 1}, 0},
+		{"BrentRootAtLower", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "x", Lower: 0, Upper: 1}, 0},
+		{"BrentRootAtUpper", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "x - 3", Lower: 1, Upper: 3}, 3},
+		{"OtherVariable", &calculatorpb.SolveRequest{
+			Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "exp(k*t) = 2", Variable: "t", Values: map[string]float64{"k": 0.05}, Lower: 0, Upper: 100,
+		}, math.Ln2 / 0.05},
+		{"LargeRoot", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "x - 1234567.891", Lower: 0, Upper: 1e7}, 1234567.891},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.Solve(context.Background(), tt.request)
+			assert.Nil(t, err)
+			assert.True(t, res.Convergence.Converged)
+			assert.Len(t, res.Roots, 1)
+			assert.InDelta(t, tt.expected, res.Roots[0].Real, 1e-9*(1+math.Abs(tt.expected)))
+			assert.LessOrEqual(t, res.Convergence.Error, 1e-9*(1+math.Abs(tt.expected)))
+			assert.InDelta(t, 0, res.Convergence.Residual, 1e-9)
+		})
+	}
+}
+
+func Test_SolveIterations(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	solve := func(solver calculatorpb.SOLVER, tolerance float64, maxIterations uint32) *calculatorpb.Convergence {
+		res, err := calculatorSvc.Solve(context.Background(), &calculatorpb.SolveRequest{
+			Solver: solver, Equation: "x^3 - 2x - 5", Lower: 2, Upper: 3, Initial: 2,
+			Tolerance: tolerance, MaxIterations: maxIterations,
+		})
+		assert.Nil(t, err)
+		return res.Convergence
+	}
+
+	bisection, brent, newton := solve(calculatorpb.SOLVER_SOLVER_BISECTION, 0, 0),
+		solve(calculatorpb.SOLVER_SOLVER_BRENT, 0, 0), solve(calculatorpb.SOLVER_SOLVER_NEWTON, 0, 0)
+	assert.Equal(t, "bisection", bisection.Method)
+	assert.Equal(t, "brent", brent.Method)
+	assert.Equal(t, "newton", newton.Method)
+	assert.Less(t, brent.Iterations, bisection.Iterations)
+	assert.Less(t, newton.Iterations, bisection.Iterations)
+
+	// a looser tolerance takes fewer iterations
+	loose := solve(calculatorpb.SOLVER_SOLVER_BISECTION, 1e-3, 0)
+	assert.True(t, loose.Converged)
+	assert.Less(t, loose.Iterations, bisection.Iterations)
+	assert.LessOrEqual(t, loose.Error, 1e-3)
+
+	// running out of iterations reports the estimate reached
+	limited := solve(calculatorpb.SOLVER_SOLVER_BISECTION, 0, 5)
+	assert.False(t, limited.Converged)
+	assert.Equal(t, uint32(5), limited.Iterations)
+	assert.Equal(t, 1.0/64, limited.Error)
+
+	// Newton's method stops where the derivative vanishes
+	res, err := calculatorSvc.Solve(context.Background(), &calculatorpb.SolveRequest{
+		Solver: calculatorpb.SOLVER_SOLVER_NEWTON, Equation: "x^2 + 1", Initial: 0,
+	})
+	assert.Nil(t, err)
+	assert.False(t, res.Convergence.Converged)
+}
+
+func Test_SolveErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.SolveRequest
+	}{
+		{"MissingSolver", &calculatorpb.SolveRequest{Equation: "x"}},
+		{"TwoEquals", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "x = 1 = 2"}},
+		{"NoVariable", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "y - 1", Values: map[string]float64{"y": 1}}},
+		{"MissingValue", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "a*x - 1"}},
+		{"ValueOfVariable", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "x - 1", Values: map[string]float64{"x": 1}}},
+		{"ConstantVariable", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "pi - 3", Variable: "pi"}},
+		{"NotAPolynomial", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "sin(x)"}},
+		{"DivisionByVariable", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "1/x - 2"}},
+		{"FractionalPower", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "x^0.5 - 2"}},
+		{"DegreeFive", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "x^5 - 1"}},
+		{"ConstantAfterCancelling", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_POLYNOMIAL, Equation: "x - x + 1"}},
+		{"NoSignChange", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BRENT, Equation: "x^2 + 1", Lower: -1, Upper: 1}},
+		{"EmptyBracket", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "x", Lower: 1, Upper: 1}},
+		{"UndefinedAtBound", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_BISECTION, Equation: "ln(x)", Lower: -1, Upper: 2}},
+		{"NegativeTolerance", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_NEWTON, Equation: "x", Tolerance: -1}},
+		{"TooManyIterations", &calculatorpb.SolveRequest{Solver: calculatorpb.SOLVER_SOLVER_NEWTON, Equation: "x", MaxIterations: 1000000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Solve(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
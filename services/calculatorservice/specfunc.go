@@ -0,0 +1,278 @@
+package calculatorservice
+
+import (
+	"math"
+)
+
+const (
+	specfuncEpsilon    = 1e-15
+	specfuncIterations = 500
+	maxGammaShape      = 1e8
+	// maxMixtureNoncentrality is the noncentrality above which the noncentral
+	// chi-square CDF is approximated rather than summed
+	maxMixtureNoncentrality = 2e4
+)
+
+// regIncBeta is the regularized incomplete beta function I_x(a, b)
+func regIncBeta(a, b, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+	lbeta := lgamma(a+b) - lgamma(a) - lgamma(b)
+	front := math.Exp(lbeta + a*math.Log(x) + b*math.Log1p(-x))
+	// the continued fraction converges quickly only on this side of the mean
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction of the incomplete beta
+// function with the modified Lentz method
+func betaContinuedFraction(a, b, x float64) float64 {
+	const tiny = 1e-300
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= specfuncIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+		// even step
+		aa := fm * (b - fm) * x / ((a + m2 - 1) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+		// odd step
+		aa = -(a + fm) * (a + b + fm) * x / ((a + m2) * (a + m2 + 1))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < specfuncEpsilon {
+			break
+		}
+	}
+	return h
+}
+
+// regIncGammaLower is the regularized lower incomplete gamma function P(a, x)
+func regIncGammaLower(a, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case math.IsInf(x, 1):
+		return 1
+	case x < a+1:
+		return gammaSeries(a, x)
+	}
+	return 1 - gammaContinuedFraction(a, x)
+}
+
+// regIncGammaUpper is the regularized upper incomplete gamma function Q(a, x)
+func regIncGammaUpper(a, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 1
+	case math.IsInf(x, 1):
+		return 0
+	case x < a+1:
+		return 1 - gammaSeries(a, x)
+	}
+	return gammaContinuedFraction(a, x)
+}
+
+// gammaIterations bounds the terms of the series and continued fraction, which
+// near x = a take a number proportional to the root of a, up to a shape of
+// maxGammaShape
+func gammaIterations(a float64) int {
+	return specfuncIterations + int(10*math.Sqrt(math.Min(a, maxGammaShape)))
+}
+
+func gammaSeries(a, x float64) float64 {
+	ap, sum := a, 1/a
+	del := sum
+	for n, iterations := 0, gammaIterations(a); n < iterations; n++ {
+		ap++
+		del *= x / ap
+		sum += del
+		if math.Abs(del) < math.Abs(sum)*specfuncEpsilon {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lgamma(a))
+}
+
+func gammaContinuedFraction(a, x float64) float64 {
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i, iterations := 1, gammaIterations(a); i <= iterations; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < specfuncEpsilon {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma(a)) * h
+}
+
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+func normalQuantile(p float64) float64 {
+	return -math.Sqrt2 * math.Erfcinv(2*p)
+}
+
+func studentTCDF(t, df float64) float64 {
+	if math.IsInf(t, 0) {
+		if t > 0 {
+			return 1
+		}
+		return 0
+	}
+	tail := 0.5 * regIncBeta(df/2, 0.5, df/(df+t*t))
+	if t > 0 {
+		return 1 - tail
+	}
+	return tail
+}
+
+func studentTQuantile(p, df float64) float64 {
+	return invertCDF(func(t float64) float64 { return studentTCDF(t, df) }, p, math.Inf(-1), math.Inf(1))
+}
+
+// chiSquareSurvival is the upper tail of the chi-square distribution, computed without cancellation for the small
+// p-values of strongly significant tests
+func chiSquareSurvival(x, k float64) float64 {
+	return regIncGammaUpper(k/2, x/2)
+}
+
+// noncentralChiSquareCDF is the CDF at x of the chi-square distribution with
+// k degrees of freedom and noncentrality lambda, a Poisson mixture of central
+// ones with k + 2j degrees of freedom. The sum runs out from the mode of the
+// Poisson weights, stepping the incomplete gamma function by its recurrence
+// P(a+1, x) = P(a, x) - x^a e^-x / Γ(a+1).
+func noncentralChiSquareCDF(x, k, lambda float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	h, y := lambda/2, x/2
+	switch {
+	case h == 0:
+		return regIncGammaLower(k/2, y)
+	case lambda > maxMixtureNoncentrality:
+		return sankaranCDF(x, k, lambda)
+	}
+	mode := math.Floor(h)
+	weight := math.Exp(mode*math.Log(h) - h - lgamma(mode+1))
+	p := regIncGammaLower(k/2+mode, y)
+	// the term of the density between P(a, x) and P(a+1, x)
+	step := func(a float64) float64 { return math.Exp(a*math.Log(y) - y - lgamma(a+1)) }
+
+	sum := weight * p
+	for j, w, pj := mode, weight, p; w > specfuncEpsilon*sum || j < h; {
+		pj -= step(k/2 + j)
+		j++
+		w *= h / j
+		sum += w * math.Max(0, pj)
+	}
+	for j, w, pj := mode, weight, p; j > 0 && w > specfuncEpsilon*sum; {
+		w *= j / h
+		j--
+		pj += step(k/2 + j)
+		sum += w * math.Min(1, pj)
+	}
+	return math.Min(1, sum)
+}
+
+// sankaranCDF is Sankaran's normal approximation to the noncentral chi-square
+// CDF, whose error shrinks as k + lambda grows
+func sankaranCDF(x, k, lambda float64) float64 {
+	h := 1 - 2.0/3*(k+lambda)*(k+3*lambda)/((k+2*lambda)*(k+2*lambda))
+	p := (k + 2*lambda) / ((k + lambda) * (k + lambda))
+	m := (h - 1) * (1 - 3*h)
+	z := (math.Pow(x/(k+lambda), h) - (1 + h*p*(h-1-(2-h)*m*p/2))) / (h * math.Sqrt(2*p) * (1 + m*p/2))
+	return normalCDF(z)
+}
+
+// invertCDF finds x with cdf(x) = p for a continuous, non decreasing cdf supported
+// on [lo, hi] by expanding a bracket and bisecting it
+func invertCDF(cdf func(float64) float64, p, lo, hi float64) float64 {
+	switch {
+	case p <= 0:
+		return lo
+	case p >= 1:
+		return hi
+	}
+	a, b := -1.0, 1.0
+	if !math.IsInf(lo, 0) {
+		a = lo
+	}
+	if !math.IsInf(hi, 0) {
+		b = hi
+	}
+	if b <= a {
+		b = a + 1
+	}
+	if math.IsInf(lo, 0) {
+		for cdf(a) > p && !math.IsInf(a, 0) {
+			a *= 2
+		}
+	}
+	if math.IsInf(hi, 0) {
+		for cdf(b) < p && !math.IsInf(b, 0) {
+			b *= 2
+		}
+	}
+	for i := 0; i < 200; i++ {
+		mid := a + (b-a)/2
+		if mid == a || mid == b {
+			break
+		}
+		if cdf(mid) < p {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return a + (b-a)/2
+}
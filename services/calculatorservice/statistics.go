@@ -0,0 +1,192 @@
+package calculatorservice
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	defaultStatisticsWindow      = 10
+	defaultStatisticsCompression = 100
+	maxStatisticsWindow          = 1 << 20
+)
+
+var defaultStatisticsQuantiles = []float64{0.5, 0.9, 0.99}
+
+// StreamStatistics consumes batches of values from recv until the client closes the
+// stream, sending a snapshot of the running statistics whenever one is asked for and
+// a final one once the stream has ended.
+func (c *Calculator) StreamStatistics(ctx context.Context, recv func() (*calculatorpb.StatisticsStreamRequest, error), send func(*calculatorpb.StatisticsSnapshot) error) error {
+	var stats *runningStats
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		req, err := recv()
+		if err == io.EOF {
+			if stats == nil {
+				stats, _ = newRunningStats(nil)
+			}
+			return send(stats.snapshot())
+		}
+		if err != nil {
+			return err
+		}
+
+		if stats == nil {
+			stats, err = newRunningStats(req.Options)
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range req.Values {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return invalidArgumentf("value %v is not a finite number", v)
+			}
+			stats.add(v)
+		}
+		if req.Snapshot {
+			if err := send(stats.snapshot()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// moments keeps a running mean and variance using Welford's algorithm
+type moments struct {
+	n    float64
+	mean float64
+	m2   float64
+}
+
+func (m *moments) add(x float64) {
+	m.n++
+	delta := x - m.mean
+	m.mean += delta / m.n
+	m.m2 += delta * (x - m.mean)
+}
+
+// variance is the unbiased sample variance
+func (m *moments) variance() float64 {
+	if m.n < 2 {
+		return 0
+	}
+	return m.m2 / (m.n - 1)
+}
+
+type runningStats struct {
+	moments
+	min       float64
+	max       float64
+	alpha     float64
+	ema       float64
+	window    []float64
+	windowSum float64
+	next      int
+	digest    *TDigest
+	quantiles []float64
+}
+
+func newRunningStats(opts *calculatorpb.StatisticsOptions) (*runningStats, error) {
+	if opts == nil {
+		opts = &calculatorpb.StatisticsOptions{}
+	}
+	window := int(opts.Window)
+	if window == 0 {
+		window = defaultStatisticsWindow
+	}
+	if window > maxStatisticsWindow {
+		return nil, invalidArgumentf("window %d is larger than the maximum of %d", window, maxStatisticsWindow)
+	}
+	alpha := opts.Alpha
+	if alpha == 0 {
+		alpha = 2 / float64(window+1)
+	}
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, invalidArgumentf("alpha %v must be within (0, 1]", alpha)
+	}
+	compression := opts.Compression
+	if compression == 0 {
+		compression = defaultStatisticsCompression
+	}
+	if !(compression >= 10 && compression <= 10000) {
+		return nil, invalidArgumentf("compression %v must be within [10, 10000]", compression)
+	}
+	quantiles := opts.Quantiles
+	if len(quantiles) == 0 {
+		quantiles = defaultStatisticsQuantiles
+	}
+	for _, q := range quantiles {
+		if !(q >= 0 && q <= 1) {
+			return nil, invalidArgumentf("quantile %v must be within [0, 1]", q)
+		}
+	}
+
+	return &runningStats{
+		min:       math.Inf(1),
+		max:       math.Inf(-1),
+		alpha:     alpha,
+		window:    make([]float64, 0, window),
+		digest:    NewTDigest(compression),
+		quantiles: quantiles,
+	}, nil
+}
+
+func (s *runningStats) add(x float64) {
+	s.moments.add(x)
+	s.min = math.Min(s.min, x)
+	s.max = math.Max(s.max, x)
+	s.digest.Add(x)
+
+	if s.n == 1 {
+		s.ema = x
+	} else {
+		s.ema += s.alpha * (x - s.ema)
+	}
+
+	if len(s.window) < cap(s.window) {
+		s.window = append(s.window, x)
+	} else {
+		s.windowSum -= s.window[s.next]
+		s.window[s.next] = x
+		s.next = (s.next + 1) % len(s.window)
+	}
+	s.windowSum += x
+
+	// re-sum once per lap so rounding errors don't build up over long streams
+	if s.next == 0 && len(s.window) == cap(s.window) {
+		s.windowSum = 0
+		for _, v := range s.window {
+			s.windowSum += v
+		}
+	}
+}
+
+func (s *runningStats) snapshot() *calculatorpb.StatisticsSnapshot {
+	snapshot := &calculatorpb.StatisticsSnapshot{
+		Count: uint64(s.n),
+	}
+	if s.n == 0 {
+		return snapshot
+	}
+
+	variance := s.variance()
+	snapshot.Mean = s.mean
+	snapshot.Variance = variance
+	snapshot.Stddev = math.Sqrt(variance)
+	snapshot.Min = s.min
+	snapshot.Max = s.max
+	snapshot.Ema = s.ema
+	snapshot.Sma = s.windowSum / float64(len(s.window))
+	for _, q := range s.quantiles {
+		snapshot.Quantiles = append(snapshot.Quantiles, &calculatorpb.QuantileValue{
+			Quantile: q,
+			Value:    s.digest.Quantile(q),
+		})
+	}
+	return snapshot
+}
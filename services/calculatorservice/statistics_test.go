@@ -0,0 +1,136 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StreamStatistics(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name          string
+		requests      []*calculatorpb.StatisticsStreamRequest
+		expectedSnaps int
+		expectedLast  *calculatorpb.StatisticsSnapshot
+	}{
+		{
+			name: "FinalSnapshot",
+			requests: []*calculatorpb.StatisticsStreamRequest{
+				{Options: &calculatorpb.StatisticsOptions{Window: 2, Alpha: 0.5, Quantiles: []float64{0.5}}, Values: []float64{2, 4}},
+				{Values: []float64{4, 4, 5, 5, 7, 9}},
+			},
+			expectedSnaps: 1,
+			expectedLast: &calculatorpb.StatisticsSnapshot{
+				Count:     8,
+				Mean:      5,
+				Variance:  32.0 / 7,
+				Stddev:    math.Sqrt(32.0 / 7),
+				Min:       2,
+				Max:       9,
+				Ema:       7.421875,
+				Sma:       8,
+				Quantiles: []*calculatorpb.QuantileValue{{Quantile: 0.5, Value: 4.5}},
+			},
+		},
+		{
+			name: "SnapshotOnDemand",
+			requests: []*calculatorpb.StatisticsStreamRequest{
+				{Values: []float64{1, 2, 3}, Snapshot: true},
+				{Values: []float64{4}, Snapshot: true},
+			},
+			expectedSnaps: 3,
+		},
+		{
+			name:          "EmptyStream",
+			expectedSnaps: 1,
+			expectedLast:  &calculatorpb.StatisticsSnapshot{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var snaps []*calculatorpb.StatisticsSnapshot
+			err := calculatorSvc.StreamStatistics(context.Background(), recvAll(tt.requests), func(s *calculatorpb.StatisticsSnapshot) error {
+				snaps = append(snaps, s)
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.Len(t, snaps, tt.expectedSnaps)
+			if tt.expectedLast != nil {
+				last := snaps[len(snaps)-1]
+				assert.Equal(t, tt.expectedLast.Count, last.Count)
+				assert.InDelta(t, tt.expectedLast.Mean, last.Mean, 1e-9)
+				assert.InDelta(t, tt.expectedLast.Variance, last.Variance, 1e-9)
+				assert.InDelta(t, tt.expectedLast.Stddev, last.Stddev, 1e-9)
+				assert.Equal(t, tt.expectedLast.Min, last.Min)
+				assert.Equal(t, tt.expectedLast.Max, last.Max)
+				assert.InDelta(t, tt.expectedLast.Ema, last.Ema, 1e-9)
+				assert.InDelta(t, tt.expectedLast.Sma, last.Sma, 1e-9)
+				assert.Equal(t, len(tt.expectedLast.Quantiles), len(last.Quantiles))
+				for i, q := range tt.expectedLast.Quantiles {
+					assert.InDelta(t, q.Value, last.Quantiles[i].Value, 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func Test_StreamStatisticsInvalidOptions(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		options *calculatorpb.StatisticsOptions
+	}{
+		{name: "AlphaAboveOne", options: &calculatorpb.StatisticsOptions{Alpha: 1.5}},
+		{name: "QuantileOutOfRange", options: &calculatorpb.StatisticsOptions{Quantiles: []float64{2}}},
+		{name: "CompressionTooSmall", options: &calculatorpb.StatisticsOptions{Compression: 1}},
+		{name: "AlphaNaN", options: &calculatorpb.StatisticsOptions{Alpha: math.NaN()}},
+		{name: "QuantileNaN", options: &calculatorpb.StatisticsOptions{Quantiles: []float64{0.5, math.NaN()}}},
+		{name: "CompressionNaN", options: &calculatorpb.StatisticsOptions{Compression: math.NaN()}},
+		{name: "CompressionInfinite", options: &calculatorpb.StatisticsOptions{Compression: math.Inf(1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := []*calculatorpb.StatisticsStreamRequest{{Options: tt.options, Values: []float64{1}}}
+			err := calculatorSvc.StreamStatistics(context.Background(), recvAll(requests), func(*calculatorpb.StatisticsSnapshot) error { return nil })
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
+
+func Test_TDigest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	left, right := calculatorservice.NewTDigest(100), calculatorservice.NewTDigest(100)
+	for i := 0; i < 100000; i++ {
+		left.Add(rng.Float64())
+		right.Add(rng.Float64())
+	}
+	left.Merge(right)
+
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+		assert.InDelta(t, q, left.Quantile(q), 0.01)
+	}
+}
+
+// recvAll returns a recv function that replays requests and then reports the end of the stream
+func recvAll(requests []*calculatorpb.StatisticsStreamRequest) func() (*calculatorpb.StatisticsStreamRequest, error) {
+	return func() (*calculatorpb.StatisticsStreamRequest, error) {
+		if len(requests) == 0 {
+			return nil, io.EOF
+		}
+		req := requests[0]
+		requests = requests[1:]
+		return req, nil
+	}
+}
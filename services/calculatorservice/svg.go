@@ -0,0 +1,175 @@
+package calculatorservice
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	plotMarginLeft   = 64
+	plotMarginRight  = 16
+	plotMarginTop    = 16
+	plotMarginBottom = 36
+	plotTitleHeight  = 24
+	// plotTickSpacing is the least pixels between the ticks of an axis
+	plotTickSpacing = 80
+	// plotLegendCharWidth is the pixels of a character of a legend label,
+	// roughly, as the chart has no font metrics
+	plotLegendCharWidth = 7
+)
+
+// plotColors are the colours of the series in turn
+var plotColors = []string{
+	"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f",
+	"#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac",
+}
+
+// plotArea maps the coordinates of a plot to the pixels of a chart
+type plotArea struct {
+	left, top, width, height float64
+	xLower, xUpper           float64
+	yLower, yUpper           float64
+}
+
+func (a *plotArea) x(x float64) float64 {
+	return a.left + (x-a.xLower)/(a.xUpper-a.xLower)*a.width
+}
+
+// y is clamped not far off the chart, the clip path hides the rest, so that
+// the points next to a pole don't need huge coordinates
+func (a *plotArea) y(y float64) float64 {
+	py := a.top + (a.yUpper-y)/(a.yUpper-a.yLower)*a.height
+	return math.Max(a.top-10*a.height, math.Min(a.top+11*a.height, py))
+}
+
+// renderPlot draws the series as a self-contained SVG chart with a grid at
+// round numbers and a legend. The same series always give the same chart.
+func renderPlot(title string, width, height int, xLower, xUpper, yLower, yUpper float64, series []*calculatorpb.PlotData) string {
+	top := plotMarginTop
+	if title != "" {
+		top += plotTitleHeight
+	}
+	a := &plotArea{
+		left: plotMarginLeft, top: float64(top),
+		width:  float64(width - plotMarginLeft - plotMarginRight),
+		height: float64(height - top - plotMarginBottom),
+		xLower: xLower, xUpper: xUpper, yLower: yLower, yUpper: yUpper,
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n", width, height, width, height)
+	b.WriteString(`<rect width="100%" height="100%" fill="white"/>` + "\n")
+	if title != "" {
+		fmt.Fprintf(&b, `<text x="%s" y="%d" text-anchor="middle" font-size="16">%s</text>`+"\n", svgNumber(float64(width)/2), plotMarginTop+14, html.EscapeString(title))
+	}
+	fmt.Fprintf(&b, `<clipPath id="plot-area"><rect x="%s" y="%s" width="%s" height="%s"/></clipPath>`+"\n", svgNumber(a.left), svgNumber(a.top), svgNumber(a.width), svgNumber(a.height))
+
+	// the grid, its labels and the axes through 0
+	ticks, step := plotTicks(xLower, xUpper, int(a.width/plotTickSpacing))
+	for _, t := range ticks {
+		x := svgNumber(a.x(t))
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="#e0e0e0"/>`+"\n", x, svgNumber(a.top), x, svgNumber(a.top+a.height))
+		fmt.Fprintf(&b, `<text x="%s" y="%s" text-anchor="middle">%s</text>`+"\n", x, svgNumber(a.top+a.height+16), tickLabel(t, step))
+	}
+	ticks, step = plotTicks(yLower, yUpper, int(a.height/plotTickSpacing))
+	for _, t := range ticks {
+		y := svgNumber(a.y(t))
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="#e0e0e0"/>`+"\n", svgNumber(a.left), y, svgNumber(a.left+a.width), y)
+		fmt.Fprintf(&b, `<text x="%s" y="%s" text-anchor="end">%s</text>`+"\n", svgNumber(a.left-6), svgNumber(a.y(t)+4), tickLabel(t, step))
+	}
+	if xLower <= 0 && 0 <= xUpper {
+		x := svgNumber(a.x(0))
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="#808080"/>`+"\n", x, svgNumber(a.top), x, svgNumber(a.top+a.height))
+	}
+	if yLower <= 0 && 0 <= yUpper {
+		y := svgNumber(a.y(0))
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="#808080"/>`+"\n", svgNumber(a.left), y, svgNumber(a.left+a.width), y)
+	}
+	fmt.Fprintf(&b, `<rect x="%s" y="%s" width="%s" height="%s" fill="none" stroke="#404040"/>`+"\n", svgNumber(a.left), svgNumber(a.top), svgNumber(a.width), svgNumber(a.height))
+
+	// a segment of a single point is drawn as a dot by its round cap
+	b.WriteString(`<g clip-path="url(#plot-area)" fill="none" stroke-width="1.5" stroke-linejoin="round" stroke-linecap="round">` + "\n")
+	for i, s := range series {
+		var d strings.Builder
+		for _, segment := range s.Segments {
+			for j, p := range segment.Points {
+				command := "L"
+				if j == 0 {
+					command = "M"
+				}
+				fmt.Fprintf(&d, "%s%s %s ", command, svgNumber(a.x(p.X)), svgNumber(a.y(p.Y)))
+			}
+			if len(segment.Points) == 1 {
+				d.WriteString("h0 ")
+			}
+		}
+		fmt.Fprintf(&b, `<path stroke="%s" d="%s"/>`+"\n", plotColors[i%len(plotColors)], strings.TrimSpace(d.String()))
+	}
+	b.WriteString("</g>\n")
+
+	// the legend in the top right corner of the plot
+	longest := 0
+	for _, s := range series {
+		if n := len([]rune(s.Label)); n > longest {
+			longest = n
+		}
+	}
+	legendWidth := float64(36 + longest*plotLegendCharWidth)
+	legendLeft := a.left + a.width - 8 - legendWidth
+	fmt.Fprintf(&b, `<rect x="%s" y="%s" width="%s" height="%s" fill="white" fill-opacity="0.8" stroke="#c0c0c0"/>`+"\n",
+		svgNumber(legendLeft), svgNumber(a.top+8), svgNumber(legendWidth), svgNumber(float64(8+18*len(series))))
+	for i, s := range series {
+		y := a.top + 24 + float64(18*i)
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="2"/>`+"\n",
+			svgNumber(legendLeft+6), svgNumber(y-4), svgNumber(legendLeft+26), svgNumber(y-4), plotColors[i%len(plotColors)])
+		fmt.Fprintf(&b, `<text x="%s" y="%s">%s</text>`+"\n", svgNumber(legendLeft+30), svgNumber(y), html.EscapeString(s.Label))
+	}
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// plotTicks are the multiples in [lower, upper] of a step of 1, 2 or 5 times
+// a power of 10, of which there are at most about n
+func plotTicks(lower, upper float64, n int) ([]float64, float64) {
+	if n < 2 {
+		n = 2
+	}
+	raw := (upper - lower) / float64(n)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	var step float64
+	for _, m := range []float64{1, 2, 5, 10} {
+		step = m * magnitude
+		if step >= raw {
+			break
+		}
+	}
+	var ticks []float64
+	for i := math.Ceil(lower / step); i*step <= upper && len(ticks) <= n; i++ {
+		ticks = append(ticks, i*step)
+	}
+	return ticks, step
+}
+
+// tickLabel formats a tick with as many decimals as its step needs
+func tickLabel(v, step float64) string {
+	if math.Abs(v) < step/2 {
+		v = 0
+	}
+	if math.Abs(v) >= 1e6 || step < 1e-4 {
+		return strconv.FormatFloat(v, 'g', 6, 64)
+	}
+	decimals := 0
+	if step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step) - 1e-9))
+	}
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
+func svgNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
@@ -0,0 +1,452 @@
+package calculatorservice
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+)
+
+const (
+	maxDerivativeOrder = 10
+	// maxExpressionSize bounds the number of nodes of a result, as every
+	// order of a derivative can multiply it
+	maxExpressionSize = 20000
+)
+
+// Symbolic differentiates, simplifies and substitutes values into expressions
+func (c *Calculator) Symbolic(ctx context.Context, req *calculatorpb.SymbolicRequest) (*calculatorpb.SymbolicResponse, error) {
+	e, err := parseExpression(req.Expression)
+	if err != nil {
+		return nil, err
+	}
+	for name := range req.Values {
+		if _, ok := exprConstants[name]; ok {
+			return nil, invalidArgumentf("%s is a constant and can not be given a value", name)
+		}
+	}
+
+	switch req.Operation {
+	case calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE:
+		variable := req.Variable
+		if variable == "" {
+			variable = "x"
+		}
+		if _, ok := exprConstants[variable]; ok {
+			return nil, invalidArgumentf("can not differentiate with respect to the constant %s", variable)
+		}
+		order := req.Order
+		if order == 0 {
+			order = 1
+		}
+		if order > maxDerivativeOrder {
+			return nil, invalidArgumentf("order %d is above the maximum of %d", order, maxDerivativeOrder)
+		}
+		for i := uint32(0); i < order; i++ {
+			e = simplify(differentiate(e, variable))
+			if exprSize(e) > maxExpressionSize {
+				return nil, invalidArgumentf("derivative %d has more than %d terms", i+1, maxExpressionSize)
+			}
+		}
+	case calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY:
+		e = simplify(e)
+	case calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE:
+		if len(req.Values) == 0 {
+			return nil, invalidArgumentf("values are not supplied")
+		}
+	default:
+		return nil, invalidArgumentf("symbolic operation is not supplied")
+	}
+	if len(req.Values) > 0 {
+		e = simplify(substitute(e, req.Values))
+	}
+
+	res := &calculatorpb.SymbolicResponse{Expression: e.String()}
+	vars := map[string]bool{}
+	exprVariables(e, vars)
+	for name := range vars {
+		res.Variables = append(res.Variables, name)
+	}
+	sort.Strings(res.Variables)
+	if len(vars) == 0 {
+		res.Constant = true
+		res.Value, _ = evalExpr(e, nil)
+	}
+	return res, nil
+}
+
+// differentiate is the derivative of e with respect to x, before simplification
+func differentiate(e expr, x string) expr {
+	switch e := e.(type) {
+	case *numberNode:
+		return &numberNode{0}
+	case *variableNode:
+		if e.name == x {
+			return &numberNode{1}
+		}
+		return &numberNode{0}
+	case *negNode:
+		return &negNode{differentiate(e.x, x)}
+	case *callNode:
+		// chain rule
+		return &binaryNode{'*', exprFunctions[e.fn].derivative(e.arg), differentiate(e.arg, x)}
+	case *binaryNode:
+		u, v := e.left, e.right
+		du, dv := differentiate(u, x), differentiate(v, x)
+		switch e.op {
+		case '+', '-':
+			return &binaryNode{e.op, du, dv}
+		case '*':
+			return &binaryNode{'+', &binaryNode{'*', du, v}, &binaryNode{'*', u, dv}}
+		case '/':
+			return &binaryNode{'/',
+				&binaryNode{'-', &binaryNode{'*', du, v}, &binaryNode{'*', u, dv}},
+				&binaryNode{'^', v, &numberNode{2}}}
+		default:
+			switch {
+			case !dependsOn(v, x):
+				// power rule
+				return &binaryNode{'*', &binaryNode{'*', v, &binaryNode{'^', u, &binaryNode{'-', v, &numberNode{1}}}}, du}
+			case !dependsOn(u, x):
+				return &binaryNode{'*', &binaryNode{'*', e, &callNode{"ln", u}}, dv}
+			default:
+				// d(u^v) = u^v (v' ln u + v u'/u)
+				return &binaryNode{'*', e, &binaryNode{'+',
+					&binaryNode{'*', dv, &callNode{"ln", u}},
+					&binaryNode{'/', &binaryNode{'*', v, du}, u}}}
+			}
+		}
+	}
+	return e
+}
+
+func dependsOn(e expr, x string) bool {
+	switch e := e.(type) {
+	case *variableNode:
+		return e.name == x
+	case *negNode:
+		return dependsOn(e.x, x)
+	case *callNode:
+		return dependsOn(e.arg, x)
+	case *binaryNode:
+		return dependsOn(e.left, x) || dependsOn(e.right, x)
+	}
+	return false
+}
+
+// substitute replaces the variables that have values with numbers
+func substitute(e expr, values map[string]float64) expr {
+	switch e := e.(type) {
+	case *variableNode:
+		if v, ok := values[e.name]; ok {
+			return &numberNode{v}
+		}
+	case *negNode:
+		return &negNode{substitute(e.x, values)}
+	case *callNode:
+		return &callNode{e.fn, substitute(e.arg, values)}
+	case *binaryNode:
+		return &binaryNode{e.op, substitute(e.left, values), substitute(e.right, values)}
+	}
+	return e
+}
+
+func exprSize(e expr) int {
+	switch e := e.(type) {
+	case *negNode:
+		return 1 + exprSize(e.x)
+	case *callNode:
+		return 1 + exprSize(e.arg)
+	case *binaryNode:
+		return 1 + exprSize(e.left) + exprSize(e.right)
+	}
+	return 1
+}
+
+// simplify folds constants, drops identities and collects like terms of sums
+// and like factors of products. Functions are only folded when their value is
+// a whole number, so that ln(2) stays exact but ln(1) is 0.
+func simplify(e expr) expr {
+	switch e := e.(type) {
+	case *negNode:
+		return collectSum([]expr{simplify(e.x)}, []float64{-1})
+	case *callNode:
+		arg := simplify(e.arg)
+		if v, ok := arg.(*variableNode); ok && v.name == "e" && e.fn == "ln" {
+			return &numberNode{1}
+		}
+		if n, ok := arg.(*numberNode); ok {
+			if v := exprFunctions[e.fn].eval(n.value); v == math.Trunc(v) && !math.IsInf(v, 0) {
+				return &numberNode{v}
+			}
+		}
+		return &callNode{e.fn, arg}
+	case *binaryNode:
+		l, r := simplify(e.left), simplify(e.right)
+		switch e.op {
+		case '+':
+			return collectSum([]expr{l, r}, []float64{1, 1})
+		case '-':
+			return collectSum([]expr{l, r}, []float64{1, -1})
+		case '*', '/':
+			p := productForm{coeff: 1}
+			p.add(l, false)
+			p.add(r, e.op == '/')
+			return p.build()
+		default:
+			power := simplifyPower(l, r)
+			if b, ok := power.(*binaryNode); ok && b.op == '^' {
+				// for x^-1 to become 1/x
+				p := productForm{coeff: 1}
+				p.add(power, false)
+				return p.build()
+			}
+			return power
+		}
+	}
+	return e
+}
+
+func simplifyPower(base, exponent expr) expr {
+	b, baseIsNumber := base.(*numberNode)
+	n, exponentIsNumber := exponent.(*numberNode)
+	if baseIsNumber && exponentIsNumber {
+		if v := math.Pow(b.value, n.value); !math.IsInf(v, 0) && !math.IsNaN(v) {
+			return &numberNode{v}
+		}
+	}
+	switch {
+	case exponentIsNumber && n.value == 0, baseIsNumber && b.value == 1:
+		return &numberNode{1}
+	case exponentIsNumber && n.value == 1:
+		return base
+	case baseIsNumber && b.value == 0 && exponentIsNumber && n.value > 0:
+		return &numberNode{0}
+	}
+	// (u^a)^b is u^(a b) for a whole b
+	if inner, ok := base.(*binaryNode); ok && inner.op == '^' && exponentIsNumber && n.value == math.Trunc(n.value) {
+		if a, ok := inner.right.(*numberNode); ok {
+			return simplifyPower(inner.left, &numberNode{a.value * n.value})
+		}
+	}
+	return &binaryNode{'^', base, exponent}
+}
+
+// collectSum adds up terms with the given signs, collecting the constants and
+// the terms that only differ in their coefficient
+func collectSum(terms []expr, signs []float64) expr {
+	var constant float64
+	var keys []string
+	collected := map[string]*productForm{}
+	var add func(e expr, sign float64)
+	add = func(e expr, sign float64) {
+		switch t := e.(type) {
+		case *numberNode:
+			constant += sign * t.value
+			return
+		case *negNode:
+			add(t.x, -sign)
+			return
+		case *binaryNode:
+			if t.op == '+' || t.op == '-' {
+				add(t.left, sign)
+				if t.op == '-' {
+					sign = -sign
+				}
+				add(t.right, sign)
+				return
+			}
+		}
+		p := productForm{coeff: 1}
+		p.add(e, false)
+		coeff := sign * p.coeff
+		p.coeff = 1
+		key := p.build().String()
+		if c, ok := collected[key]; ok {
+			c.coeff += coeff
+			return
+		}
+		p.coeff = coeff
+		collected[key] = &p
+		keys = append(keys, key)
+	}
+	for i, t := range terms {
+		add(t, signs[i])
+	}
+
+	var sum expr
+	appendTerm := func(t expr, negative bool) {
+		switch {
+		case sum == nil && negative:
+			sum = &negNode{t}
+			if n, ok := t.(*numberNode); ok {
+				sum = &numberNode{-n.value}
+			}
+		case sum == nil:
+			sum = t
+		case negative:
+			sum = &binaryNode{'-', sum, t}
+		default:
+			sum = &binaryNode{'+', sum, t}
+		}
+	}
+	for _, key := range keys {
+		p := collected[key]
+		if p.coeff == 0 {
+			continue
+		}
+		if sum == nil {
+			// the first term carries its own sign
+			sum = p.build()
+			continue
+		}
+		negative := p.coeff < 0
+		p.coeff = math.Abs(p.coeff)
+		appendTerm(p.build(), negative)
+	}
+	if constant != 0 || sum == nil {
+		appendTerm(&numberNode{math.Abs(constant)}, constant < 0)
+	}
+	return sum
+}
+
+// productForm is a coefficient times factors raised to exponents
+type productForm struct {
+	coeff   float64
+	keys    []string
+	factors map[string]*factor
+}
+
+type factor struct {
+	base     expr
+	exponent expr
+}
+
+// add multiplies the product by e, or divides it by e when invert is set
+func (p *productForm) add(e expr, invert bool) {
+	switch t := e.(type) {
+	case *numberNode:
+		switch {
+		case !invert:
+			p.coeff *= t.value
+			return
+		case t.value != 0:
+			p.coeff /= t.value
+			return
+		}
+	case *negNode:
+		p.coeff = -p.coeff
+		p.add(t.x, invert)
+		return
+	case *binaryNode:
+		switch t.op {
+		case '*':
+			p.add(t.left, invert)
+			p.add(t.right, invert)
+			return
+		case '/':
+			p.add(t.left, invert)
+			p.add(t.right, !invert)
+			return
+		case '^':
+			p.multiply(t.left, t.right, invert)
+			return
+		}
+	}
+	p.multiply(e, &numberNode{1}, invert)
+}
+
+func (p *productForm) multiply(base, exponent expr, invert bool) {
+	if invert {
+		exponent = simplify(&negNode{exponent})
+	}
+	if p.factors == nil {
+		p.factors = map[string]*factor{}
+	}
+	key := base.String()
+	if f, ok := p.factors[key]; ok {
+		f.exponent = simplify(&binaryNode{'+', f.exponent, exponent})
+		return
+	}
+	p.factors[key] = &factor{base, exponent}
+	p.keys = append(p.keys, key)
+}
+
+// build writes the product as coefficient * numerator / denominator, with
+// variables before function calls before anything else
+func (p *productForm) build() expr {
+	if p.coeff == 0 {
+		return &numberNode{0}
+	}
+	keys := append([]string{}, p.keys...)
+	rank := func(key string) int {
+		switch p.factors[key].base.(type) {
+		case *variableNode:
+			return 0
+		case *callNode:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		if rank(keys[i]) != rank(keys[j]) {
+			return rank(keys[i]) < rank(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	var numerator, denominator expr
+	chain := func(product expr, e expr) expr {
+		if product == nil {
+			return e
+		}
+		return &binaryNode{'*', product, e}
+	}
+	for _, key := range keys {
+		f := p.factors[key]
+		exponent, inverted := f.exponent, false
+		switch x := exponent.(type) {
+		case *numberNode:
+			if x.value == 0 {
+				continue
+			}
+			if x.value < 0 {
+				exponent, inverted = &numberNode{-x.value}, true
+			}
+		case *negNode:
+			exponent, inverted = x.x, true
+		}
+		power := simplifyPower(f.base, exponent)
+		if inverted {
+			denominator = chain(denominator, power)
+		} else {
+			numerator = chain(numerator, power)
+		}
+	}
+
+	magnitude := math.Abs(p.coeff)
+	var product expr
+	switch {
+	case numerator == nil:
+		product = &numberNode{p.coeff}
+	case magnitude == 1 && p.coeff < 0:
+		// -x*y rather than -(x*y), which is the same
+		product = negateFirstFactor(numerator)
+	case magnitude == 1:
+		product = numerator
+	default:
+		product = &binaryNode{'*', &numberNode{p.coeff}, numerator}
+	}
+	if denominator != nil {
+		product = &binaryNode{'/', product, denominator}
+	}
+	return product
+}
+
+func negateFirstFactor(e expr) expr {
+	if b, ok := e.(*binaryNode); ok && b.op == '*' {
+		return &binaryNode{'*', negateFirstFactor(b.left), b.right}
+	}
+	return &negNode{e}
+}
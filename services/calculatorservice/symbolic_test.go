@@ -0,0 +1,197 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Differentiate(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		expression string
+		variable   string
+		order      uint32
+		expected   string
+	}{
+		{"x^3 + 2x", "", 0, "3*x^2 + 2"},
+		{"x^3 + 2x", "", 2, "6*x"},
+		{"x^3 + 2x", "", 4, "0"},
+		{"5", "", 0, "0"},
+		{"sin(x^2)", "", 0, "2*x*cos(x^2)"},
+		{"x*sin(x)", "", 0, "sin(x) + x*cos(x)"},
+		{"sin(x)*cos(x)", "", 0, "cos(x)^2 - sin(x)^2"},
+		{"x/(1+x)", "", 0, "1/(x + 1)^2"},
+		{"1/x", "", 0, "-1/x^2"},
+		{"ln(x)", "", 0, "1/x"},
+		{"log(x)", "", 0, "1/(x*ln(10))"},
+		{"exp(2x)", "", 0, "2*exp(2*x)"},
+		{"e^x", "", 0, "e^x"},
+		{"2^x", "", 0, "ln(2)*2^x"},
+		{"x^x", "", 0, "x^x*(ln(x) + 1)"},
+		{"sqrt(x)", "", 0, "0.5/sqrt(x)"},
+		{"tan(x)", "", 0, "1/cos(x)^2"},
+		{"atan(x)", "", 0, "1/(x^2 + 1)"},
+		{"-cos(x)", "", 0, "sin(x)"},
+		{"(x+1)^3", "", 0, "3*(x + 1)^2"},
+		{"x^2*y^3", "y", 0, "3*x^2*y^2"},
+		{"x^2*y^3", "x", 0, "2*x*y^3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression+"/"+tt.variable, func(t *testing.T) {
+			res, err := calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+				Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE,
+				Expression: tt.expression,
+				Variable:   tt.variable,
+				Order:      tt.order,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, res.Expression)
+		})
+	}
+}
+
+func Test_DifferentiateAgreesWithDifferenceQuotient(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	value := func(expression string, x float64) float64 {
+		res, err := calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+			Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE,
+			Expression: expression,
+			Values:     map[string]float64{"x": x},
+		})
+		assert.Nil(t, err)
+		return res.Value
+	}
+	for _, expression := range []string{
+		"asin(x/2)", "acos(x/2)", "sinh(x)*cosh(x)", "tanh(x^2)", "abs(x - 3)", "x^(1/3)", "(x^2 + 1)/(x - 4)", "x^sin(x)",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			res, err := calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+				Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE,
+				Expression: expression,
+				Values:     map[string]float64{"x": 1.3},
+			})
+			assert.Nil(t, err)
+			assert.True(t, res.Constant)
+			const h = 1e-6
+			expected := (value(expression, 1.3+h) - value(expression, 1.3-h)) / (2 * h)
+			assert.InDelta(t, expected, res.Value, 1e-6*(1+math.Abs(expected)))
+		})
+	}
+}
+
+func Test_Simplify(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"x + x", "2*x"},
+		{"2x + 3x - x", "4*x"},
+		{"x*x", "x^2"},
+		{"x/x", "1"},
+		{"0*x + 1*y", "y"},
+		{"x - x", "0"},
+		{"-(-x)", "x"},
+		{"2*3*x", "6*x"},
+		{"x^2*x^-1", "x"},
+		{"(x^2)^3", "x^6"},
+		{"x^-1", "1/x"},
+		{"3 - 5", "-2"},
+		{"x*y + y*x", "2*x*y"},
+		{"-x*y", "-x*y"},
+		{"a - (b - c)", "a - b + c"},
+		{"2*(x+1) + 3*(x+1)", "5*(x + 1)"},
+		{"sin(0) + cos(0) + ln(e)", "2"},
+		{"ln(2)", "ln(2)"},
+		{"x^0", "1"},
+		{"-(x+1)", "-x - 1"},
+		{"x - -2", "x + 2"},
+		{"-2^2", "-4"},
+		{"(-2)^2", "4"},
+		{"x/y/z", "x/(y*z)"},
+		{"2e3x", "2000*x"},
+		{"2pi*r", "2*pi*r"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			res, err := calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+				Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY,
+				Expression: tt.expression,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, res.Expression)
+		})
+	}
+}
+
+func Test_Substitute(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+		Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE,
+		Expression: "x^2*y + z",
+		Values:     map[string]float64{"x": 3},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "9*y + z", res.Expression)
+	assert.False(t, res.Constant)
+	assert.Equal(t, []string{"y", "z"}, res.Variables)
+
+	res, err = calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+		Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE,
+		Expression: "2pi*r",
+		Values:     map[string]float64{"r": 2},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "4*pi", res.Expression)
+	assert.True(t, res.Constant)
+	assert.InDelta(t, 4*math.Pi, res.Value, 1e-12)
+
+	// the slope of the tangent at a point
+	res, err = calculatorSvc.Symbolic(context.Background(), &calculatorpb.SymbolicRequest{
+		Operation:  calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE,
+		Expression: "x^3 - 2x",
+		Values:     map[string]float64{"x": 2},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "10", res.Expression)
+	assert.Equal(t, 10.0, res.Value)
+}
+
+func Test_SymbolicErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.SymbolicRequest
+	}{
+		{"Empty", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY}},
+		{"UnbalancedParenthesis", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: "(x + 1"}},
+		{"TrailingOperator", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: "x +"}},
+		{"UnknownFunction", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: "f(x)"}},
+		{"MissingArgument", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: "sin + 1"}},
+		{"InvalidCharacter", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: "x % 2"}},
+		{"TooDeep", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SIMPLIFY, Expression: strings.Repeat("(", 200) + "x" + strings.Repeat(")", 200)}},
+		{"ConstantVariable", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE, Expression: "pi", Variable: "pi"}},
+		{"ConstantValue", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE, Expression: "e", Values: map[string]float64{"e": 3}}},
+		{"OrderTooHigh", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_DIFFERENTIATE, Expression: "x", Order: 11}},
+		{"NoValues", &calculatorpb.SymbolicRequest{Operation: calculatorpb.SYMBOLIC_SYMBOLIC_SUBSTITUTE, Expression: "x"}},
+		{"MissingOperation", &calculatorpb.SymbolicRequest{Expression: "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.Symbolic(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
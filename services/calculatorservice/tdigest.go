@@ -0,0 +1,141 @@
+package calculatorservice
+
+import (
+	"math"
+	"sort"
+)
+
+// centroid is a cluster of values in the t-digest, summarised by its mean and weight
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest is a merging t-digest (Dunning & Ertl) used to estimate quantiles of
+// a stream with bounded memory. The number of centroids is bounded by roughly
+// the compression factor, no matter how many values are added.
+type TDigest struct {
+	compression float64
+	bufferSize  int
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+// NewTDigest creates an empty sketch, larger compression trades memory for accuracy
+func NewTDigest(compression float64) *TDigest {
+	bufferSize := int(math.Ceil(compression)) * 5
+	return &TDigest{
+		compression: compression,
+		bufferSize:  bufferSize,
+		buffer:      make([]centroid, 0, bufferSize),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add records a single value
+func (t *TDigest) Add(x float64) {
+	t.buffer = append(t.buffer, centroid{mean: x, weight: 1})
+	t.count++
+	t.min = math.Min(t.min, x)
+	t.max = math.Max(t.max, x)
+	if len(t.buffer) >= t.bufferSize {
+		t.compress()
+	}
+}
+
+// Merge folds the centroids of other into t, other is left untouched
+func (t *TDigest) Merge(other *TDigest) {
+	if other.count == 0 {
+		return
+	}
+	t.buffer = append(t.buffer, other.centroids...)
+	t.buffer = append(t.buffer, other.buffer...)
+	t.count += other.count
+	t.min = math.Min(t.min, other.min)
+	t.max = math.Max(t.max, other.max)
+	t.compress()
+}
+
+// compress merges the buffered values into the centroid list, using the k1
+// scale function so that centroids stay small near the tails.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.buffer, t.centroids...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, len(t.centroids)+1)
+	merged = append(merged, all[0])
+	soFar := 0.0
+	limit := t.count * t.kInverse(t.k(0)+1)
+	for _, c := range all[1:] {
+		last := &merged[len(merged)-1]
+		if soFar+last.weight+c.weight <= limit {
+			last.weight += c.weight
+			last.mean += (c.mean - last.mean) * c.weight / last.weight
+			continue
+		}
+		soFar += last.weight
+		limit = t.count * t.kInverse(t.k(soFar/t.count)+1)
+		merged = append(merged, c)
+	}
+	t.centroids = merged
+	t.buffer = t.buffer[:0]
+}
+
+func (t *TDigest) k(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (t *TDigest) kInverse(k float64) float64 {
+	if k >= t.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/t.compression) + 1) / 2
+}
+
+// Quantile estimates the value below which a fraction q of the stream falls
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	switch {
+	case len(t.centroids) == 0:
+		return math.NaN()
+	case len(t.centroids) == 1 || q <= 0:
+		if q >= 1 {
+			return t.max
+		}
+		if q <= 0 {
+			return t.min
+		}
+		return t.centroids[0].mean
+	case q >= 1:
+		return t.max
+	}
+
+	index := q * t.count
+	first := t.centroids[0]
+	if index < first.weight/2 {
+		return t.min + (first.mean-t.min)*index/(first.weight/2)
+	}
+	last := t.centroids[len(t.centroids)-1]
+	if index > t.count-last.weight/2 {
+		return last.mean + (t.max-last.mean)*(index-(t.count-last.weight/2))/(last.weight/2)
+	}
+
+	// centres are the cumulative weights at the middle of each centroid
+	centre := first.weight / 2
+	for i := 0; i < len(t.centroids)-1; i++ {
+		left, right := t.centroids[i], t.centroids[i+1]
+		next := centre + (left.weight+right.weight)/2
+		if index <= next {
+			return left.mean + (right.mean-left.mean)*(index-centre)/(next-centre)
+		}
+		centre = next
+	}
+	return last.mean
+}
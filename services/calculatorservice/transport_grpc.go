@@ -2,8 +2,11 @@ package calculatorservice
 
 import (
 	"context"
+	"errors"
 
 	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GRPCHandler ...
@@ -19,14 +22,352 @@ func NewGRPCHandler(service Service) *GRPCHandler {
 	}
 }
 
+// encodeError maps service errors to gRPC status errors
+func encodeError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrOverflow):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, ErrRateUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, ErrBudgetExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, ErrNotConverged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
 // Calculator is a gRPC handler...
 func (h *GRPCHandler) Calculator(ctx context.Context, req *calculatorpb.CalculateRequest) (*calculatorpb.CalculateResponse, error) {
 	result, err := h.service.Calculator(ctx, req.Operator, req.Operands)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	return &calculatorpb.CalculateResponse{
 		Result: result,
 	}, nil
 }
+
+// StreamStatistics is a gRPC handler that keeps running statistics over the values streamed by the client
+func (h *GRPCHandler) StreamStatistics(stream calculatorpb.CalculatorService_StreamStatisticsServer) error {
+	return encodeError(h.service.StreamStatistics(stream.Context(), stream.Recv, stream.Send))
+}
+
+// TTest is a gRPC handler that runs a one-sample, two-sample or Welch t-test
+func (h *GRPCHandler) TTest(ctx context.Context, req *calculatorpb.TTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	res, err := h.service.TTest(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// ChiSquareTest is a gRPC handler that runs a chi-square goodness-of-fit or independence test
+func (h *GRPCHandler) ChiSquareTest(ctx context.Context, req *calculatorpb.ChiSquareTestRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	res, err := h.service.ChiSquareTest(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Correlation is a gRPC handler that computes a correlation coefficient and its significance
+func (h *GRPCHandler) Correlation(ctx context.Context, req *calculatorpb.CorrelationRequest) (*calculatorpb.HypothesisTestResponse, error) {
+	res, err := h.service.Correlation(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Distribution is a gRPC handler that evaluates the PDF, CDF or quantile function of a distribution
+func (h *GRPCHandler) Distribution(ctx context.Context, req *calculatorpb.DistributionRequest) (*calculatorpb.DistributionResponse, error) {
+	res, err := h.service.Distribution(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Random is a gRPC handler that draws seeded samples from a distribution
+func (h *GRPCHandler) Random(ctx context.Context, req *calculatorpb.RandomRequest) (*calculatorpb.RandomResponse, error) {
+	res, err := h.service.Random(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// RollDice is a gRPC handler that rolls dice written in dice notation
+func (h *GRPCHandler) RollDice(ctx context.Context, req *calculatorpb.RollDiceRequest) (*calculatorpb.RollDiceResponse, error) {
+	res, err := h.service.RollDice(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Combinatorics is a gRPC handler that computes exact combinatorial numbers
+func (h *GRPCHandler) Combinatorics(ctx context.Context, req *calculatorpb.CombinatoricsRequest) (*calculatorpb.CombinatoricsResponse, error) {
+	res, err := h.service.Combinatorics(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// NumberTheory is a gRPC handler that runs number theory functions on arbitrary-size integers
+func (h *GRPCHandler) NumberTheory(ctx context.Context, req *calculatorpb.NumberTheoryRequest) (*calculatorpb.NumberTheoryResponse, error) {
+	res, err := h.service.NumberTheory(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// IntegerCalculator is a gRPC handler that computes on fixed-width integers
+func (h *GRPCHandler) IntegerCalculator(ctx context.Context, req *calculatorpb.IntegerCalculateRequest) (*calculatorpb.IntegerCalculateResponse, error) {
+	res, err := h.service.IntegerCalculator(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// FloatBits is a gRPC handler that shows the IEEE-754 bits of a float64
+func (h *GRPCHandler) FloatBits(ctx context.Context, req *calculatorpb.FloatBitsRequest) (*calculatorpb.FloatBitsResponse, error) {
+	res, err := h.service.FloatBits(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// UnitCalculator is a gRPC handler that computes with dimensioned quantities
+func (h *GRPCHandler) UnitCalculator(ctx context.Context, req *calculatorpb.UnitCalculateRequest) (*calculatorpb.UnitCalculateResponse, error) {
+	res, err := h.service.UnitCalculator(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// ConvertCurrency is a gRPC handler that converts and sums amounts in several currencies
+func (h *GRPCHandler) ConvertCurrency(ctx context.Context, req *calculatorpb.ConvertCurrencyRequest) (*calculatorpb.ConvertCurrencyResponse, error) {
+	res, err := h.service.ConvertCurrency(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// MoneyCalculator is a gRPC handler that computes allocations, percentages and taxes on exact amounts
+func (h *GRPCHandler) MoneyCalculator(ctx context.Context, req *calculatorpb.MoneyCalculateRequest) (*calculatorpb.MoneyCalculateResponse, error) {
+	res, err := h.service.MoneyCalculator(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// TimeValue is a gRPC handler that computes PMT, PV, FV, NPER or RATE
+func (h *GRPCHandler) TimeValue(ctx context.Context, req *calculatorpb.TimeValueRequest) (*calculatorpb.TimeValueResponse, error) {
+	res, err := h.service.TimeValue(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// CashFlow is a gRPC handler that computes the NPV or IRR of a series of cash flows
+func (h *GRPCHandler) CashFlow(ctx context.Context, req *calculatorpb.CashFlowRequest) (*calculatorpb.CashFlowResponse, error) {
+	res, err := h.service.CashFlow(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Amortization is a gRPC handler that streams a loan amortization schedule row by row
+func (h *GRPCHandler) Amortization(req *calculatorpb.AmortizationRequest, stream calculatorpb.CalculatorService_AmortizationServer) error {
+	return encodeError(h.service.Amortization(stream.Context(), req, stream.Send))
+}
+
+// Depreciation is a gRPC handler that computes a depreciation schedule
+func (h *GRPCHandler) Depreciation(ctx context.Context, req *calculatorpb.DepreciationRequest) (*calculatorpb.DepreciationResponse, error) {
+	res, err := h.service.Depreciation(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// DateCalculator is a gRPC handler that does date and time arithmetic
+func (h *GRPCHandler) DateCalculator(ctx context.Context, req *calculatorpb.DateCalculateRequest) (*calculatorpb.DateCalculateResponse, error) {
+	res, err := h.service.DateCalculator(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// EvaluateRuleTable is a gRPC handler that evaluates an amount against a named bracket table
+func (h *GRPCHandler) EvaluateRuleTable(ctx context.Context, req *calculatorpb.RuleTableRequest) (*calculatorpb.RuleTableResponse, error) {
+	res, err := h.service.EvaluateRuleTable(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Symbolic is a gRPC handler that differentiates, simplifies and substitutes into expressions
+func (h *GRPCHandler) Symbolic(ctx context.Context, req *calculatorpb.SymbolicRequest) (*calculatorpb.SymbolicResponse, error) {
+	res, err := h.service.Symbolic(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// EvaluateExpression is a gRPC handler that evaluates an expression and optionally its gradient
+func (h *GRPCHandler) EvaluateExpression(ctx context.Context, req *calculatorpb.EvaluateExpressionRequest) (*calculatorpb.EvaluateExpressionResponse, error) {
+	res, err := h.service.EvaluateExpression(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Solve is a gRPC handler that finds the roots of an equation
+func (h *GRPCHandler) Solve(ctx context.Context, req *calculatorpb.SolveRequest) (*calculatorpb.SolveResponse, error) {
+	res, err := h.service.Solve(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Integrate is a gRPC handler that computes a definite integral
+func (h *GRPCHandler) Integrate(ctx context.Context, req *calculatorpb.IntegrateRequest) (*calculatorpb.IntegrateResponse, error) {
+	res, err := h.service.Integrate(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// SumSeries is a gRPC handler that sums a finite or infinite series
+func (h *GRPCHandler) SumSeries(ctx context.Context, req *calculatorpb.SumSeriesRequest) (*calculatorpb.SumSeriesResponse, error) {
+	res, err := h.service.SumSeries(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// SolveODE is a gRPC handler that streams the trajectory of an initial value problem point by point
+func (h *GRPCHandler) SolveODE(req *calculatorpb.SolveODERequest, stream calculatorpb.CalculatorService_SolveODEServer) error {
+	return encodeError(h.service.SolveODE(stream.Context(), req, stream.Send))
+}
+
+// Plot is a gRPC handler that samples expressions for a chart
+func (h *GRPCHandler) Plot(ctx context.Context, req *calculatorpb.PlotRequest) (*calculatorpb.PlotResponse, error) {
+	res, err := h.service.Plot(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Interpolate is a gRPC handler that interpolates tabulated points
+func (h *GRPCHandler) Interpolate(ctx context.Context, req *calculatorpb.InterpolateRequest) (*calculatorpb.InterpolateResponse, error) {
+	res, err := h.service.Interpolate(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Fit is a gRPC handler that fits a model to points by least squares
+func (h *GRPCHandler) Fit(ctx context.Context, req *calculatorpb.FitRequest) (*calculatorpb.FitResponse, error) {
+	res, err := h.service.Fit(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// LinearProgram is a gRPC handler that solves a linear program by the simplex method
+func (h *GRPCHandler) LinearProgram(ctx context.Context, req *calculatorpb.LinearProgramRequest) (*calculatorpb.LinearProgramResponse, error) {
+	res, err := h.service.LinearProgram(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// FourierTransform is a gRPC handler that computes the discrete Fourier transform of samples
+func (h *GRPCHandler) FourierTransform(ctx context.Context, req *calculatorpb.FourierTransformRequest) (*calculatorpb.FourierTransformResponse, error) {
+	res, err := h.service.FourierTransform(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// PowerSpectrum is a gRPC handler that computes the power spectral density of samples
+func (h *GRPCHandler) PowerSpectrum(ctx context.Context, req *calculatorpb.PowerSpectrumRequest) (*calculatorpb.PowerSpectrumResponse, error) {
+	res, err := h.service.PowerSpectrum(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Window is a gRPC handler that applies a window to samples
+func (h *GRPCHandler) Window(ctx context.Context, req *calculatorpb.WindowRequest) (*calculatorpb.WindowResponse, error) {
+	res, err := h.service.Window(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// FIRFilter is a gRPC handler that filters samples with a finite impulse response filter
+func (h *GRPCHandler) FIRFilter(ctx context.Context, req *calculatorpb.FIRFilterRequest) (*calculatorpb.FIRFilterResponse, error) {
+	res, err := h.service.FIRFilter(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Geometry is a gRPC handler that computes shapes, triangles, distances, polygons and geodesics
+func (h *GRPCHandler) Geometry(ctx context.Context, req *calculatorpb.GeometryRequest) (*calculatorpb.GeometryResponse, error) {
+	res, err := h.service.Geometry(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// Logic is a gRPC handler that evaluates, tabulates and minimizes boolean expressions
+func (h *GRPCHandler) Logic(ctx context.Context, req *calculatorpb.LogicRequest) (*calculatorpb.LogicResponse, error) {
+	res, err := h.service.Logic(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
+
+// IntervalCalculator is a gRPC handler that computes an enclosure of an operation or an expression of intervals
+func (h *GRPCHandler) IntervalCalculator(ctx context.Context, req *calculatorpb.IntervalCalculateRequest) (*calculatorpb.IntervalCalculateResponse, error) {
+	res, err := h.service.IntervalCalculator(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res, nil
+}
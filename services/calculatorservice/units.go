@@ -0,0 +1,490 @@
+package calculatorservice
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed units.yaml
+var defaultUnits []byte
+
+// UnitCalculator adds, subtracts, multiplies, divides and converts quantities with units
+func (c *Calculator) UnitCalculator(ctx context.Context, req *calculatorpb.UnitCalculateRequest) (*calculatorpb.UnitCalculateResponse, error) {
+	if req.Operand_1 == nil {
+		return nil, invalidArgumentf("operand_1 is not supplied")
+	}
+	a, err := c.units.parse(req.Operand_1.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		value      float64
+		dim        dimension
+		outputUnit = req.OutputUnit
+	)
+	switch req.Operator {
+	case calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT:
+		if outputUnit == "" {
+			return nil, invalidArgumentf("output unit is not supplied")
+		}
+		value, dim = a.toBase(req.Operand_1.Value), a.dimension
+	case calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_ADD, calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_SUBTRACT,
+		calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY, calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE:
+		if req.Operand_2 == nil {
+			return nil, invalidArgumentf("operand_2 is not supplied")
+		}
+		b, err := c.units.parse(req.Operand_2.Unit)
+		if err != nil {
+			return nil, err
+		}
+		// sums of temperatures on an offset scale have no meaning, they have to
+		// be converted to an absolute scale first
+		for _, operand := range []struct {
+			u    unit
+			name string
+		}{{a, req.Operand_1.Unit}, {b, req.Operand_2.Unit}} {
+			if operand.u.offset != 0 {
+				return nil, invalidArgumentf("%s is measured from an offset zero and can only be converted", operand.name)
+			}
+		}
+
+		x, y := a.toBase(req.Operand_1.Value), b.toBase(req.Operand_2.Value)
+		switch req.Operator {
+		case calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_ADD, calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_SUBTRACT:
+			if !a.dimension.equal(b.dimension) {
+				return nil, invalidArgumentf("can not combine %s (%s) with %s (%s)",
+					quantityString(req.Operand_1), a.dimension.describe(), quantityString(req.Operand_2), b.dimension.describe())
+			}
+			value, dim = x+y, a.dimension
+			if req.Operator == calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_SUBTRACT {
+				value = x - y
+			}
+			if outputUnit == "" {
+				outputUnit = req.Operand_1.Unit
+			}
+		case calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY:
+			value, dim = x*y, a.dimension.mul(b.dimension, 1)
+			if outputUnit == "" {
+				outputUnit = formatUnitTerms(combineUnitTerms(a.terms, b.terms, 1))
+			}
+		case calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE:
+			if y == 0 {
+				return nil, invalidArgumentf("you can not divide %s by 0", quantityString(req.Operand_1))
+			}
+			value, dim = x/y, a.dimension.mul(b.dimension, -1)
+			if outputUnit == "" {
+				outputUnit = formatUnitTerms(combineUnitTerms(a.terms, b.terms, -1))
+			}
+		}
+	default:
+		return nil, invalidArgumentf("unit operator is not supplied")
+	}
+
+	out, err := c.units.parse(outputUnit)
+	if err != nil {
+		return nil, err
+	}
+	if !out.dimension.equal(dim) {
+		return nil, invalidArgumentf("can not express %s as %s (%s)", dim.describe(), outputUnit, out.dimension.describe())
+	}
+	return &calculatorpb.UnitCalculateResponse{
+		Result:    &calculatorpb.Quantity{Value: out.fromBase(value), Unit: outputUnit},
+		Base:      &calculatorpb.Quantity{Value: value, Unit: c.units.baseUnit(dim)},
+		Dimension: dim.describe(),
+	}, nil
+}
+
+func quantityString(q *calculatorpb.Quantity) string {
+	return strings.TrimSpace(strconv.FormatFloat(q.Value, 'g', -1, 64) + " " + q.Unit)
+}
+
+// dimension maps base dimensions such as length and time to their exponents
+type dimension map[string]int
+
+func (d dimension) mul(other dimension, sign int) dimension {
+	product := dimension{}
+	for name, exp := range d {
+		product[name] += exp
+	}
+	for name, exp := range other {
+		product[name] += sign * exp
+	}
+	for name, exp := range product {
+		if exp == 0 {
+			delete(product, name)
+		}
+	}
+	return product
+}
+
+func (d dimension) equal(other dimension) bool {
+	if len(d) != len(other) {
+		return false
+	}
+	for name, exp := range d {
+		if other[name] != exp {
+			return false
+		}
+	}
+	return true
+}
+
+func (d dimension) describe() string {
+	terms := make([]unitTerm, 0, len(d))
+	for name, exp := range d {
+		terms = append(terms, unitTerm{symbol: name, exponent: exp})
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].symbol < terms[j].symbol })
+	if len(terms) == 0 {
+		return "dimensionless"
+	}
+	return formatUnitTerms(terms)
+}
+
+// unitTerm is a unit symbol raised to a power
+type unitTerm struct {
+	symbol   string
+	exponent int
+}
+
+// combineUnitTerms multiplies (sign 1) or divides (sign -1) two unit expressions
+// and cancels the units that appear in both
+func combineUnitTerms(a, b []unitTerm, sign int) []unitTerm {
+	combined := append([]unitTerm{}, a...)
+	for _, t := range b {
+		merged := false
+		for i := range combined {
+			if combined[i].symbol == t.symbol {
+				combined[i].exponent += sign * t.exponent
+				merged = true
+			}
+		}
+		if !merged {
+			combined = append(combined, unitTerm{symbol: t.symbol, exponent: sign * t.exponent})
+		}
+	}
+	terms := combined[:0]
+	for _, t := range combined {
+		if t.exponent != 0 {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// formatUnitTerms writes terms as e.g. kg*m^2/s^2
+func formatUnitTerms(terms []unitTerm) string {
+	var numerator, denominator []string
+	for _, t := range terms {
+		s, exp := t.symbol, t.exponent
+		if exp < 0 {
+			exp = -exp
+		}
+		if exp != 1 {
+			s += "^" + strconv.Itoa(exp)
+		}
+		if t.exponent > 0 {
+			numerator = append(numerator, s)
+		} else {
+			denominator = append(denominator, s)
+		}
+	}
+	if len(numerator) == 0 && len(denominator) > 0 {
+		numerator = []string{"1"}
+	}
+	s := strings.Join(numerator, "*")
+	for _, d := range denominator {
+		s += "/" + d
+	}
+	return s
+}
+
+// unit converts a unit expression to the base units of its dimension, the
+// value in base units is value*factor + offset
+type unit struct {
+	terms     []unitTerm
+	factor    float64
+	offset    float64
+	dimension dimension
+}
+
+func (u unit) toBase(value float64) float64 {
+	return value*u.factor + u.offset
+}
+
+func (u unit) fromBase(value float64) float64 {
+	return (value - u.offset) / u.factor
+}
+
+// unitDefinition is a unit as written in the units file
+type unitDefinition struct {
+	Dimension string   `yaml:"dimension"`
+	Factor    float64  `yaml:"factor"`
+	Unit      string   `yaml:"unit"`
+	Offset    float64  `yaml:"offset"`
+	Prefixes  bool     `yaml:"prefixes"`
+	Aliases   []string `yaml:"aliases"`
+}
+
+type unitsFile struct {
+	Prefixes map[string]float64        `yaml:"prefixes"`
+	Units    map[string]unitDefinition `yaml:"units"`
+}
+
+// unitRegistry resolves unit symbols, with or without SI prefixes
+type unitRegistry struct {
+	prefixes    map[string]float64
+	prefixOrder []string
+	units       map[string]unit
+	prefixable  map[string]bool
+	// baseUnits maps each dimension to its base unit symbol
+	baseUnits map[string]string
+}
+
+// loadUnitRegistry reads the embedded unit definitions, followed by the units
+// file at path when it is set
+func loadUnitRegistry(path string) (*unitRegistry, error) {
+	sources := [][]byte{defaultUnits}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read units file: %w", err)
+		}
+		sources = append(sources, data)
+	}
+	return newUnitRegistry(sources...)
+}
+
+// newUnitRegistry merges unit files, later files add units and prefixes or
+// replace the ones already defined
+func newUnitRegistry(sources ...[]byte) (*unitRegistry, error) {
+	definitions := map[string]unitDefinition{}
+	r := &unitRegistry{
+		prefixes:   map[string]float64{},
+		units:      map[string]unit{},
+		prefixable: map[string]bool{},
+		baseUnits:  map[string]string{},
+	}
+	for _, source := range sources {
+		var file unitsFile
+		if err := yaml.Unmarshal(source, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse units file: %w", err)
+		}
+		for p, v := range file.Prefixes {
+			r.prefixes[p] = v
+		}
+		for symbol, def := range file.Units {
+			definitions[symbol] = def
+		}
+	}
+	for p := range r.prefixes {
+		r.prefixOrder = append(r.prefixOrder, p)
+	}
+	// longest first, so that da is tried before d
+	sort.Slice(r.prefixOrder, func(i, j int) bool {
+		if len(r.prefixOrder[i]) != len(r.prefixOrder[j]) {
+			return len(r.prefixOrder[i]) > len(r.prefixOrder[j])
+		}
+		return r.prefixOrder[i] < r.prefixOrder[j]
+	})
+
+	// definitions may refer to units further down the file, so they are
+	// resolved depth first
+	resolving := map[string]bool{}
+	var resolve func(symbol string) (unit, error)
+	resolve = func(symbol string) (unit, error) {
+		if u, ok := r.units[symbol]; ok {
+			return u, nil
+		}
+		def, ok := definitions[symbol]
+		if !ok {
+			for _, prefix := range r.prefixOrder {
+				if name := strings.TrimPrefix(symbol, prefix); name != symbol && definitions[name].Prefixes {
+					if _, err := resolve(name); err != nil {
+						return unit{}, err
+					}
+				}
+			}
+			return r.lookup(symbol)
+		}
+		if resolving[symbol] {
+			return unit{}, fmt.Errorf("unit %s is defined in terms of itself", symbol)
+		}
+		resolving[symbol] = true
+
+		factor := def.Factor
+		if factor == 0 {
+			factor = 1
+		}
+		u := unit{terms: []unitTerm{{symbol: symbol, exponent: 1}}, offset: def.Offset}
+		switch {
+		case def.Dimension != "" && def.Unit != "":
+			return unit{}, fmt.Errorf("unit %s has both a dimension and a unit", symbol)
+		case def.Dimension != "":
+			if base, ok := r.baseUnits[def.Dimension]; ok {
+				return unit{}, fmt.Errorf("%s and %s are both base units of %s", base, symbol, def.Dimension)
+			}
+			r.baseUnits[def.Dimension] = symbol
+			u.factor, u.dimension = factor, dimension{def.Dimension: 1}
+		case def.Unit != "":
+			of, err := r.parseWith(def.Unit, resolve)
+			if err != nil {
+				return unit{}, fmt.Errorf("unit %s: %w", symbol, err)
+			}
+			if of.offset != 0 {
+				return unit{}, fmt.Errorf("unit %s can not be defined in terms of the offset unit %s", symbol, def.Unit)
+			}
+			u.factor, u.dimension = factor*of.factor, of.dimension
+		default:
+			return unit{}, fmt.Errorf("unit %s needs a dimension or a unit", symbol)
+		}
+
+		r.units[symbol] = u
+		r.prefixable[symbol] = def.Prefixes && def.Offset == 0
+		for _, alias := range def.Aliases {
+			r.units[alias] = u
+			r.prefixable[alias] = r.prefixable[symbol]
+		}
+		return u, nil
+	}
+
+	symbols := make([]string, 0, len(definitions))
+	for symbol := range definitions {
+		symbols = append(symbols, symbol)
+	}
+	// base units first, so that a clash between two of them is reported the same way every time
+	sort.Strings(symbols)
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return definitions[symbols[i]].Dimension != "" && definitions[symbols[j]].Dimension == ""
+	})
+	for _, symbol := range symbols {
+		if _, err := resolve(symbol); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// parse reads a unit expression such as "km/h", "kg*m^2/s^2" or "m s^-1", an
+// empty expression is dimensionless
+func (r *unitRegistry) parse(expr string) (unit, error) {
+	u, err := r.parseWith(expr, r.lookup)
+	if err != nil {
+		return unit{}, invalidArgumentf("%v", err)
+	}
+	return u, nil
+}
+
+func (r *unitRegistry) parseWith(expr string, lookup func(string) (unit, error)) (unit, error) {
+	result := unit{factor: 1, dimension: dimension{}}
+	sign := 1
+	var terms []unitTerm
+	rest := strings.TrimSpace(expr)
+	for first := true; rest != ""; first = false {
+		switch {
+		case strings.HasPrefix(rest, "/"):
+			sign, rest = -1, rest[1:]
+		case strings.HasPrefix(rest, "*"):
+			sign, rest = 1, rest[1:]
+		case strings.HasPrefix(rest, "·"):
+			sign, rest = 1, strings.TrimPrefix(rest, "·")
+		case !first:
+			// juxtaposition multiplies, as in "N m"
+			sign = 1
+		}
+		rest = strings.TrimLeft(rest, " ")
+
+		end := strings.IndexAny(rest, " */·^²³")
+		if end < 0 {
+			end = len(rest)
+		}
+		symbol := rest[:end]
+		rest = rest[end:]
+		if symbol == "" {
+			return unit{}, fmt.Errorf("unit expression %q is missing a unit", expr)
+		}
+
+		exp := 1
+		switch {
+		case strings.HasPrefix(rest, "^"):
+			digits := rest[1:]
+			n := strings.IndexAny(digits, " */·")
+			if n < 0 {
+				n = len(digits)
+			}
+			e, err := strconv.Atoi(digits[:n])
+			if err != nil {
+				return unit{}, fmt.Errorf("unit expression %q has an invalid exponent %q", expr, digits[:n])
+			}
+			exp, rest = e, digits[n:]
+		case strings.HasPrefix(rest, "²"):
+			exp, rest = 2, strings.TrimPrefix(rest, "²")
+		case strings.HasPrefix(rest, "³"):
+			exp, rest = 3, strings.TrimPrefix(rest, "³")
+		}
+		rest = strings.TrimLeft(rest, " ")
+		exp *= sign
+
+		if symbol == "1" {
+			continue
+		}
+		u, err := lookup(symbol)
+		if err != nil {
+			return unit{}, err
+		}
+		result.factor *= math.Pow(u.factor, float64(exp))
+		result.dimension = result.dimension.mul(u.dimension, exp)
+		terms = combineUnitTerms(terms, []unitTerm{{symbol: symbol, exponent: exp}}, 1)
+		if u.offset != 0 {
+			result.offset = u.offset
+		}
+	}
+	result.terms = terms
+
+	// an offset only makes sense for the unit on its own, °C/s is a rate of change
+	if result.offset != 0 && (len(terms) != 1 || terms[0].exponent != 1) {
+		result.offset = 0
+	}
+	return result, nil
+}
+
+// lookup resolves a unit symbol, trying it as a prefixed unit when it is not defined
+func (r *unitRegistry) lookup(symbol string) (unit, error) {
+	if u, ok := r.units[symbol]; ok {
+		return u, nil
+	}
+	for _, prefix := range r.prefixOrder {
+		scale := r.prefixes[prefix]
+		name := strings.TrimPrefix(symbol, prefix)
+		if name == symbol || !r.prefixable[name] {
+			continue
+		}
+		u := r.units[name]
+		return unit{
+			terms:     []unitTerm{{symbol: symbol, exponent: 1}},
+			factor:    scale * u.factor,
+			dimension: u.dimension,
+		}, nil
+	}
+	return unit{}, fmt.Errorf("unknown unit %q", symbol)
+}
+
+// baseUnit writes a dimension in base units, e.g. kg*m/s^2
+func (r *unitRegistry) baseUnit(d dimension) string {
+	terms := make([]unitTerm, 0, len(d))
+	for name, exp := range d {
+		terms = append(terms, unitTerm{symbol: r.baseUnits[name], exponent: exp})
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].symbol < terms[j].symbol })
+	return formatUnitTerms(terms)
+}
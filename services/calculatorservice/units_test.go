@@ -0,0 +1,169 @@
+package calculatorservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/josephmbassey/calculator-service/rpc/proto/calculatorpb"
+	"github.com/josephmbassey/calculator-service/services/calculatorservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UnitCalculator(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name              string
+		operator          calculatorpb.UNIT_OPERATOR
+		operand1          *calculatorpb.Quantity
+		operand2          *calculatorpb.Quantity
+		outputUnit        string
+		expectedValue     float64
+		expectedUnit      string
+		expectedDimension string
+	}{
+		{"AddLengths", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_ADD, &calculatorpb.Quantity{Value: 5, Unit: "km"}, &calculatorpb.Quantity{Value: 300, Unit: "m"}, "", 5.3, "km", "length"},
+		{"SubtractFeetFromMetres", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_SUBTRACT, &calculatorpb.Quantity{Value: 1, Unit: "m"}, &calculatorpb.Quantity{Value: 1, Unit: "ft"}, "in", 27.37007874015748, "in", "length"},
+		{"Speed", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE, &calculatorpb.Quantity{Value: 60, Unit: "mi"}, &calculatorpb.Quantity{Value: 1, Unit: "h"}, "km/h", 96.56064, "km/h", "length/time"},
+		{"DefaultProductUnit", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY, &calculatorpb.Quantity{Value: 2, Unit: "kW"}, &calculatorpb.Quantity{Value: 3, Unit: "h"}, "", 6, "kW*h", "length^2*mass/time^2"},
+		{"Force", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY, &calculatorpb.Quantity{Value: 10, Unit: "kg"}, &calculatorpb.Quantity{Value: 9.81, Unit: "m/s^2"}, "N", 98.1, "N", "length*mass/time^2"},
+		{"CancellingUnits", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE, &calculatorpb.Quantity{Value: 3, Unit: "km"}, &calculatorpb.Quantity{Value: 1, Unit: "km"}, "", 3, "", "dimensionless"},
+		{"ScaleByNumber", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_MULTIPLY, &calculatorpb.Quantity{Value: 4, Unit: "lb"}, &calculatorpb.Quantity{Value: 2}, "", 8, "lb", "mass"},
+		{"CelsiusToFahrenheit", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 100, Unit: "°C"}, nil, "°F", 212, "°F", "temperature"},
+		{"FahrenheitToCelsius", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: -40, Unit: "degF"}, nil, "degC", -40, "degC", "temperature"},
+		{"AbsoluteZero", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 0, Unit: "K"}, nil, "°C", -273.15, "°C", "temperature"},
+		{"KilowattHours", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 3, Unit: "kWh"}, nil, "MJ", 10.8, "MJ", "length^2*mass/time^2"},
+		{"Pounds", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 1, Unit: "lb"}, nil, "g", 453.59237, "g", "mass"},
+		{"SquaredUnits", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 1, Unit: "ha"}, nil, "m²", 10000, "m²", "length^2"},
+		{"Juxtaposition", calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT, &calculatorpb.Quantity{Value: 1, Unit: "kN m"}, nil, "J", 1000, "J", "length^2*mass/time^2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := calculatorSvc.UnitCalculator(context.Background(), &calculatorpb.UnitCalculateRequest{
+				Operator:   tt.operator,
+				Operand_1:  tt.operand1,
+				Operand_2:  tt.operand2,
+				OutputUnit: tt.outputUnit,
+			})
+			assert.Nil(t, err)
+			assert.InDelta(t, tt.expectedValue, res.Result.Value, 1e-9*(1+math.Abs(tt.expectedValue)))
+			assert.Equal(t, tt.expectedUnit, res.Result.Unit)
+			assert.Equal(t, tt.expectedDimension, res.Dimension)
+		})
+	}
+}
+
+func Test_UnitCalculatorBase(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	res, err := calculatorSvc.UnitCalculator(context.Background(), &calculatorpb.UnitCalculateRequest{
+		Operator:  calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE,
+		Operand_1: &calculatorpb.Quantity{Value: 1, Unit: "kWh"},
+		Operand_2: &calculatorpb.Quantity{Value: 1, Unit: "h"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "kWh/h", res.Result.Unit)
+	assert.InDelta(t, 1, res.Result.Value, 1e-12)
+	assert.Equal(t, "kg*m^2/s^3", res.Base.Unit)
+	assert.InDelta(t, 1000, res.Base.Value, 1e-9)
+}
+
+func Test_UnitCalculatorErrors(t *testing.T) {
+	calculatorSvc, _ := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout))
+	tests := []struct {
+		name    string
+		request *calculatorpb.UnitCalculateRequest
+	}{
+		{"IncompatibleSum", &calculatorpb.UnitCalculateRequest{
+			Operator:  calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_ADD,
+			Operand_1: &calculatorpb.Quantity{Value: 1, Unit: "ft"},
+			Operand_2: &calculatorpb.Quantity{Value: 1, Unit: "s"},
+		}},
+		{"IncompatibleConversion", &calculatorpb.UnitCalculateRequest{
+			Operator:   calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT,
+			Operand_1:  &calculatorpb.Quantity{Value: 1, Unit: "J"},
+			OutputUnit: "W",
+		}},
+		{"UnknownUnit", &calculatorpb.UnitCalculateRequest{
+			Operator:   calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT,
+			Operand_1:  &calculatorpb.Quantity{Value: 1, Unit: "furlong"},
+			OutputUnit: "m",
+		}},
+		{"OffsetArithmetic", &calculatorpb.UnitCalculateRequest{
+			Operator:  calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_ADD,
+			Operand_1: &calculatorpb.Quantity{Value: 20, Unit: "°C"},
+			Operand_2: &calculatorpb.Quantity{Value: 5, Unit: "°C"},
+		}},
+		{"DivideByZero", &calculatorpb.UnitCalculateRequest{
+			Operator:  calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE,
+			Operand_1: &calculatorpb.Quantity{Value: 1, Unit: "m"},
+			Operand_2: &calculatorpb.Quantity{Value: 0, Unit: "s"},
+		}},
+		{"BadExponent", &calculatorpb.UnitCalculateRequest{
+			Operator:   calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT,
+			Operand_1:  &calculatorpb.Quantity{Value: 1, Unit: "m^x"},
+			OutputUnit: "m",
+		}},
+		{"MissingOutputUnit", &calculatorpb.UnitCalculateRequest{
+			Operator:  calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT,
+			Operand_1: &calculatorpb.Quantity{Value: 1, Unit: "m"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculatorSvc.UnitCalculator(context.Background(), tt.request)
+			assert.True(t, errors.Is(err, calculatorservice.ErrInvalidArgument), "unexpected error %v", err)
+		})
+	}
+}
+
+func Test_UnitsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "units.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(`
+units:
+  furlong: {factor: 220, unit: yd}
+  fortnight: {factor: 14, unit: d}
+  B: {dimension: information, prefixes: true}
+  bit: {factor: 0.125, unit: B, prefixes: true}
+`), 0o600))
+
+	calculatorSvc, err := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithUnitsFile(path))
+	assert.Nil(t, err)
+
+	res, err := calculatorSvc.UnitCalculator(context.Background(), &calculatorpb.UnitCalculateRequest{
+		Operator:   calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_DIVIDE,
+		Operand_1:  &calculatorpb.Quantity{Value: 1, Unit: "furlong"},
+		Operand_2:  &calculatorpb.Quantity{Value: 1, Unit: "fortnight"},
+		OutputUnit: "mm/h",
+	})
+	assert.Nil(t, err)
+	assert.InDelta(t, 598.7142857142857, res.Result.Value, 1e-9)
+
+	res, err = calculatorSvc.UnitCalculator(context.Background(), &calculatorpb.UnitCalculateRequest{
+		Operator:   calculatorpb.UNIT_OPERATOR_UNIT_OPERATOR_CONVERT,
+		Operand_1:  &calculatorpb.Quantity{Value: 8, Unit: "Mbit"},
+		OutputUnit: "kB",
+	})
+	assert.Nil(t, err)
+	assert.InDelta(t, 1000, res.Result.Value, 1e-9)
+	assert.Equal(t, "information", res.Dimension)
+
+	for name, content := range map[string]string{
+		"Cycle":         "units:\n  foo: {unit: bar}\n  bar: {unit: foo}\n",
+		"DuplicateBase": "units:\n  metre: {dimension: length}\n",
+		"Undefined":     "units:\n  foo: {factor: 2, unit: qux}\n",
+		"NotYAML":       "units: [",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "units.yaml")
+			assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+			_, err := calculatorservice.NewService(log.NewLogfmtLogger(os.Stdout), calculatorservice.WithUnitsFile(path))
+			assert.NotNil(t, err)
+		})
+	}
+}